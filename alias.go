@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AliasResolver resolves floating names like "latest", "stable", "lts", "1" or "1.4" to concrete versions
+// of a version set, so download endpoints and deploy tools share the same rules. Supported names are:
+//
+//	latest   the highest version, including pre-releases
+//	stable   the highest release
+//	1, v1    the highest release of the major version
+//	1.4      the highest release of the series
+//	1.4.2    the version itself, if it is part of the set
+//
+// and the names of Aliases, e.g. "lts" mapped to "~2.4 || ~3.8".
+type AliasResolver struct {
+	// Versions is the set of versions names resolve to.
+	Versions []SemVer
+
+	// Aliases maps custom names to constraints, resolving to the highest version of the set they allow.
+	// Custom names take precedence over the built-in ones.
+	Aliases map[string]Constraint
+
+	// PreReleaseFallback makes "1" and "1.4" resolve to the highest pre-release of a major version or series
+	// that has no release yet, e.g. while 2.0.0-rc.1 is the only version of 2.
+	PreReleaseFallback bool
+}
+
+// Resolve returns the version the name refers to.
+// It returns an error if the name is not supported or no version of the set matches it.
+func (r AliasResolver) Resolve(name string) (SemVer, error) {
+	if c, ok := r.Aliases[name]; ok {
+		return r.highest(name, c.Allows)
+	}
+
+	switch name {
+	case "latest":
+		return r.highest(name, func(SemVer) bool { return true })
+	case "stable":
+		return r.highest(name, SemVer.IsRelease)
+	}
+
+	// Resolve a full version to itself
+	number := strings.TrimPrefix(name, "v")
+	if v, err := Parse(number); err == nil {
+		return r.highest(name, func(candidate SemVer) bool { return ComparePrecedence(candidate, v) == 0 })
+	}
+
+	// Parse a major version or series
+	parts := strings.Split(number, ".")
+	if len(parts) > 2 {
+		return SemVer{}, fmt.Errorf("invalid alias: %s", name)
+	}
+	numbers := make([]uint, len(parts))
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 0)
+		if err != nil || part != "0" && strings.HasPrefix(part, "0") {
+			return SemVer{}, fmt.Errorf("invalid alias: %s", name)
+		}
+		numbers[i] = uint(n)
+	}
+	inLine := func(v SemVer) bool {
+		return v.Major == numbers[0] && (len(numbers) == 1 || v.Minor == numbers[1])
+	}
+
+	v, err := r.highest(name, func(v SemVer) bool { return inLine(v) && v.IsRelease() })
+	if err != nil && r.PreReleaseFallback {
+		return r.highest(name, inLine)
+	}
+	return v, err
+}
+
+// highest returns the version of the set with the highest precedence among those matching.
+func (r AliasResolver) highest(name string, matches func(SemVer) bool) (SemVer, error) {
+	var result SemVer
+	found := false
+	for _, v := range r.Versions {
+		if matches(v) && (!found || ComparePrecedence(v, result) > 0) {
+			result, found = v, true
+		}
+	}
+	if !found {
+		return SemVer{}, fmt.Errorf("no version for alias %s", name)
+	}
+	return result, nil
+}
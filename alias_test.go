@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestAliasResolver(t *testing.T) {
+	var versions []SemVer
+	for _, s := range []string{"1.3.9", "1.4.0", "1.4.2+build.7", "1.5.0-rc.1", "2.4.1", "2.5.0", "3.0.0-beta.2", "3.0.0-rc.1", "0.9.0"} {
+		versions = append(versions, mustParse(t, s))
+	}
+	lts, err := ParseConstraint("~1.4 || ~2.4")
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	r := AliasResolver{Versions: versions, Aliases: map[string]Constraint{"lts": lts}}
+	fallback := r
+	fallback.PreReleaseFallback = true
+
+	tests := []struct {
+		name        string
+		resolver    AliasResolver
+		alias       string
+		expected    string
+		expectError bool
+	}{
+		{name: "Latest", resolver: r, alias: "latest", expected: "3.0.0-rc.1"},
+		{name: "Stable", resolver: r, alias: "stable", expected: "2.5.0"},
+		{name: "Custom alias", resolver: r, alias: "lts", expected: "2.4.1"},
+		{name: "Major version", resolver: r, alias: "1", expected: "1.4.2+build.7"},
+		{name: "Major version with prefix", resolver: r, alias: "v2", expected: "2.5.0"},
+		{name: "Series", resolver: r, alias: "1.4", expected: "1.4.2+build.7"},
+		{name: "Series without release", resolver: r, alias: "1.5", expectError: true},
+		{name: "Series without release with fallback", resolver: fallback, alias: "1.5", expected: "1.5.0-rc.1"},
+		{name: "Major version without release with fallback", resolver: fallback, alias: "3", expected: "3.0.0-rc.1"},
+		{name: "Zero major version", resolver: r, alias: "0", expected: "0.9.0"},
+		{name: "Exact version", resolver: r, alias: "1.4.2", expected: "1.4.2+build.7"},
+		{name: "Exact pre-release", resolver: r, alias: "v3.0.0-beta.2", expected: "3.0.0-beta.2"},
+		{name: "Unknown exact version", resolver: r, alias: "1.4.1", expectError: true},
+		{name: "Unknown major version", resolver: r, alias: "4", expectError: true},
+		{name: "Unconfigured alias", resolver: AliasResolver{Versions: versions}, alias: "lts", expectError: true},
+		{name: "Leading zero", resolver: r, alias: "01", expectError: true},
+		{name: "Too many components", resolver: r, alias: "1.4.2.1", expectError: true},
+		{name: "Empty set", resolver: AliasResolver{}, alias: "latest", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := tt.resolver.Resolve(tt.alias)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if v.String() != tt.expected {
+				t.Errorf("Resolve(%q) = %v, want %v", tt.alias, v, tt.expected)
+			}
+		})
+	}
+}
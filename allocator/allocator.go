@@ -0,0 +1,64 @@
+// Package allocator reserves release versions atomically, so that concurrent release jobs that computed
+// the same next version cannot both publish it.
+package allocator
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Backend stores the latest allocated version and replaces it atomically.
+type Backend interface {
+	// Load returns the latest allocated version, or false if no version was allocated yet.
+	Load() (semver.SemVer, bool, error)
+
+	// CompareAndSwap replaces the latest allocated version with next if it is still old,
+	// or if no version was allocated and hasOld is false. It reports whether the version was replaced.
+	CompareAndSwap(old semver.SemVer, hasOld bool, next semver.SemVer) (bool, error)
+}
+
+// ConflictError is returned by Allocate when a version at least as high as the requested one was allocated before.
+type ConflictError struct {
+	Requested semver.SemVer
+	Winner    semver.SemVer
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("version %s conflicts with the allocated version %s", e.Requested, e.Winner)
+}
+
+// Allocator hands out strictly increasing versions from a backend shared by all release jobs.
+type Allocator struct {
+	backend Backend
+}
+
+// New returns an allocator storing the allocated versions in the backend.
+func New(backend Backend) *Allocator {
+	return &Allocator{backend: backend}
+}
+
+// Allocate reserves the version if it is higher than every version allocated before.
+// It returns a *ConflictError with the latest allocated version otherwise, and the errors of the backend.
+func (a *Allocator) Allocate(next semver.SemVer) error {
+	for {
+		current, ok, err := a.backend.Load()
+		if err != nil {
+			return err
+		}
+		if ok && semver.ComparePrecedence(next, current) <= 0 {
+			return &ConflictError{Requested: next, Winner: current}
+		}
+
+		// Another job may have allocated a version since the load, check again in that case
+		swapped, err := a.backend.CompareAndSwap(current, ok, next)
+		if err != nil || swapped {
+			return err
+		}
+	}
+}
+
+// Latest returns the latest allocated version, or false if no version was allocated yet.
+func (a *Allocator) Latest() (semver.SemVer, bool, error) {
+	return a.backend.Load()
+}
@@ -0,0 +1,101 @@
+package allocator
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func mustParse(t *testing.T, s string) semver.SemVer {
+	t.Helper()
+	v, err := semver.Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed: %v", s, err)
+	}
+	return v
+}
+
+// backends returns a fresh instance of each backend.
+func backends(t *testing.T) map[string]Backend {
+	return map[string]Backend{
+		"Memory": &MemoryBackend{},
+		"File":   FileBackend{Path: filepath.Join(t.TempDir(), "version")},
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		version        string
+		expectedWinner string
+	}{
+		{version: "1.4.0"},
+		{version: "1.5.0"},
+		{version: "1.5.0", expectedWinner: "1.5.0"},
+		{version: "1.5.0+build.2", expectedWinner: "1.5.0"},
+		{version: "1.4.9", expectedWinner: "1.5.0"},
+		{version: "1.6.0-rc.1"},
+		{version: "1.6.0"},
+	}
+
+	for name, backend := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			a := New(backend)
+			for _, tt := range tests {
+				err := a.Allocate(mustParse(t, tt.version))
+				if tt.expectedWinner == "" {
+					if err != nil {
+						t.Errorf("Allocate(%v) did not expect error but got: %v", tt.version, err)
+					}
+					continue
+				}
+				var conflict *ConflictError
+				if !errors.As(err, &conflict) || conflict.Winner.String() != tt.expectedWinner {
+					t.Errorf("Allocate(%v) = %v, want a conflict with %v", tt.version, err, tt.expectedWinner)
+				}
+			}
+			if latest, ok, err := a.Latest(); err != nil || !ok || latest.String() != "1.6.0" {
+				t.Errorf("Latest() = %v, %v, %v, want 1.6.0", latest, ok, err)
+			}
+		})
+	}
+}
+
+func TestAllocateConcurrent(t *testing.T) {
+	for name, backend := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			a := New(backend)
+			next := mustParse(t, "1.5.0")
+
+			// Only one of the jobs racing for the same version wins
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			won, conflicts := 0, 0
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					err := a.Allocate(next)
+					var conflict *ConflictError
+					mu.Lock()
+					defer mu.Unlock()
+					switch {
+					case err == nil:
+						won++
+					case errors.As(err, &conflict) && conflict.Winner == next:
+						conflicts++
+					default:
+						t.Errorf("Allocate() = %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if won != 1 || conflicts != 7 {
+				t.Errorf("Allocate() succeeded %d times with %d conflicts, want 1 and 7", won, conflicts)
+			}
+		})
+	}
+}
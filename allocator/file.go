@@ -0,0 +1,121 @@
+package allocator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/mkyc/go-semver/internal/atomicfile"
+)
+
+// FileBackend is a Backend storing the latest allocated version in a file, for release jobs sharing a file system.
+// Swaps are serialized by a lock file next to it, created exclusively, which is removed after the swap.
+// The lock file records the host, process and time of its owner. A lock left behind by a crashed job is
+// removed once it is older than StaleLockAge; if that is disabled, remove the lock file manually after
+// checking that its owner is gone.
+type FileBackend struct {
+	Path string
+
+	// LockTimeout is how long CompareAndSwap waits for the lock held by another job, one second if zero.
+	LockTimeout time.Duration
+
+	// StaleLockAge is the age after which a lock is considered left behind by a crashed job and removed,
+	// one minute if zero. Negative values never remove locks. It must be well above the duration of a swap.
+	StaleLockAge time.Duration
+}
+
+// Load returns the version stored in the file, or false if the file does not exist.
+func (b FileBackend) Load() (semver.SemVer, bool, error) {
+	data, err := os.ReadFile(b.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return semver.SemVer{}, false, nil
+	}
+	if err != nil {
+		return semver.SemVer{}, false, fmt.Errorf("load allocated version: %w", err)
+	}
+	v, err := semver.Parse(strings.TrimSpace(string(data)))
+	if err != nil {
+		return semver.SemVer{}, false, fmt.Errorf("load allocated version: %s: %w", b.Path, err)
+	}
+	return v, true, nil
+}
+
+// CompareAndSwap replaces the version stored in the file if it is still old, holding the lock file meanwhile.
+// It returns an error if the lock cannot be acquired within the timeout.
+func (b FileBackend) CompareAndSwap(old semver.SemVer, hasOld bool, next semver.SemVer) (bool, error) {
+	unlock, err := b.lock()
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	current, ok, err := b.Load()
+	if err != nil {
+		return false, err
+	}
+	if ok != hasOld || ok && current != old {
+		return false, nil
+	}
+
+	// Replace the file atomically, so readers never see a partial version
+	if err := atomicfile.WriteFile(b.Path, []byte(next.String()+"\n")); err != nil {
+		return false, fmt.Errorf("store allocated version: %w", err)
+	}
+	return true, nil
+}
+
+// lock creates the lock file, retrying until the timeout, and returns the function removing it.
+// Stale locks are removed on the way.
+func (b FileBackend) lock() (func(), error) {
+	timeout := b.LockTimeout
+	if timeout == 0 {
+		timeout = time.Second
+	}
+	staleAge := b.StaleLockAge
+	if staleAge == 0 {
+		staleAge = time.Minute
+	}
+	deadline := time.Now().Add(timeout)
+
+	path := b.Path + ".lock"
+	host, _ := os.Hostname()
+	owner := fmt.Sprintf("host=%s pid=%d time=%s\n", host, os.Getpid(), time.Now().UTC().Format(time.RFC3339))
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			_, err = f.WriteString(owner)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(path)
+				return nil, fmt.Errorf("lock allocated version: %w", err)
+			}
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("lock allocated version: %w", err)
+		}
+
+		// Remove a lock left behind by a crashed job, unless it was replaced in the meantime
+		if info, err := os.Stat(path); err == nil && staleAge > 0 && time.Since(info.ModTime()) > staleAge {
+			if current, err := os.Stat(path); err == nil && os.SameFile(info, current) {
+				os.Remove(path)
+			}
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			holder, _ := os.ReadFile(path)
+			if len(holder) == 0 {
+				holder = []byte("an unknown owner")
+			}
+			return nil, fmt.Errorf("lock allocated version: %s held for more than %v by %s, remove it if its owner is gone",
+				path, timeout, strings.TrimSpace(string(holder)))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,103 @@
+package allocator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileBackendLoad(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expected    string
+		expectError bool
+	}{
+		{name: "Missing file"},
+		{name: "Version", content: "1.2.3\n", expected: "1.2.3"},
+		{name: "Invalid version", content: "1.2\n", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "version")
+			// An empty content leaves the file missing
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			v, ok, err := FileBackend{Path: path}.Load()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if ok != (tt.expected != "") || ok && v.String() != tt.expected {
+				t.Errorf("Load() = %v, %v, want %q", v, ok, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFileBackendLockTimeout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "version")
+	if err := os.WriteFile(path+".lock", nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	b := FileBackend{Path: path, LockTimeout: 50 * time.Millisecond}
+	if _, err := b.CompareAndSwap(mustParse(t, "1.0.0"), false, mustParse(t, "1.1.0")); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
+
+func TestFileBackendStaleLock(t *testing.T) {
+	tests := []struct {
+		name         string
+		staleLockAge time.Duration
+		expectError  bool
+	}{
+		{name: "Stale lock is removed"},
+		{name: "Lock younger than the stale age", staleLockAge: time.Hour, expectError: true},
+		{name: "Stale locks are kept if disabled", staleLockAge: -1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A lock left behind by a crashed job ten minutes ago
+			path := filepath.Join(t.TempDir(), "version")
+			if err := os.WriteFile(path+".lock", []byte("host=ci pid=42\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			crashed := time.Now().Add(-10 * time.Minute)
+			if err := os.Chtimes(path+".lock", crashed, crashed); err != nil {
+				t.Fatal(err)
+			}
+
+			b := FileBackend{Path: path, LockTimeout: 50 * time.Millisecond, StaleLockAge: tt.staleLockAge}
+			swapped, err := b.CompareAndSwap(mustParse(t, "1.0.0"), false, mustParse(t, "1.1.0"))
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if !swapped {
+				t.Errorf("CompareAndSwap() = false, want true")
+			}
+			if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+				t.Errorf("lock file not removed after the swap: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package allocator
+
+import (
+	"sync"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// MemoryBackend is a Backend for allocators within one process. The zero value is ready to use.
+type MemoryBackend struct {
+	mu     sync.Mutex
+	latest semver.SemVer
+	ok     bool
+}
+
+// Load returns the latest allocated version.
+func (b *MemoryBackend) Load() (semver.SemVer, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.latest, b.ok, nil
+}
+
+// CompareAndSwap replaces the latest allocated version if it is still old.
+func (b *MemoryBackend) CompareAndSwap(old semver.SemVer, hasOld bool, next semver.SemVer) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ok != hasOld || b.ok && b.latest != old {
+		return false, nil
+	}
+	b.latest, b.ok = next, true
+	return true, nil
+}
@@ -0,0 +1,155 @@
+// Package apibump recommends the version bump for a Go module from its actual API changes,
+// as detected by golang.org/x/exp/apidiff between two source trees or released versions:
+// incompatible changes require a major bump, compatible additions a minor bump and anything else a patch bump.
+package apibump
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+	"golang.org/x/exp/apidiff"
+	"golang.org/x/tools/go/packages"
+)
+
+// Recommend returns the change type required by the API changes of a report:
+// ChangeMajor if any change is incompatible, ChangeMinor if there are compatible changes and ChangePatch otherwise.
+func Recommend(r apidiff.Report) semver.ChangeType {
+	change := semver.ChangePatch
+	for _, c := range r.Changes {
+		if !c.Compatible {
+			return semver.ChangeMajor
+		}
+		change = semver.ChangeMinor
+	}
+	return change
+}
+
+// LoadModule loads the exported API of the module whose go.mod is in dir.
+// Internal and main packages are left out, as they are not part of the API.
+func LoadModule(dir string) (*apidiff.Module, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedModule,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, fmt.Errorf("load module: %w", err)
+	}
+
+	m := &apidiff.Module{}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("load module: %s: %v", pkg.PkgPath, pkg.Errors[0])
+		}
+		if pkg.Module != nil && m.Path == "" {
+			m.Path = pkg.Module.Path
+		}
+		if pkg.Name == "main" || isInternal(pkg.PkgPath) {
+			continue
+		}
+		m.Packages = append(m.Packages, pkg.Types)
+	}
+	if m.Path == "" {
+		return nil, fmt.Errorf("load module: no module in %s", dir)
+	}
+	return m, nil
+}
+
+// isInternal reports whether the package path has an "internal" element.
+func isInternal(path string) bool {
+	for _, element := range strings.Split(path, "/") {
+		if element == "internal" {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareDirs returns the API changes between the module source trees in oldDir and newDir.
+func CompareDirs(oldDir, newDir string) (apidiff.Report, error) {
+	oldModule, err := LoadModule(oldDir)
+	if err != nil {
+		return apidiff.Report{}, err
+	}
+	newModule, err := LoadModule(newDir)
+	if err != nil {
+		return apidiff.Report{}, err
+	}
+	return apidiff.ModuleChanges(oldModule, newModule), nil
+}
+
+// CompareVersions downloads two versions of a module with "go mod download" and returns their API changes,
+// e.g. CompareVersions("example.com/mod", "v1.2.3", "v1.3.0").
+func CompareVersions(modulePath, oldVersion, newVersion string) (apidiff.Report, error) {
+	oldDir, err := download(modulePath, oldVersion)
+	if err != nil {
+		return apidiff.Report{}, err
+	}
+	newDir, err := download(modulePath, newVersion)
+	if err != nil {
+		return apidiff.Report{}, err
+	}
+	return CompareDirs(oldDir, newDir)
+}
+
+// download returns the directory of the module version in the module cache, downloading it if needed.
+func download(modulePath, version string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "mod", "download", "-json", modulePath+"@"+version)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+
+	// The error is reported in the JSON output for unknown versions
+	var result struct {
+		Dir   string
+		Error string
+	}
+	if jsonErr := json.Unmarshal(stdout.Bytes(), &result); jsonErr != nil && err == nil {
+		err = jsonErr
+	}
+	if result.Error != "" {
+		return "", fmt.Errorf("download %s@%s: %s", modulePath, version, result.Error)
+	}
+	if err != nil {
+		return "", fmt.Errorf("download %s@%s: %v: %s", modulePath, version, err, strings.TrimSpace(stderr.String()))
+	}
+	return result.Dir, nil
+}
+
+// CheckRelease verifies that the proposed version is at least as large as required by the API changes
+// since the previous version, like gorelease does. A pre-release of a large enough version is accepted,
+// so 2.0.0-rc.1 may follow incompatible changes to 1.2.3. Below v1, incompatible changes only require
+// a minor bump, so 0.3.0 may follow 0.2.5.
+// It returns an error explaining the required version and the changes requiring it if not.
+func CheckRelease(previous, proposed semver.SemVer, r apidiff.Report) error {
+	if semver.ComparePrecedence(proposed, previous) <= 0 {
+		return fmt.Errorf("version %s is not greater than the previous version %s", proposed, previous)
+	}
+
+	change := Recommend(r)
+	required := semver.ZeroPolicy{BreakingBumpsMinor: true}.Bump(previous, change)
+	core := semver.SemVer{Major: proposed.Major, Minor: proposed.Minor, Patch: proposed.Patch}
+	if semver.ComparePrecedence(core, required) >= 0 {
+		return nil
+	}
+
+	// List the changes that require the larger bump
+	compatible := change != semver.ChangeMajor
+	kind := "incompatible"
+	if compatible {
+		kind = "compatible"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "version %s is too low: %s API changes since %s require at least %s:", proposed, kind, previous, required)
+	for _, c := range r.Changes {
+		if c.Compatible == compatible {
+			fmt.Fprintf(&b, "\n  %s", c.Message)
+		}
+	}
+	return fmt.Errorf("%s", b.String())
+}
@@ -0,0 +1,198 @@
+package apibump
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+	"golang.org/x/exp/apidiff"
+)
+
+// writeModule writes a module with the given files to a temporary directory.
+func writeModule(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	files["go.mod"] = "module example.com/lib\n\ngo 1.21\n"
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestCompareDirs(t *testing.T) {
+	old := writeModule(t, map[string]string{
+		"lib.go":              "package lib\n\nfunc A() int { return 1 }\n",
+		"internal/x/x.go":     "package x\n\nfunc X() {}\n",
+		"cmd/tool/main.go":    "package main\n\nfunc main() {}\n",
+		"sub/sub.go":          "package sub\n\nconst S = 1\n",
+		"sub/sub_internal.go": "package sub\n\nfunc helper() {}\n",
+	})
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected semver.ChangeType
+	}{
+		{
+			name: "Implementation change",
+			files: map[string]string{
+				"lib.go":     "package lib\n\nfunc A() int { return 2 }\n",
+				"sub/sub.go": "package sub\n\nconst S = 1\n",
+			},
+			expected: semver.ChangePatch,
+		},
+		{
+			name: "Internal and main packages change",
+			files: map[string]string{
+				"lib.go":           "package lib\n\nfunc A() int { return 1 }\n",
+				"internal/x/x.go":  "package x\n\nfunc Y() {}\n",
+				"cmd/tool/main.go": "package main\n\nfunc main() {}\n\nfunc Exported() {}\n",
+				"sub/sub.go":       "package sub\n\nconst S = 1\n",
+			},
+			expected: semver.ChangePatch,
+		},
+		{
+			name: "Addition",
+			files: map[string]string{
+				"lib.go":     "package lib\n\nfunc A() int { return 1 }\n\nfunc B() {}\n",
+				"sub/sub.go": "package sub\n\nconst S = 1\n",
+			},
+			expected: semver.ChangeMinor,
+		},
+		{
+			name: "Removed package",
+			files: map[string]string{
+				"lib.go": "package lib\n\nfunc A() int { return 1 }\n",
+			},
+			expected: semver.ChangeMajor,
+		},
+		{
+			name: "Changed signature",
+			files: map[string]string{
+				"lib.go":     "package lib\n\nfunc A() string { return \"\" }\n",
+				"sub/sub.go": "package sub\n\nconst S = 1\n",
+			},
+			expected: semver.ChangeMajor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := CompareDirs(old, writeModule(t, tt.files))
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := Recommend(report); result != tt.expected {
+				t.Errorf("Recommend() = %v, want %v\n%s", result, tt.expected, report)
+			}
+		})
+	}
+}
+
+func TestCompareDirsError(t *testing.T) {
+	broken := writeModule(t, map[string]string{"lib.go": "package lib\n\nfunc A() int { return \"\" }\n"})
+	if _, err := CompareDirs(broken, broken); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	tests := []struct {
+		name     string
+		report   apidiff.Report
+		expected semver.ChangeType
+	}{
+		{name: "No changes", expected: semver.ChangePatch},
+		{name: "Compatible", report: apidiff.Report{Changes: []apidiff.Change{{Message: "B: added", Compatible: true}}}, expected: semver.ChangeMinor},
+		{
+			name: "Incompatible",
+			report: apidiff.Report{Changes: []apidiff.Change{
+				{Message: "B: added", Compatible: true},
+				{Message: "A: removed", Compatible: false},
+			}},
+			expected: semver.ChangeMajor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Recommend(tt.report); result != tt.expected {
+				t.Errorf("Recommend() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckRelease(t *testing.T) {
+	incompatible := apidiff.Report{Changes: []apidiff.Change{
+		{Message: "B: added", Compatible: true},
+		{Message: "A: removed", Compatible: false},
+	}}
+	compatible := apidiff.Report{Changes: []apidiff.Change{{Message: "B: added", Compatible: true}}}
+
+	tests := []struct {
+		name        string
+		previous    string
+		proposed    string
+		report      apidiff.Report
+		expectError bool
+	}{
+		{name: "Patch without changes", previous: "1.2.3", proposed: "1.2.4"},
+		{name: "Minor with additions", previous: "1.2.3", proposed: "1.3.0"},
+		{name: "Major with additions", previous: "1.2.3", proposed: "2.0.0", report: compatible},
+		{name: "Major with incompatible changes", previous: "1.2.3", proposed: "2.0.0", report: incompatible},
+		{name: "Pre-release with incompatible changes", previous: "1.2.3", proposed: "2.0.0-rc.1", report: incompatible},
+		{name: "Patch with additions", previous: "1.2.3", proposed: "1.2.4", report: compatible, expectError: true},
+		{name: "Minor with incompatible changes", previous: "1.2.3", proposed: "1.3.0", report: incompatible, expectError: true},
+		{name: "v0 minor with incompatible changes", previous: "0.2.5", proposed: "0.3.0", report: incompatible},
+		{name: "v0 patch with incompatible changes", previous: "0.2.5", proposed: "0.2.6", report: incompatible, expectError: true},
+		{name: "v0 minor with additions", previous: "0.2.5", proposed: "0.3.0", report: compatible},
+		{name: "v0 patch with additions", previous: "0.2.5", proposed: "0.2.6", report: compatible, expectError: true},
+		{name: "v0 patch without changes", previous: "0.2.5", proposed: "0.2.6"},
+		{name: "Same version", previous: "1.2.3", proposed: "1.2.3", expectError: true},
+		{name: "Lower version", previous: "1.2.3", proposed: "1.2.3-rc.1", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous, err := semver.Parse(tt.previous)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proposed, err := semver.Parse(tt.proposed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = CheckRelease(previous, proposed, tt.report)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckReleaseExplanation(t *testing.T) {
+	report := apidiff.Report{Changes: []apidiff.Change{
+		{Message: "B: added", Compatible: true},
+		{Message: "A: removed", Compatible: false},
+	}}
+	err := CheckRelease(semver.SemVer{Major: 1, Minor: 2, Patch: 3}, semver.SemVer{Major: 1, Minor: 3}, report)
+	expected := "version 1.3.0 is too low: incompatible API changes since 1.2.3 require at least 2.0.0:\n  A: removed"
+	if err == nil || err.Error() != expected {
+		t.Errorf("CheckRelease() = %v, want %v", err, expected)
+	}
+}
@@ -0,0 +1,155 @@
+// Command release-check verifies that a proposed version of a Go module is at least as large as
+// required by the API changes since a previous release tag, like gorelease:
+//
+//	release-check -base v1.2.3 -version v1.3.0
+//
+// The API of the working tree is compared with the API at the base tag. It exits with 0 if the
+// version is large enough and with 1 and an explanation otherwise.
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/mkyc/go-semver/apibump"
+)
+
+// Exit codes of the command.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run checks the release and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("release-check", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	dir := fs.String("C", ".", "directory of the module in the working tree")
+	base := fs.String("base", "", "tag of the previous release, e.g. v1.2.3 or sub/v1.2.3")
+	version := fs.String("version", "", "proposed version of the next release")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *base == "" || *version == "" || fs.NArg() != 0 {
+		fmt.Fprintln(stderr, "Usage: release-check [-C dir] -base tag -version version")
+		fs.PrintDefaults()
+		return exitUsage
+	}
+
+	// The tag of a module in a subdirectory is prefixed with the directory
+	previous, err := semver.Parse(strings.TrimPrefix(path.Base(*base), "v"))
+	if err != nil {
+		fmt.Fprintf(stderr, "release-check: base tag: %v\n", err)
+		return exitUsage
+	}
+	proposed, err := semver.Parse(strings.TrimPrefix(*version, "v"))
+	if err != nil {
+		fmt.Fprintf(stderr, "release-check: %v\n", err)
+		return exitUsage
+	}
+
+	baseDir, err := os.MkdirTemp("", "release-check-")
+	if err != nil {
+		fmt.Fprintf(stderr, "release-check: %v\n", err)
+		return exitFailure
+	}
+	defer os.RemoveAll(baseDir)
+	if err := extractTag(*dir, *base, baseDir); err != nil {
+		fmt.Fprintf(stderr, "release-check: %v\n", err)
+		return exitFailure
+	}
+
+	report, err := apibump.CompareDirs(baseDir, *dir)
+	if err != nil {
+		fmt.Fprintf(stderr, "release-check: %v\n", err)
+		return exitFailure
+	}
+	if err := apibump.CheckRelease(previous, proposed, report); err != nil {
+		fmt.Fprintf(stderr, "release-check: %v\n", err)
+		return exitFailure
+	}
+
+	fmt.Fprintf(stdout, "%s is a valid release after %s, the API changes require a %s bump\n", proposed, previous, apibump.Recommend(report))
+	return exitOK
+}
+
+// extractTag writes the module directory dir as of the tag into target.
+func extractTag(dir, tag, target string) error {
+	// Find the path of the module within the repository
+	prefix, err := git(dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return err
+	}
+	treeish := tag + "^{tree}"
+	if prefix = strings.TrimSuffix(strings.TrimSpace(prefix), "/"); prefix != "" {
+		treeish = tag + ":" + prefix
+	}
+
+	top, err := git(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return err
+	}
+	archive, err := git(strings.TrimSpace(top), "archive", "--format=tar", treeish)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(bytes.NewReader([]byte(archive)))
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("extract %s: %w", tag, err)
+		}
+		name := filepath.Join(target, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(name, filepath.Clean(target)+string(filepath.Separator)) {
+			return fmt.Errorf("extract %s: invalid path %s", tag, header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(name, 0o755); err != nil {
+				return fmt.Errorf("extract %s: %w", tag, err)
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+				return fmt.Errorf("extract %s: %w", tag, err)
+			}
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return fmt.Errorf("extract %s: %w", tag, err)
+			}
+			if err := os.WriteFile(name, data, 0o644); err != nil {
+				return fmt.Errorf("extract %s: %w", tag, err)
+			}
+		}
+	}
+}
+
+// git runs a git command in the directory and returns its output.
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// newRepo creates a git repository with the module in a subdirectory, tagged as sub/v1.2.3.
+func newRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	repo := t.TempDir()
+	dir := filepath.Join(repo, "sub")
+	writeFile(t, filepath.Join(dir, "go.mod"), "module example.com/sub\n\ngo 1.21\n")
+	writeFile(t, filepath.Join(dir, "lib.go"), "package sub\n\nfunc A() int { return 1 }\n")
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "-A"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "commit.gpgSign=false", "commit", "-q", "-m", "Initial commit"},
+		{"-c", "tag.gpgSign=false", "tag", "sub/v1.2.3"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	return dir
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       string
+		version      string
+		expectedCode int
+	}{
+		{name: "Patch", source: "package sub\n\nfunc A() int { return 2 }\n", version: "v1.2.4", expectedCode: exitOK},
+		{name: "Addition with patch", source: "package sub\n\nfunc A() int { return 1 }\n\nfunc B() {}\n", version: "v1.2.4", expectedCode: exitFailure},
+		{name: "Addition with minor", source: "package sub\n\nfunc A() int { return 1 }\n\nfunc B() {}\n", version: "v1.3.0", expectedCode: exitOK},
+		{name: "Removal with minor", source: "package sub\n\nfunc B() {}\n", version: "v1.3.0", expectedCode: exitFailure},
+		{name: "Removal with major", source: "package sub\n\nfunc B() {}\n", version: "v2.0.0", expectedCode: exitOK},
+		{name: "Invalid version", source: "package sub\n", version: "v1.3", expectedCode: exitUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := newRepo(t)
+			writeFile(t, filepath.Join(dir, "lib.go"), tt.source)
+
+			var stdout, stderr bytes.Buffer
+			code := run([]string{"-C", dir, "-base", "sub/v1.2.3", "-version", tt.version}, &stdout, &stderr)
+			if code != tt.expectedCode {
+				t.Errorf("exit code = %v, want %v (stdout: %s, stderr: %s)", code, tt.expectedCode, stdout.String(), stderr.String())
+			}
+		})
+	}
+}
+
+func TestRunUnknownTag(t *testing.T) {
+	dir := newRepo(t)
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-C", dir, "-base", "sub/v1.0.0", "-version", "v1.2.4"}, &stdout, &stderr); code != exitFailure {
+		t.Errorf("exit code = %v, want %v", code, exitFailure)
+	}
+	if code := run([]string{"-C", dir}, &stdout, &stderr); code != exitUsage {
+		t.Errorf("exit code without flags = %v, want %v", code, exitUsage)
+	}
+}
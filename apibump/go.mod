@@ -0,0 +1,14 @@
+module github.com/mkyc/go-semver/apibump
+
+go 1.25.0
+
+require (
+	github.com/mkyc/go-semver v0.1.0
+	golang.org/x/exp v0.0.0-20260611194520-c48552f49976
+	golang.org/x/tools v0.47.0
+)
+
+require (
+	golang.org/x/mod v0.37.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
+)
@@ -0,0 +1,62 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BundleShortVersion returns the value for CFBundleShortVersionString, which Apple requires to be
+// three period-separated integers. The pre-release and build metadata are dropped, so every
+// pre-release of 1.2.3 is submitted as "1.2.3" and told apart by BundleVersion.
+func (s SemVer) BundleShortVersion() string {
+	return fmt.Sprintf("%d.%d.%d", s.Major, s.Minor, s.Patch)
+}
+
+// BundleVersion returns the value for CFBundleVersion, the build number, which Apple requires to be
+// unique and increasing for every upload. The version is packed into a single integer using the
+// given VersionCodeLayout, so pre-releases get build numbers below their release and the same
+// scheme can be shared with Android versionCode values.
+// It returns an error if the version cannot be encoded with the layout.
+func (s SemVer) BundleVersion(layout VersionCodeLayout) (string, error) {
+	code, err := layout.Encode(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(code), nil
+}
+
+// ParseBundleVersion reconstructs a SemVer from a CFBundleShortVersionString and a CFBundleVersion
+// produced by BundleShortVersion and BundleVersion with the same layout.
+// It returns an error if either value is malformed or they describe different versions.
+func ParseBundleVersion(shortVersion, bundleVersion string, layout VersionCodeLayout) (SemVer, error) {
+	// Validate the short version, Apple allows omitting trailing components
+	parts := strings.Split(shortVersion, ".")
+	if len(parts) > 3 {
+		return SemVer{}, fmt.Errorf("invalid bundle short version: %s, expected at most three integers", shortVersion)
+	}
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+	core, err := Parse(strings.Join(parts, "."))
+	if err != nil {
+		return SemVer{}, fmt.Errorf("invalid bundle short version: %s", shortVersion)
+	}
+
+	// Decode the build number
+	code, err := strconv.Atoi(bundleVersion)
+	if err != nil {
+		return SemVer{}, fmt.Errorf("invalid bundle version: %s, expected a single integer", bundleVersion)
+	}
+	s, err := layout.Decode(code)
+	if err != nil {
+		return SemVer{}, err
+	}
+
+	// Both values must agree on the version core
+	if s.BundleShortVersion() != core.BundleShortVersion() {
+		return SemVer{}, fmt.Errorf("bundle version %s encodes %s, which does not match short version %s", bundleVersion, s, shortVersion)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestBundleVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		semver        SemVer
+		expectedShort string
+		expected      string
+		expectError   bool
+	}{
+		{
+			name:          "Release",
+			semver:        SemVer{Major: 1, Minor: 2, Patch: 3},
+			expectedShort: "1.2.3",
+			expected:      "1020399",
+		},
+		{
+			name:          "Pre-release drops suffix from short version",
+			semver:        SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta.4"},
+			expectedShort: "1.2.3",
+			expected:      "1020338",
+		},
+		{
+			name:          "Build metadata is dropped",
+			semver:        SemVer{Major: 2, Minor: 0, Patch: 0, Build: "sha.abc"},
+			expectedShort: "2.0.0",
+			expected:      "2000099",
+		},
+		{
+			name:          "Unsupported pre-release",
+			semver:        SemVer{Major: 1, PreRelease: "nightly"},
+			expectedShort: "1.0.0",
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if short := tt.semver.BundleShortVersion(); short != tt.expectedShort {
+				t.Errorf("BundleShortVersion() = %v, want %v", short, tt.expectedShort)
+			}
+
+			version, err := tt.semver.BundleVersion(DefaultVersionCodeLayout)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if version != tt.expected {
+				t.Errorf("BundleVersion() = %v, want %v", version, tt.expected)
+			}
+
+			// Parsing both values must yield the version without build metadata
+			back, err := ParseBundleVersion(tt.expectedShort, version, DefaultVersionCodeLayout)
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			expected := tt.semver
+			expected.Build = ""
+			if back != expected {
+				t.Errorf("ParseBundleVersion() = %v, want %v", back, expected)
+			}
+		})
+	}
+}
+
+func TestParseBundleVersionInvalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		shortVersion  string
+		bundleVersion string
+	}{
+		{name: "Too many short version components", shortVersion: "1.2.3.4", bundleVersion: "1020399"},
+		{name: "Non-numeric short version", shortVersion: "1.2.x", bundleVersion: "1020399"},
+		{name: "Dotted bundle version", shortVersion: "1.2.3", bundleVersion: "1.2.3"},
+		{name: "Mismatching versions", shortVersion: "1.2.4", bundleVersion: "1020399"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseBundleVersion(tt.shortVersion, tt.bundleVersion, DefaultVersionCodeLayout); err == nil {
+				t.Errorf("Expected error but got none")
+			}
+		})
+	}
+
+	// Apple allows trailing components to be omitted from the short version
+	if _, err := ParseBundleVersion("1.2", "1020099", DefaultVersionCodeLayout); err != nil {
+		t.Errorf("Did not expect error but got: %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"runtime/debug"
+	"strings"
+)
+
+// pseudoVersionPattern matches the pre-release of a Go module pseudo-version, e.g. "0.20240102150405-abcdef123456".
+var pseudoVersionPattern = regexp.MustCompile(`^(?:(?:.*\.)?0\.)?\d{14}-[0-9A-Za-z]+$`)
+
+// FromBuildInfo returns the version of the main module as recorded by the go command in the running binary.
+// Binaries built with "go install module@version" or from a tagged commit report that version,
+// and binaries built from an untagged commit report a pseudo-version like 1.2.4-0.20240102150405-abcdef123456,
+// which is returned as is; use IsPseudoVersion to tell them apart.
+// It returns an error if the binary has no build information or was built as "(devel)",
+// e.g. with "go run" or outside of version control.
+func FromBuildInfo() (SemVer, error) {
+	info, ok := debug.ReadBuildInfo()
+	return versionFromBuildInfo(info, ok)
+}
+
+// versionFromBuildInfo implements FromBuildInfo for the given result of debug.ReadBuildInfo.
+func versionFromBuildInfo(info *debug.BuildInfo, ok bool) (SemVer, error) {
+	if !ok || info == nil {
+		return SemVer{}, fmt.Errorf("invalid build info: not available in this binary")
+	}
+
+	version := info.Main.Version
+	if version == "" || version == "(devel)" {
+		return SemVer{}, fmt.Errorf("invalid build info: main module %s has no version", info.Main.Path)
+	}
+
+	// Module versions always have a "v" prefix
+	v, err := Parse(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return SemVer{}, fmt.Errorf("invalid build info: %w", err)
+	}
+	return v, nil
+}
+
+// IsPseudoVersion returns true if the version is a Go module pseudo-version, which the go command
+// assigns to untagged commits, e.g. 0.0.0-20240102150405-abcdef123456 or 1.2.4-0.20240102150405-abcdef123456.
+func (s SemVer) IsPseudoVersion() bool {
+	if !pseudoVersionPattern.MatchString(s.PreRelease) {
+		return false
+	}
+	// Without a base version the pseudo-version must be vX.0.0-timestamp-hash
+	if !strings.Contains(s.PreRelease, ".") {
+		return s.Minor == 0 && s.Patch == 0
+	}
+	return true
+}
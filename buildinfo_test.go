@@ -0,0 +1,71 @@
+package semver
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+func TestFromBuildInfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		expected    string
+		expectError bool
+	}{
+		{name: "Tagged version", version: "v1.2.3", expected: "1.2.3"},
+		{name: "Pseudo-version", version: "v1.2.4-0.20240102150405-abcdef123456", expected: "1.2.4-0.20240102150405-abcdef123456"},
+		{name: "Modified working tree", version: "v1.2.3+dirty", expected: "1.2.3+dirty"},
+		{name: "Incompatible major version", version: "v2.0.0+incompatible", expected: "2.0.0+incompatible"},
+		{name: "Development build", version: "(devel)", expectError: true},
+		{name: "Empty version", version: "", expectError: true},
+		{name: "Invalid version", version: "v1.2", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := &debug.BuildInfo{Main: debug.Module{Path: "example.com/app", Version: tt.version}}
+			v, err := versionFromBuildInfo(info, true)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if v.String() != tt.expected {
+				t.Errorf("versionFromBuildInfo() = %v, want %v", v, tt.expected)
+			}
+		})
+	}
+
+	if _, err := versionFromBuildInfo(nil, false); err == nil {
+		t.Errorf("Expected error without build info but got none")
+	}
+}
+
+func TestIsPseudoVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{"0.0.0-20240102150405-abcdef123456", true},
+		{"2.0.0-20240102150405-abcdef123456", true},
+		{"1.2.4-0.20240102150405-abcdef123456", true},
+		{"1.2.3-rc.1.0.20240102150405-abcdef123456", true},
+		{"1.2.3-0.20240102150405-abcdef123456+incompatible", true},
+		{"1.2.3-20240102150405-abcdef123456", false},
+		{"1.2.3-rc.1", false},
+		{"1.2.3", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if result := mustParse(t, tt.version).IsPseudoVersion(); result != tt.expected {
+				t.Errorf("IsPseudoVersion() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
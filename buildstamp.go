@@ -0,0 +1,113 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// buildStampTimeLayout is the layout of the timestamp of a BuildStamp, UTC with second precision.
+const buildStampTimeLayout = "20060102150405"
+
+// BuildStamp describes the build of a binary, encoded as build metadata of its version like
+// "sha.1a2b3c4.utc.20240102150405.builder.ci-42.dirty". All fields are optional.
+type BuildStamp struct {
+	// Commit is the hexadecimal commit hash, full or abbreviated
+	Commit string
+	// Time is the build time, encoded in UTC with second precision
+	Time time.Time
+	// Builder identifies the build machine or CI job, characters other than ASCII letters, digits and hyphens
+	// are replaced with hyphens, e.g. "runner/42" becomes "runner-42"
+	Builder string
+	// Dirty marks builds from a working tree with uncommitted changes
+	Dirty bool
+}
+
+// Metadata returns the stamp as dot-separated build metadata identifiers, each field as a key followed by its value:
+// "sha.<commit>", "utc.<yyyymmddhhmmss>", "builder.<id>" and "dirty" without a value. Empty fields are left out.
+// It returns an error if the commit is not hexadecimal.
+func (b BuildStamp) Metadata() (string, error) {
+	var identifiers []string
+	if b.Commit != "" {
+		if strings.Trim(strings.ToLower(b.Commit), "0123456789abcdef") != "" {
+			return "", fmt.Errorf("invalid build stamp: commit %s is not hexadecimal", b.Commit)
+		}
+		identifiers = append(identifiers, "sha", strings.ToLower(b.Commit))
+	}
+	if !b.Time.IsZero() {
+		identifiers = append(identifiers, "utc", b.Time.UTC().Format(buildStampTimeLayout))
+	}
+	if builder := sanitizeIdentifier(b.Builder); builder != "" {
+		identifiers = append(identifiers, "builder", builder)
+	}
+	if b.Dirty {
+		identifiers = append(identifiers, "dirty")
+	}
+	return strings.Join(identifiers, "."), nil
+}
+
+// Stamp returns the version with the build metadata replaced by the stamp.
+// It returns an error if the commit is not hexadecimal.
+func (s SemVer) Stamp(b BuildStamp) (SemVer, error) {
+	metadata, err := b.Metadata()
+	if err != nil {
+		return SemVer{}, err
+	}
+	s.Build = metadata
+	return s, nil
+}
+
+// ParseBuildStamp extracts the fields of a stamp from build metadata produced by BuildStamp.Metadata.
+// Identifiers that are not part of a stamp are ignored, so the metadata may carry other information.
+// It returns an error if a key lacks its value, a key is repeated or the timestamp is malformed.
+func ParseBuildStamp(build string) (BuildStamp, error) {
+	var b BuildStamp
+	seen := make(map[string]bool)
+	identifiers := strings.Split(build, ".")
+	for i := 0; i < len(identifiers); i++ {
+		key := identifiers[i]
+		switch key {
+		case "sha", "utc", "builder", "dirty":
+		default:
+			continue
+		}
+		if seen[key] {
+			return BuildStamp{}, fmt.Errorf("invalid build stamp: %s, repeated %s", build, key)
+		}
+		seen[key] = true
+		if key == "dirty" {
+			b.Dirty = true
+			continue
+		}
+
+		// Every other key is followed by its value
+		if i+1 == len(identifiers) || identifiers[i+1] == "" {
+			return BuildStamp{}, fmt.Errorf("invalid build stamp: %s, missing value of %s", build, key)
+		}
+		i++
+		value := identifiers[i]
+		switch key {
+		case "sha":
+			b.Commit = value
+		case "utc":
+			t, err := time.Parse(buildStampTimeLayout, value)
+			if err != nil {
+				return BuildStamp{}, fmt.Errorf("invalid build stamp: %s, malformed timestamp %s", build, value)
+			}
+			b.Time = t
+		case "builder":
+			b.Builder = value
+		}
+	}
+	return b, nil
+}
+
+// sanitizeIdentifier replaces the characters not allowed in an identifier with hyphens.
+func sanitizeIdentifier(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-' {
+			return r
+		}
+		return '-'
+	}, s)
+}
@@ -0,0 +1,94 @@
+package semver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildStampMetadata(t *testing.T) {
+	buildTime := time.Date(2024, 1, 2, 16, 4, 5, 999, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name        string
+		stamp       BuildStamp
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "All fields",
+			stamp:    BuildStamp{Commit: "1A2B3C4", Time: buildTime, Builder: "ci-42", Dirty: true},
+			expected: "sha.1a2b3c4.utc.20240102150405.builder.ci-42.dirty",
+		},
+		{name: "Commit only", stamp: BuildStamp{Commit: "0123456789abcdef0123456789abcdef01234567"}, expected: "sha.0123456789abcdef0123456789abcdef01234567"},
+		{name: "Sanitized builder", stamp: BuildStamp{Builder: "runner/42.local"}, expected: "builder.runner-42-local"},
+		{name: "Dirty only", stamp: BuildStamp{Dirty: true}, expected: "dirty"},
+		{name: "Empty", stamp: BuildStamp{}, expected: ""},
+		{name: "Commit not hexadecimal", stamp: BuildStamp{Commit: "main"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := tt.stamp.Metadata()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if metadata != tt.expected {
+				t.Errorf("Metadata() = %q, want %q", metadata, tt.expected)
+			}
+
+			// Stamped versions must be valid
+			v, err := mustParse(t, "1.2.3-rc.1").Stamp(tt.stamp)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if _, err := Parse(v.String()); err != nil {
+				t.Errorf("Stamp() = %v, which does not parse: %v", v, err)
+			}
+		})
+	}
+}
+
+func TestParseBuildStamp(t *testing.T) {
+	tests := []struct {
+		name        string
+		build       string
+		expected    BuildStamp
+		expectError bool
+	}{
+		{
+			name:     "All fields",
+			build:    "sha.1a2b3c4.utc.20240102150405.builder.ci-42.dirty",
+			expected: BuildStamp{Commit: "1a2b3c4", Time: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC), Builder: "ci-42", Dirty: true},
+		},
+		{name: "Other identifiers ignored", build: "build.5.sha.1a2b3c4.linux", expected: BuildStamp{Commit: "1a2b3c4"}},
+		{name: "Builder named like a key", build: "builder.dirty", expected: BuildStamp{Builder: "dirty"}},
+		{name: "Empty", build: "", expected: BuildStamp{}},
+		{name: "Missing value", build: "sha", expectError: true},
+		{name: "Repeated key", build: "dirty.dirty", expectError: true},
+		{name: "Malformed timestamp", build: "utc.2024", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stamp, err := ParseBuildStamp(tt.build)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if stamp.Commit != tt.expected.Commit || !stamp.Time.Equal(tt.expected.Time) || stamp.Builder != tt.expected.Builder || stamp.Dirty != tt.expected.Dirty {
+				t.Errorf("ParseBuildStamp() = %+v, want %+v", stamp, tt.expected)
+			}
+		})
+	}
+}
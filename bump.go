@@ -0,0 +1,79 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// BumpMajor returns the next major version, e.g. 2.0.0 for 1.2.3.
+// A pre-release of a major version is bumped to its release, so 2.0.0-rc.1 becomes 2.0.0.
+// Build metadata is dropped.
+func (s SemVer) BumpMajor() SemVer {
+	if s.PreRelease != "" && s.Minor == 0 && s.Patch == 0 {
+		return SemVer{Major: s.Major}
+	}
+	return SemVer{Major: s.Major + 1}
+}
+
+// BumpMinor returns the next minor version, e.g. 1.3.0 for 1.2.3.
+// A pre-release of a minor version is bumped to its release, so 1.3.0-rc.1 becomes 1.3.0.
+// Build metadata is dropped.
+func (s SemVer) BumpMinor() SemVer {
+	if s.PreRelease != "" && s.Patch == 0 {
+		return SemVer{Major: s.Major, Minor: s.Minor}
+	}
+	return SemVer{Major: s.Major, Minor: s.Minor + 1}
+}
+
+// BumpPatch returns the next patch version, e.g. 1.2.4 for 1.2.3.
+// A pre-release is bumped to its release, so 1.2.4-rc.1 becomes 1.2.4.
+// Build metadata is dropped.
+func (s SemVer) BumpPatch() SemVer {
+	if s.PreRelease != "" {
+		return SemVer{Major: s.Major, Minor: s.Minor, Patch: s.Patch}
+	}
+	return SemVer{Major: s.Major, Minor: s.Minor, Patch: s.Patch + 1}
+}
+
+// ChangeType classifies a change by the version component it requires to bump.
+// Change types are ordered, so the required bump for a set of changes is the largest of them.
+type ChangeType int
+
+const (
+	// ChangeNone is a change that does not require a release, e.g. documentation or chores.
+	ChangeNone ChangeType = iota
+	// ChangePatch is a backward compatible bug fix.
+	ChangePatch
+	// ChangeMinor is new, backward compatible functionality.
+	ChangeMinor
+	// ChangeMajor is a backward incompatible change.
+	ChangeMajor
+)
+
+// String returns the name of the change type: none, patch, minor or major.
+func (c ChangeType) String() string {
+	switch c {
+	case ChangeNone:
+		return "none"
+	case ChangePatch:
+		return "patch"
+	case ChangeMinor:
+		return "minor"
+	case ChangeMajor:
+		return "major"
+	}
+	return fmt.Sprintf("ChangeType(%d)", int(c))
+}
+
+// Bump returns the next version for a change of the given type, using BumpMajor, BumpMinor or BumpPatch.
+// ChangeNone returns the version unchanged. Use ZeroPolicy.Bump to bump 0.y.z versions by other conventions.
+func (s SemVer) Bump(change ChangeType) SemVer {
+	switch change {
+	case ChangeMajor:
+		return s.BumpMajor()
+	case ChangeMinor:
+		return s.BumpMinor()
+	case ChangePatch:
+		return s.BumpPatch()
+	}
+	return s
+}
@@ -0,0 +1,98 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestBump(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		expectedMajor string
+		expectedMinor string
+		expectedPatch string
+	}{
+		{
+			name:          "Release",
+			version:       "1.2.3",
+			expectedMajor: "2.0.0",
+			expectedMinor: "1.3.0",
+			expectedPatch: "1.2.4",
+		},
+		{
+			name:          "Release with build metadata",
+			version:       "1.2.3+build.1",
+			expectedMajor: "2.0.0",
+			expectedMinor: "1.3.0",
+			expectedPatch: "1.2.4",
+		},
+		{
+			name:          "Pre-release of a major version",
+			version:       "2.0.0-rc.1",
+			expectedMajor: "2.0.0",
+			expectedMinor: "2.0.0",
+			expectedPatch: "2.0.0",
+		},
+		{
+			name:          "Pre-release of a minor version",
+			version:       "1.3.0-beta",
+			expectedMajor: "2.0.0",
+			expectedMinor: "1.3.0",
+			expectedPatch: "1.3.0",
+		},
+		{
+			name:          "Pre-release of a patch version",
+			version:       "1.2.4-alpha.1",
+			expectedMajor: "2.0.0",
+			expectedMinor: "1.3.0",
+			expectedPatch: "1.2.4",
+		},
+		{
+			name:          "Initial development",
+			version:       "0.0.0",
+			expectedMajor: "1.0.0",
+			expectedMinor: "0.1.0",
+			expectedPatch: "0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := mustParse(t, tt.version)
+			if result := v.BumpMajor().String(); result != tt.expectedMajor {
+				t.Errorf("BumpMajor() = %v, want %v", result, tt.expectedMajor)
+			}
+			if result := v.BumpMinor().String(); result != tt.expectedMinor {
+				t.Errorf("BumpMinor() = %v, want %v", result, tt.expectedMinor)
+			}
+			if result := v.BumpPatch().String(); result != tt.expectedPatch {
+				t.Errorf("BumpPatch() = %v, want %v", result, tt.expectedPatch)
+			}
+		})
+	}
+}
+
+func TestBumpChangeType(t *testing.T) {
+	tests := []struct {
+		change   ChangeType
+		expected string
+		name     string
+	}{
+		{ChangeNone, "1.2.3-rc.1", "none"},
+		{ChangePatch, "1.2.3", "patch"},
+		{ChangeMinor, "1.3.0", "minor"},
+		{ChangeMajor, "2.0.0", "major"},
+	}
+
+	v := SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := v.Bump(tt.change).String(); result != tt.expected {
+				t.Errorf("Bump() = %v, want %v", result, tt.expected)
+			}
+			if tt.change.String() != tt.name {
+				t.Errorf("String() = %v, want %v", tt.change.String(), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+// Package calver implements calendar versioning as described on https://calver.org/.
+// Versions are parsed according to a scheme like "YYYY.MM.MICRO" or "YY.0M.DD",
+// compared chronologically and converted to and from semver.SemVer where possible.
+package calver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// segment is a single dot-separated component of a scheme.
+type segment string
+
+// Supported scheme segments.
+const (
+	fullYear    segment = "YYYY"
+	shortYear   segment = "YY"
+	paddedYear  segment = "0Y"
+	shortMonth  segment = "MM"
+	paddedMonth segment = "0M"
+	shortWeek   segment = "WW"
+	paddedWeek  segment = "0W"
+	shortDay    segment = "DD"
+	paddedDay   segment = "0D"
+	major       segment = "MAJOR"
+	minor       segment = "MINOR"
+	micro       segment = "MICRO"
+)
+
+// Scheme describes the layout of a calendar version, e.g. "YYYY.MM.MICRO".
+type Scheme struct {
+	segments []segment
+}
+
+// NewScheme parses a layout of dot-separated segments into a Scheme.
+// Supported segments are YYYY, YY, 0Y, MM, 0M, WW, 0W, DD, 0D, MAJOR, MINOR and MICRO.
+// It returns an error if the layout contains unknown segments or no date segment at all.
+func NewScheme(layout string) (Scheme, error) {
+	var scheme Scheme
+	hasDate := false
+
+	for _, part := range strings.Split(layout, ".") {
+		switch s := segment(part); s {
+		case fullYear, shortYear, paddedYear, shortMonth, paddedMonth, shortWeek, paddedWeek, shortDay, paddedDay:
+			hasDate = true
+			scheme.segments = append(scheme.segments, s)
+		case major, minor, micro:
+			scheme.segments = append(scheme.segments, s)
+		default:
+			return Scheme{}, fmt.Errorf("invalid scheme: %s, unknown segment %q", layout, part)
+		}
+	}
+
+	if !hasDate {
+		return Scheme{}, fmt.Errorf("invalid scheme: %s, no calendar segment", layout)
+	}
+
+	return scheme, nil
+}
+
+// MustScheme is like NewScheme but panics if the layout is invalid.
+// It simplifies the initialization of global scheme variables.
+func MustScheme(layout string) Scheme {
+	scheme, err := NewScheme(layout)
+	if err != nil {
+		panic(err)
+	}
+	return scheme
+}
+
+// String returns the layout of the scheme.
+func (s Scheme) String() string {
+	parts := make([]string, len(s.segments))
+	for i, seg := range s.segments {
+		parts[i] = string(seg)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Version represents a calendar version according to its Scheme.
+// Values holds the numeric value of each segment in scheme order, years in short segments
+// are kept as written (e.g. 24 for "YY"). Modifier is an optional suffix like "dev" or "rc.1".
+type Version struct {
+	Scheme   Scheme
+	Values   []uint
+	Modifier string
+}
+
+// Parse parses a version string according to the scheme.
+// An optional modifier may follow the last segment after a hyphen, e.g. "2024.06.1-dev".
+// It returns an error if the string does not match the scheme or describes an impossible date.
+func (s Scheme) Parse(version string) (Version, error) {
+	result := Version{Scheme: s}
+
+	// Split off the modifier
+	core, modifier, found := strings.Cut(version, "-")
+	if found {
+		if _, err := semver.Parse("0.0.0-" + modifier); err != nil {
+			return Version{}, fmt.Errorf("invalid modifier: %s", modifier)
+		}
+		result.Modifier = modifier
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != len(s.segments) {
+		return Version{}, fmt.Errorf("invalid version format: %s, expected %s", core, s)
+	}
+
+	for i, part := range parts {
+		value, err := parseSegment(s.segments[i], part)
+		if err != nil {
+			return Version{}, err
+		}
+		result.Values = append(result.Values, value)
+	}
+
+	if err := result.validateDate(); err != nil {
+		return Version{}, err
+	}
+
+	return result, nil
+}
+
+// parseSegment parses a single part of a version and checks its padding and range.
+func parseSegment(seg segment, part string) (uint, error) {
+	value, err := strconv.ParseUint(part, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s segment: %s", seg, part)
+	}
+
+	// Check zero padding
+	switch seg {
+	case fullYear:
+		if len(part) != 4 {
+			return 0, fmt.Errorf("invalid %s segment: %s, expected four digits", seg, part)
+		}
+	case paddedYear, paddedMonth, paddedWeek, paddedDay:
+		if len(part) < 2 || (len(part) > 2 && strings.HasPrefix(part, "0")) {
+			return 0, fmt.Errorf("invalid %s segment: %s, expected zero padding to two digits", seg, part)
+		}
+	default:
+		if part != "0" && strings.HasPrefix(part, "0") {
+			return 0, fmt.Errorf("invalid %s segment: %s, leading zeros not allowed", seg, part)
+		}
+	}
+
+	// Check ranges
+	switch seg {
+	case shortMonth, paddedMonth:
+		if value < 1 || value > 12 {
+			return 0, fmt.Errorf("invalid %s segment: %s, month out of range", seg, part)
+		}
+	case shortWeek, paddedWeek:
+		if value < 1 || value > 53 {
+			return 0, fmt.Errorf("invalid %s segment: %s, week out of range", seg, part)
+		}
+	case shortDay, paddedDay:
+		if value < 1 || value > 31 {
+			return 0, fmt.Errorf("invalid %s segment: %s, day out of range", seg, part)
+		}
+	}
+
+	return uint(value), nil
+}
+
+// validateDate checks that year, month and day segments, when all present, form an existing date.
+func (v Version) validateDate() error {
+	year, month, day := -1, -1, -1
+	for i, seg := range v.Scheme.segments {
+		switch seg {
+		case fullYear:
+			year = int(v.Values[i])
+		case shortYear, paddedYear:
+			year = 2000 + int(v.Values[i])
+		case shortMonth, paddedMonth:
+			month = int(v.Values[i])
+		case shortDay, paddedDay:
+			day = int(v.Values[i])
+		}
+	}
+	if year < 0 || month < 0 || day < 0 {
+		return nil
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day {
+		return fmt.Errorf("invalid date: %04d-%02d-%02d does not exist", year, month, day)
+	}
+	return nil
+}
+
+// String returns the string representation of the version according to its scheme.
+func (v Version) String() string {
+	parts := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		switch v.Scheme.segments[i] {
+		case paddedYear, paddedMonth, paddedWeek, paddedDay:
+			parts[i] = fmt.Sprintf("%02d", value)
+		case fullYear:
+			parts[i] = fmt.Sprintf("%04d", value)
+		default:
+			parts[i] = strconv.FormatUint(uint64(value), 10)
+		}
+	}
+
+	result := strings.Join(parts, ".")
+	if v.Modifier != "" {
+		result += "-" + v.Modifier
+	}
+	return result
+}
+
+// Compare compares this version with another version of the same scheme chronologically.
+// Segments are compared numerically from left to right, a version with a modifier has lower
+// precedence than the same version without one, and modifiers compare like semver pre-releases.
+// It returns -1, 0 or 1 like SemVer.Compare.
+func (v Version) Compare(other Version) int {
+	// Compare segment values
+	for i := 0; i < len(v.Values) && i < len(other.Values); i++ {
+		if v.Values[i] < other.Values[i] {
+			return -1
+		}
+		if v.Values[i] > other.Values[i] {
+			return 1
+		}
+	}
+	if len(v.Values) != len(other.Values) {
+		if len(v.Values) < len(other.Values) {
+			return -1
+		}
+		return 1
+	}
+
+	// Compare modifiers with semver pre-release rules
+	a := semver.SemVer{PreRelease: v.Modifier}
+	b := semver.SemVer{PreRelease: other.Modifier}
+	return a.Compare(b)
+}
+
+// ToSemVer converts the version into a SemVer by using the segment values as major, minor and patch,
+// padding missing components with zeros. The modifier becomes the pre-release.
+// It returns an error if the scheme has more than three segments.
+func (v Version) ToSemVer() (semver.SemVer, error) {
+	if len(v.Values) > 3 {
+		return semver.SemVer{}, fmt.Errorf("cannot convert %s: scheme %s has more than three segments", v, v.Scheme)
+	}
+
+	numbers := make([]uint, 3)
+	copy(numbers, v.Values)
+
+	return semver.SemVer{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		PreRelease: v.Modifier,
+	}, nil
+}
+
+// FromSemVer converts a SemVer into a version of the scheme, the inverse of Version.ToSemVer.
+// Build metadata is dropped.
+// It returns an error if the scheme has more than three segments or the SemVer does not
+// describe a valid version of the scheme, e.g. a minor of 13 for a month segment.
+func (s Scheme) FromSemVer(v semver.SemVer) (Version, error) {
+	if len(s.segments) > 3 {
+		return Version{}, fmt.Errorf("cannot convert %s: scheme %s has more than three segments", v, s)
+	}
+
+	numbers := []uint{v.Major, v.Minor, v.Patch}
+	for i := len(s.segments); i < 3; i++ {
+		if numbers[i] != 0 {
+			return Version{}, fmt.Errorf("cannot convert %s: scheme %s has only %d segments", v, s, len(s.segments))
+		}
+	}
+
+	// Build the string form and parse it back to validate all segments
+	result := Version{Scheme: s, Values: numbers[:len(s.segments)], Modifier: v.PreRelease}
+	parsed, err := s.Parse(result.String())
+	if err != nil {
+		return Version{}, fmt.Errorf("cannot convert %s: %w", v, err)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,188 @@
+package calver
+
+import (
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestNewScheme(t *testing.T) {
+	tests := []struct {
+		name        string
+		layout      string
+		expectError bool
+	}{
+		{name: "Year, month and micro", layout: "YYYY.MM.MICRO"},
+		{name: "Ubuntu style", layout: "YY.0M"},
+		{name: "Short date", layout: "YY.MM.DD"},
+		{name: "Unknown segment", layout: "YYYY.MONTH", expectError: true},
+		{name: "No calendar segment", layout: "MAJOR.MINOR.MICRO", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme, err := NewScheme(tt.layout)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if scheme.String() != tt.layout {
+				t.Errorf("String() = %v, want %v", scheme.String(), tt.layout)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		layout      string
+		version     string
+		expected    []uint
+		modifier    string
+		expectError bool
+	}{
+		{name: "Year, month and micro", layout: "YYYY.MM.MICRO", version: "2024.6.3", expected: []uint{2024, 6, 3}},
+		{name: "Padded month", layout: "YY.0M", version: "24.04", expected: []uint{24, 4}},
+		{name: "Short date", layout: "YY.MM.DD", version: "24.2.29", expected: []uint{24, 2, 29}},
+		{name: "With modifier", layout: "YYYY.0M.MICRO", version: "2024.06.0-rc.1", expected: []uint{2024, 6, 0}, modifier: "rc.1"},
+		{name: "Padding missing", layout: "YY.0M", version: "24.4", expectError: true},
+		{name: "Unexpected padding", layout: "YYYY.MM.MICRO", version: "2024.06.3", expectError: true},
+		{name: "Month out of range", layout: "YYYY.MM.MICRO", version: "2024.13.0", expectError: true},
+		{name: "Impossible date", layout: "YY.MM.DD", version: "23.2.29", expectError: true},
+		{name: "Wrong segment count", layout: "YYYY.MM.MICRO", version: "2024.6", expectError: true},
+		{name: "Invalid modifier", layout: "YYYY.MM", version: "2024.6-dev_1", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := MustScheme(tt.layout).Parse(tt.version)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if len(v.Values) != len(tt.expected) {
+				t.Fatalf("Values = %v, want %v", v.Values, tt.expected)
+			}
+			for i := range v.Values {
+				if v.Values[i] != tt.expected[i] {
+					t.Errorf("Values = %v, want %v", v.Values, tt.expected)
+				}
+			}
+			if v.Modifier != tt.modifier {
+				t.Errorf("Modifier = %v, want %v", v.Modifier, tt.modifier)
+			}
+			if v.String() != tt.version {
+				t.Errorf("String() = %v, want %v", v.String(), tt.version)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	scheme := MustScheme("YYYY.0M.MICRO")
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"2024.06.0", "2024.06.0", 0},
+		{"2024.06.0", "2024.10.0", -1},
+		{"2025.01.0", "2024.12.9", 1},
+		{"2024.06.2", "2024.06.10", -1},
+		{"2024.06.0-dev", "2024.06.0", -1},
+		{"2024.06.0-rc.2", "2024.06.0-rc.10", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, err := scheme.Parse(tt.a)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			b, err := scheme.Parse(tt.b)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := a.Compare(b); result != tt.expected {
+				t.Errorf("Compare() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSemVerConversion(t *testing.T) {
+	tests := []struct {
+		name        string
+		layout      string
+		semver      semver.SemVer
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Year, month and micro",
+			layout:   "YYYY.0M.MICRO",
+			semver:   semver.SemVer{Major: 2024, Minor: 6, Patch: 1},
+			expected: "2024.06.1",
+		},
+		{
+			name:     "Two segments with modifier",
+			layout:   "YY.0M",
+			semver:   semver.SemVer{Major: 24, Minor: 4, PreRelease: "beta"},
+			expected: "24.04-beta",
+		},
+		{
+			name:        "Patch not representable",
+			layout:      "YY.0M",
+			semver:      semver.SemVer{Major: 24, Minor: 4, Patch: 1},
+			expectError: true,
+		},
+		{
+			name:        "Month out of range",
+			layout:      "YYYY.MM.MICRO",
+			semver:      semver.SemVer{Major: 2024, Minor: 13},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := MustScheme(tt.layout)
+			v, err := scheme.FromSemVer(tt.semver)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if v.String() != tt.expected {
+				t.Errorf("FromSemVer() = %v, want %v", v, tt.expected)
+			}
+
+			// Converting back must yield the original version
+			back, err := v.ToSemVer()
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if back != tt.semver {
+				t.Errorf("ToSemVer() = %v, want %v", back, tt.semver)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package semver
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Channel is the state of a release channel of a self-update system, e.g. "stable" or "beta".
+type Channel struct {
+	// Version is the version currently offered on the channel.
+	Version SemVer
+	// History lists the versions previously offered, oldest first. Rollback returns to the last of them.
+	History []SemVer
+	// Rollout is the percentage of clients offered Version, from 1 to 100. The other clients are offered
+	// the last version of History.
+	Rollout int
+}
+
+// Previous returns the version offered before the current one, or false if there is none.
+func (c Channel) Previous() (SemVer, bool) {
+	if len(c.History) == 0 {
+		return SemVer{}, false
+	}
+	return c.History[len(c.History)-1], true
+}
+
+// VersionFor returns the version offered to a client in the bucket, a number from 0 to 99 the client keeps
+// across update checks, e.g. derived from a hash of its installation ID. During a partial rollout, clients
+// in buckets below the rollout percentage are offered the current version, the others the previous one.
+func (c Channel) VersionFor(bucket int) SemVer {
+	if previous, ok := c.Previous(); ok && bucket >= c.Rollout {
+		return previous
+	}
+	return c.Version
+}
+
+// validate checks that the channel only moved forward and the rollout is a percentage.
+func (c Channel) validate() error {
+	if c.Rollout < 1 || c.Rollout > 100 {
+		return fmt.Errorf("rollout %d is not a percentage from 1 to 100", c.Rollout)
+	}
+	if c.Rollout < 100 && len(c.History) == 0 {
+		return fmt.Errorf("partial rollout of %s without a previous version", c.Version)
+	}
+	versions := append(append([]SemVer(nil), c.History...), c.Version)
+	for i := 1; i < len(versions); i++ {
+		if ComparePrecedence(versions[i-1], versions[i]) >= 0 {
+			return fmt.Errorf("%s follows %s, channels may only move forward", versions[i], versions[i-1])
+		}
+	}
+	return nil
+}
+
+// ChannelManifest is the published state of the release channels of a self-update system,
+// in the spirit of Omaha or Sparkle update feeds. The zero value is an empty manifest ready to use.
+type ChannelManifest struct {
+	Channels map[string]Channel
+}
+
+// Promote offers a new version on the channel to the given percentage of clients, creating the channel if needed.
+// The current version becomes the previous one.
+// It returns an error if the version is not above the current one or the rollout is not a percentage,
+// or if a partial rollout is started on a new channel.
+func (m *ChannelManifest) Promote(name string, v SemVer, rollout int) error {
+	c, exists := m.Channels[name]
+	if exists {
+		c.History = append(c.History[:len(c.History):len(c.History)], c.Version)
+	}
+	c.Version, c.Rollout = v, rollout
+	if err := c.validate(); err != nil {
+		return fmt.Errorf("invalid promotion on channel %s: %w", name, err)
+	}
+
+	if m.Channels == nil {
+		m.Channels = make(map[string]Channel)
+	}
+	m.Channels[name] = c
+	return nil
+}
+
+// SetRollout changes the percentage of clients offered the current version of the channel, e.g. to widen a rollout.
+// It returns an error if the channel does not exist or the rollout is not a valid percentage for it.
+func (m *ChannelManifest) SetRollout(name string, rollout int) error {
+	c, exists := m.Channels[name]
+	if !exists {
+		return fmt.Errorf("invalid rollout: unknown channel %s", name)
+	}
+	c.Rollout = rollout
+	if err := c.validate(); err != nil {
+		return fmt.Errorf("invalid rollout on channel %s: %w", name, err)
+	}
+	m.Channels[name] = c
+	return nil
+}
+
+// Rollback withdraws the current version of the channel and offers the previous one to all clients.
+// It returns the withdrawn version, or an error if the channel does not exist or has no previous version.
+func (m *ChannelManifest) Rollback(name string) (SemVer, error) {
+	c, exists := m.Channels[name]
+	if !exists {
+		return SemVer{}, fmt.Errorf("invalid rollback: unknown channel %s", name)
+	}
+	previous, ok := c.Previous()
+	if !ok {
+		return SemVer{}, fmt.Errorf("invalid rollback on channel %s: no version before %s", name, c.Version)
+	}
+
+	withdrawn := c.Version
+	c.Version, c.History, c.Rollout = previous, c.History[:len(c.History)-1:len(c.History)-1], 100
+	m.Channels[name] = c
+	return withdrawn, nil
+}
+
+// Validate checks that every channel only moved forward, i.e. its history and current version are ascending
+// by precedence, and has a valid rollout percentage. It returns an error describing the first violation.
+func (m ChannelManifest) Validate() error {
+	names := make([]string, 0, len(m.Channels))
+	for name := range m.Channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := m.Channels[name].validate(); err != nil {
+			return fmt.Errorf("invalid channel manifest: channel %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// channelManifestJSON is the JSON representation of a ChannelManifest, e.g.
+//
+//	{
+//	  "channels": {
+//	    "stable": {"version": "1.2.0", "history": ["1.0.0", "1.1.0"], "rollout": 25}
+//	  }
+//	}
+type channelManifestJSON struct {
+	Channels map[string]channelJSON `json:"channels"`
+}
+
+type channelJSON struct {
+	Version string   `json:"version"`
+	History []string `json:"history,omitempty"`
+	Rollout int      `json:"rollout"`
+}
+
+// MarshalJSON encodes the manifest with versions as strings.
+func (m ChannelManifest) MarshalJSON() ([]byte, error) {
+	raw := channelManifestJSON{Channels: make(map[string]channelJSON, len(m.Channels))}
+	for name, c := range m.Channels {
+		cj := channelJSON{Version: c.Version.String(), Rollout: c.Rollout}
+		for _, v := range c.History {
+			cj.History = append(cj.History, v.String())
+		}
+		raw.Channels[name] = cj
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON decodes a manifest encoded by MarshalJSON and validates it like Validate.
+func (m *ChannelManifest) UnmarshalJSON(data []byte) error {
+	var raw channelManifestJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	manifest := ChannelManifest{Channels: make(map[string]Channel, len(raw.Channels))}
+	for name, cj := range raw.Channels {
+		c := Channel{Rollout: cj.Rollout}
+		var err error
+		if c.Version, err = Parse(cj.Version); err != nil {
+			return fmt.Errorf("invalid channel manifest: channel %s: %w", name, err)
+		}
+		for _, s := range cj.History {
+			v, err := Parse(s)
+			if err != nil {
+				return fmt.Errorf("invalid channel manifest: channel %s: %w", name, err)
+			}
+			c.History = append(c.History, v)
+		}
+		manifest.Channels[name] = c
+	}
+	if err := manifest.Validate(); err != nil {
+		return err
+	}
+
+	*m = manifest
+	return nil
+}
@@ -0,0 +1,125 @@
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestChannelManifest(t *testing.T) {
+	var m ChannelManifest
+
+	// Start a channel and roll out versions
+	if err := m.Promote("stable", mustParse(t, "1.0.0"), 100); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if err := m.Promote("stable", mustParse(t, "1.1.0"), 25); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	stable := m.Channels["stable"]
+	if result := stable.VersionFor(10); result.String() != "1.1.0" {
+		t.Errorf("VersionFor(10) = %v, want %v", result, "1.1.0")
+	}
+	if result := stable.VersionFor(25); result.String() != "1.0.0" {
+		t.Errorf("VersionFor(25) = %v, want %v", result, "1.0.0")
+	}
+	if err := m.SetRollout("stable", 100); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if result := m.Channels["stable"].VersionFor(99); result.String() != "1.1.0" {
+		t.Errorf("VersionFor(99) = %v, want %v", result, "1.1.0")
+	}
+
+	// Channels only move forward
+	for _, tt := range []struct {
+		name    string
+		version string
+		rollout int
+	}{
+		{"Same version", "1.1.0", 100},
+		{"Lower version", "1.0.5", 100},
+		{"Build metadata only", "1.1.0+rebuild", 100},
+		{"Rollout above 100", "1.2.0", 101},
+		{"Rollout of zero", "1.2.0", 0},
+	} {
+		if err := m.Promote("stable", mustParse(t, tt.version), tt.rollout); err == nil {
+			t.Errorf("%s: Expected error but got none", tt.name)
+		}
+	}
+	if err := m.Promote("beta", mustParse(t, "1.2.0-beta.1"), 50); err == nil {
+		t.Errorf("Partial rollout on a new channel: Expected error but got none")
+	}
+	if result := m.Channels["stable"]; result.Version.String() != "1.1.0" || len(result.History) != 1 {
+		t.Errorf("rejected promotions changed the channel to %+v", result)
+	}
+
+	// Roll back to the previous version
+	if err := m.Promote("stable", mustParse(t, "1.2.0"), 10); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	withdrawn, err := m.Rollback("stable")
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if withdrawn.String() != "1.2.0" {
+		t.Errorf("Rollback() = %v, want %v", withdrawn, "1.2.0")
+	}
+	if result := m.Channels["stable"]; result.Version.String() != "1.1.0" || result.Rollout != 100 || len(result.History) != 1 {
+		t.Errorf("channel after Rollback() = %+v", result)
+	}
+	if _, err := m.Rollback("stable"); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if _, err := m.Rollback("stable"); err == nil {
+		t.Errorf("Rollback without history: Expected error but got none")
+	}
+	if _, err := m.Rollback("nightly"); err == nil {
+		t.Errorf("Rollback of unknown channel: Expected error but got none")
+	}
+	if err := m.SetRollout("nightly", 50); err == nil {
+		t.Errorf("SetRollout of unknown channel: Expected error but got none")
+	}
+	if err := m.Validate(); err != nil {
+		t.Errorf("Did not expect error but got: %v", err)
+	}
+}
+
+func TestChannelManifestJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expectError bool
+	}{
+		{name: "Valid manifest", data: `{"channels":{"beta":{"version":"2.0.0-beta.2","history":["2.0.0-beta.1"],"rollout":50},"stable":{"version":"1.2.0","history":["1.0.0","1.1.0"],"rollout":100}}}`},
+		{name: "Empty manifest", data: `{"channels":{}}`},
+		{name: "Moving backward", data: `{"channels":{"stable":{"version":"1.0.0","history":["1.1.0"],"rollout":100}}}`, expectError: true},
+		{name: "Invalid rollout", data: `{"channels":{"stable":{"version":"1.0.0","rollout":0}}}`, expectError: true},
+		{name: "Invalid version", data: `{"channels":{"stable":{"version":"1.0","rollout":100}}}`, expectError: true},
+		{name: "Invalid history", data: `{"channels":{"stable":{"version":"1.0.0","history":["x"],"rollout":100}}}`, expectError: true},
+		{name: "Malformed JSON", data: `{"channels":`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m ChannelManifest
+			err := json.Unmarshal([]byte(tt.data), &m)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+
+			// The manifest encodes back to the same JSON
+			data, err := json.Marshal(m)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if string(data) != tt.data {
+				t.Errorf("Marshal() = %s, want %s", data, tt.data)
+			}
+		})
+	}
+}
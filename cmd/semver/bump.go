@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runBump prints the next major, minor or patch version.
+func runBump(e env, args []string) int {
+	fs := newFlagSet(e, "bump")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		return usageError(e, fs, "expected a component and a version")
+	}
+
+	v, err := semver.Parse(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver bump: %v\n", err)
+		return exitFailure
+	}
+
+	switch fs.Arg(0) {
+	case "major":
+		v = v.BumpMajor()
+	case "minor":
+		v = v.BumpMinor()
+	case "patch":
+		v = v.BumpPatch()
+	default:
+		return usageError(e, fs, "unknown component %q, expected major, minor or patch", fs.Arg(0))
+	}
+
+	fmt.Fprintln(e.stdout, v)
+	return exitOK
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestBump(t *testing.T) {
+	runCommandTests(t, []commandTest{
+		{
+			name:           "Major",
+			args:           []string{"bump", "major", "1.2.3"},
+			expectedCode:   exitOK,
+			expectedStdout: "2.0.0\n",
+		},
+		{
+			name:           "Minor",
+			args:           []string{"bump", "minor", "1.2.3+build"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.3.0\n",
+		},
+		{
+			name:           "Patch of pre-release",
+			args:           []string{"bump", "patch", "1.2.3-rc.1"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.2.3\n",
+		},
+		{
+			name:         "Unknown component",
+			args:         []string{"bump", "micro", "1.2.3"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "Invalid version",
+			args:         []string{"bump", "major", "1.2"},
+			expectedCode: exitFailure,
+		},
+	})
+}
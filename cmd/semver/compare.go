@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runCompare prints the result of comparing two versions by precedence, as semver.ComparePrecedence: -1, 0 or 1.
+func runCompare(e env, args []string) int {
+	fs := newFlagSet(e, "compare")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		return usageError(e, fs, "expected exactly two versions")
+	}
+
+	versions := make([]semver.SemVer, 2)
+	for i, arg := range fs.Args() {
+		v, err := semver.Parse(arg)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver compare: %v\n", err)
+			return exitFailure
+		}
+		versions[i] = v
+	}
+
+	fmt.Fprintln(e.stdout, semver.ComparePrecedence(versions[0], versions[1]))
+	return exitOK
+}
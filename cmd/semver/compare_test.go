@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	runCommandTests(t, []commandTest{
+		{
+			name:           "Lower",
+			args:           []string{"compare", "1.0.0-alpha", "1.0.0"},
+			expectedCode:   exitOK,
+			expectedStdout: "-1\n",
+		},
+		{
+			name:           "Equal ignoring build metadata",
+			args:           []string{"compare", "1.0.0+a", "1.0.0+b"},
+			expectedCode:   exitOK,
+			expectedStdout: "0\n",
+		},
+		{
+			name:           "Higher",
+			args:           []string{"compare", "1.10.0", "1.9.0"},
+			expectedCode:   exitOK,
+			expectedStdout: "1\n",
+		},
+		{
+			name:           "Pre-release of a higher core",
+			args:           []string{"compare", "2.0.0-rc.1", "1.0.0"},
+			expectedCode:   exitOK,
+			expectedStdout: "1\n",
+		},
+		{
+			name:         "Invalid version",
+			args:         []string{"compare", "1.10", "1.9.0"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Missing version",
+			args:         []string{"compare", "1.9.0"},
+			expectedCode: exitUsage,
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runMax prints the highest of the versions.
+func runMax(e env, args []string) int {
+	return runExtreme(e, "max", args, 1)
+}
+
+// runMin prints the lowest of the versions.
+func runMin(e env, args []string) int {
+	return runExtreme(e, "min", args, -1)
+}
+
+// runExtreme prints the version comparing with the given sign against all others,
+// 1 for the highest and -1 for the lowest. Unlike "sort -V | tail -1" it orders
+// pre-releases by precedence, so 1.0.0 is higher than 1.0.0-rc.1.
+// It fails without output if any version is invalid.
+func runExtreme(e env, name string, args []string, sign int) int {
+	fs := newFlagSet(e, name)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	lines, err := readLines(e, fs.Args())
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver %s: %v\n", name, err)
+		return exitFailure
+	}
+	if len(lines) == 0 {
+		return usageError(e, fs, "expected at least one version")
+	}
+
+	var extreme semver.SemVer
+	for i, line := range lines {
+		v, err := semver.Parse(line)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver %s: %v\n", name, err)
+			return exitFailure
+		}
+		if i == 0 || semver.ComparePrecedence(v, extreme) == sign {
+			extreme = v
+		}
+	}
+
+	fmt.Fprintln(e.stdout, extreme)
+	return exitOK
+}
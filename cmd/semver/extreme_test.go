@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestMaxMin(t *testing.T) {
+	versions := "1.0.0-rc.1\n1.10.0\n1.9.0\n1.10.0-rc.1\n1.0.0\n"
+
+	runCommandTests(t, []commandTest{
+		{
+			name:           "Max from stdin",
+			args:           []string{"max"},
+			stdin:          versions,
+			expectedCode:   exitOK,
+			expectedStdout: "1.10.0\n",
+		},
+		{
+			name:           "Min from stdin",
+			args:           []string{"min"},
+			stdin:          versions,
+			expectedCode:   exitOK,
+			expectedStdout: "1.0.0-rc.1\n",
+		},
+		{
+			name:           "Max from arguments",
+			args:           []string{"max", "1.0.0-rc.1", "1.0.0-rc.10", "1.0.0-rc.2"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.0.0-rc.10\n",
+		},
+		{
+			name:           "Max pre-release of a higher version",
+			args:           []string{"max", "1.0.0", "2.0.0-rc.1"},
+			expectedCode:   exitOK,
+			expectedStdout: "2.0.0-rc.1\n",
+		},
+		{
+			name:           "Min release of a lower version",
+			args:           []string{"min", "2.0.0-rc.1", "1.0.0"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.0.0\n",
+		},
+		{
+			name:           "Min of a single version",
+			args:           []string{"min", "1.2.3+build"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.2.3+build\n",
+		},
+		{
+			name:         "Invalid version",
+			args:         []string{"max", "1.0.0", "v1.1.0"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "No versions",
+			args:         []string{"min"},
+			expectedCode: exitUsage,
+		},
+	})
+}
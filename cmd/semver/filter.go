@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runFilter prints the versions from stdin that satisfy a constraint, sorted by precedence.
+// Invalid and non-matching lines are dropped, and reported on stderr with -report.
+func runFilter(e env, args []string) int {
+	fs := newFlagSet(e, "filter")
+	report := fs.Bool("report", false, "report dropped lines and the reason on stderr")
+	reverse := fs.Bool("r", false, "sort in descending order")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 1 {
+		return usageError(e, fs, "expected exactly one constraint")
+	}
+
+	c, err := semver.ParseConstraint(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver filter: %v\n", err)
+		return exitFailure
+	}
+
+	lines, err := readLines(e, nil)
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver filter: %v\n", err)
+		return exitFailure
+	}
+
+	// Keep the original lines next to the parsed versions so they are printed unchanged
+	type match struct {
+		line    string
+		version semver.SemVer
+	}
+	var matches []match
+	for _, line := range lines {
+		v, err := semver.Parse(line)
+		if err != nil {
+			if *report {
+				fmt.Fprintf(e.stderr, "semver filter: dropped %s: %v\n", line, err)
+			}
+			continue
+		}
+		if !c.Allows(v) {
+			if *report {
+				fmt.Fprintf(e.stderr, "semver filter: dropped %s: does not satisfy %s\n", line, c)
+			}
+			continue
+		}
+		matches = append(matches, match{line, v})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if *reverse {
+			return semver.ComparePrecedence(matches[i].version, matches[j].version) > 0
+		}
+		return semver.ComparePrecedence(matches[i].version, matches[j].version) < 0
+	})
+
+	for _, m := range matches {
+		fmt.Fprintln(e.stdout, m.line)
+	}
+	return exitOK
+}
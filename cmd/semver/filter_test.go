@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	tags := "latest\nv1.0.0\n1.10.0\n1.2.0\n2.0.0\n1.2.0-rc.1\ntest-tag\n\n1.9.9\n"
+
+	runCommandTests(t, []commandTest{
+		{
+			name:           "Caret range",
+			args:           []string{"filter", "^1.2"},
+			stdin:          tags,
+			expectedCode:   exitOK,
+			expectedStdout: "1.2.0\n1.9.9\n1.10.0\n",
+		},
+		{
+			name:           "Descending",
+			args:           []string{"filter", "-r", ">=1.9.9"},
+			stdin:          tags,
+			expectedCode:   exitOK,
+			expectedStdout: "2.0.0\n1.10.0\n1.9.9\n",
+		},
+		{
+			name:           "Pre-release of a higher core sorts after lower releases",
+			args:           []string{"filter", ">=1.5.0 || >=2.0.0-rc.1 <2.0.0"},
+			stdin:          "2.0.0-rc.1\n1.5.0\n1.0.0\n",
+			expectedCode:   exitOK,
+			expectedStdout: "1.5.0\n2.0.0-rc.1\n",
+		},
+		{
+			name:           "Pre-release named in constraint",
+			args:           []string{"filter", ">=1.2.0-rc.1 <1.3.0"},
+			stdin:          tags,
+			expectedCode:   exitOK,
+			expectedStdout: "1.2.0-rc.1\n1.2.0\n",
+		},
+		{
+			name:           "Nothing matches",
+			args:           []string{"filter", ">=3"},
+			stdin:          tags,
+			expectedCode:   exitOK,
+			expectedStdout: "",
+		},
+		{
+			name:         "Invalid constraint",
+			args:         []string{"filter", ">=1.2.3.4"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Missing constraint",
+			args:         []string{"filter"},
+			expectedCode: exitUsage,
+		},
+	})
+}
+
+func TestFilterReport(t *testing.T) {
+	code, stdout, stderr := runCommand([]string{"filter", "-report", "1.x"}, "1.0.0\nlatest\n2.0.0\n")
+	if code != exitOK {
+		t.Errorf("exit code = %v, want %v", code, exitOK)
+	}
+	if stdout != "1.0.0\n" {
+		t.Errorf("stdout = %q, want %q", stdout, "1.0.0\n")
+	}
+	for _, dropped := range []string{"dropped latest", "dropped 2.0.0: does not satisfy 1.x"} {
+		if !strings.Contains(stderr, dropped) {
+			t.Errorf("stderr = %q, want it to contain %q", stderr, dropped)
+		}
+	}
+}
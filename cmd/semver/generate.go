@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"strconv"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runGenerate writes a Go file declaring the version read from a VERSION file or the latest git tag,
+// validated at generation time and type checked as a semver.SemVer at compile time:
+//
+//	//go:generate go run github.com/mkyc/go-semver/cmd/semver generate -file VERSION
+//
+// The package defaults to $GOPACKAGE, which go generate sets.
+func runGenerate(e env, args []string) int {
+	fs := newFlagSet(e, "generate")
+	file := fs.String("file", "", "read the version from this VERSION file")
+	dir := fs.String("git", "", "read the version from the latest version tag of this git repository")
+	output := fs.String("o", "version.go", "output file, or - for stdout")
+	pkg := fs.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	name := fs.String("name", "Version", "name of the generated variable, the string constant is named <name>String")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 0 {
+		return usageError(e, fs, "unexpected arguments")
+	}
+	if (*file == "") == (*dir == "") {
+		return usageError(e, fs, "expected exactly one of -file and -git")
+	}
+	if *pkg == "" {
+		return usageError(e, fs, "expected -package outside of go generate")
+	}
+	if !token.IsIdentifier(*pkg) || !token.IsIdentifier(*name) {
+		return usageError(e, fs, "package and variable names must be Go identifiers")
+	}
+
+	var v semver.SemVer
+	var source string
+	if *file != "" {
+		f, err := semver.ReadVersionFile(*file)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver generate: %v\n", err)
+			return exitFailure
+		}
+		v, source = f.Version, *file
+	} else {
+		tags, err := gitVersionTags(*dir)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver generate: %v\n", err)
+			return exitFailure
+		}
+		latest, ok := latestVersionTag(tags)
+		if !ok {
+			fmt.Fprintf(e.stderr, "semver generate: no version tag in %s\n", *dir)
+			return exitFailure
+		}
+		v, source = latest.version, "git tag "+latest.name
+	}
+
+	src, err := generateVersionSource(*pkg, *name, source, v)
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver generate: %v\n", err)
+		return exitFailure
+	}
+
+	if *output == "-" {
+		e.stdout.Write(src)
+		return exitOK
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		fmt.Fprintf(e.stderr, "semver generate: %v\n", err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+// generateVersionSource returns the formatted source of a file declaring the version as a
+// semver.SemVer variable and a string constant.
+func generateVersionSource(pkg, name, source string, v semver.SemVer) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by semver generate from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import semver %q\n\n", "github.com/mkyc/go-semver")
+	fmt.Fprintf(&b, "// %sString is the version of this module.\n", name)
+	fmt.Fprintf(&b, "const %sString = %q\n\n", name, v.String())
+	fmt.Fprintf(&b, "// %s is the version of this module.\n", name)
+	fmt.Fprintf(&b, "var %s = semver.SemVer{Major: %d, Minor: %d, Patch: %d, PreRelease: %s, Build: %s}\n",
+		name, v.Major, v.Minor, v.Patch, strconv.Quote(v.PreRelease), strconv.Quote(v.Build))
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	versionFile := filepath.Join(dir, "VERSION")
+	if err := os.WriteFile(versionFile, []byte("v1.2.3-rc.1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	runCommandTests(t, []commandTest{
+		{
+			name:         "VERSION file",
+			args:         []string{"generate", "-file", versionFile, "-package", "app", "-o", "-"},
+			expectedCode: exitOK,
+			expectedStdout: "// Code generated by semver generate from " + versionFile + "; DO NOT EDIT.\n" +
+				"\n" +
+				"package app\n" +
+				"\n" +
+				"import semver \"github.com/mkyc/go-semver\"\n" +
+				"\n" +
+				"// VersionString is the version of this module.\n" +
+				"const VersionString = \"1.2.3-rc.1\"\n" +
+				"\n" +
+				"// Version is the version of this module.\n" +
+				"var Version = semver.SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: \"rc.1\", Build: \"\"}\n",
+		},
+		{
+			name:         "Missing VERSION file",
+			args:         []string{"generate", "-file", filepath.Join(dir, "missing"), "-package", "app"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "No source",
+			args:         []string{"generate", "-package", "app"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "Both sources",
+			args:         []string{"generate", "-file", versionFile, "-git", dir, "-package", "app"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "Invalid name",
+			args:         []string{"generate", "-file", versionFile, "-package", "app", "-name", "my-version"},
+			expectedCode: exitUsage,
+		},
+	})
+}
+
+func TestGenerateGit(t *testing.T) {
+	r := newGitRepo(t)
+	r.commit("feat: initial implementation")
+	r.tag("v0.9.0")
+	r.tag("v1.0.0")
+	r.tag("v1.1.0-rc.1")
+
+	output := filepath.Join(t.TempDir(), "version.go")
+	code, _, stderr := runCommand([]string{"generate", "-git", r.dir, "-package", "main", "-name", "version", "-o", output}, "")
+	if code != exitOK {
+		t.Fatalf("exit code = %v, want %v (stderr: %s)", code, exitOK, stderr)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "// Code generated by semver generate from git tag v1.0.0; DO NOT EDIT.\n" +
+		"\n" +
+		"package main\n" +
+		"\n" +
+		"import semver \"github.com/mkyc/go-semver\"\n" +
+		"\n" +
+		"// versionString is the version of this module.\n" +
+		"const versionString = \"1.0.0\"\n" +
+		"\n" +
+		"// version is the version of this module.\n" +
+		"var version = semver.SemVer{Major: 1, Minor: 0, Patch: 0, PreRelease: \"\", Build: \"\"}\n"
+	if string(data) != expected {
+		t.Errorf("generated = %q, want %q", data, expected)
+	}
+
+	// A repository without version tags cannot be used
+	empty := newGitRepo(t)
+	empty.commit("chore: initial commit")
+	if code, _, _ := runCommand([]string{"generate", "-git", empty.dir, "-package", "main", "-o", "-"}, ""); code != exitFailure {
+		t.Errorf("exit code without tags = %v, want %v", code, exitFailure)
+	}
+}
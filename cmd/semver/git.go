@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// git runs a git command in the given repository and returns its trimmed output.
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// gitTags returns the tags of the repository reachable from HEAD.
+func gitTags(dir string) ([]string, error) {
+	out, err := git(dir, "tag", "--merged", "HEAD")
+	if err != nil || out == "" {
+		return nil, err
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+// gitCommitMessages returns the full messages of the commits reachable from HEAD but not from since.
+// All commits reachable from HEAD are returned if since is empty.
+func gitCommitMessages(dir, since string) ([]string, error) {
+	revision := "HEAD"
+	if since != "" {
+		revision = since + "..HEAD"
+	}
+	// Messages are separated by NUL, as they may span several lines
+	out, err := git(dir, "log", "--format=%B%x00", revision)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, message := range strings.Split(out, "\x00") {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+// gitShortSHA returns the abbreviated commit hash of HEAD.
+func gitShortSHA(dir string) (string, error) {
+	return git(dir, "rev-parse", "--short", "HEAD")
+}
+
+// versionTag is a git tag naming a version, with or without a "v" prefix.
+type versionTag struct {
+	name    string
+	version semver.SemVer
+}
+
+// gitVersionTags returns the tags reachable from HEAD that name a version, in no particular order.
+func gitVersionTags(dir string) ([]versionTag, error) {
+	tags, err := gitTags(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []versionTag
+	for _, tag := range tags {
+		if v, err := semver.Parse(strings.TrimPrefix(tag, "v")); err == nil {
+			result = append(result, versionTag{tag, v})
+		}
+	}
+	return result, nil
+}
+
+// latestVersionTag returns the tag with the highest version, so a release takes precedence over any pre-release.
+// It returns false if there are no tags.
+func latestVersionTag(tags []versionTag) (versionTag, bool) {
+	if len(tags) == 0 {
+		return versionTag{}, false
+	}
+	latest := tags[0]
+	for _, tag := range tags[1:] {
+		if tag.version.Compare(latest.version) > 0 {
+			latest = tag
+		}
+	}
+	return latest, true
+}
+
+// gitVersionTagName returns the name of the tag naming the version, with or without a "v" prefix.
+func gitVersionTagName(dir string, v semver.SemVer) (string, error) {
+	for _, name := range []string{v.StringV(), v.String()} {
+		if _, err := git(dir, "rev-parse", "--verify", "--quiet", "refs/tags/"+name); err == nil {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("no tag for version %s", v)
+}
+
+// gitCommits returns the commits reachable from the revision to but not from the revision from, newest first.
+func gitCommits(dir, from, to string) ([]semver.Commit, error) {
+	// Commits are separated by NUL, the hash from the message by a line break
+	out, err := git(dir, "log", "--format=%h%n%B%x00", from+".."+to)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []semver.Commit
+	for _, entry := range strings.Split(out, "\x00") {
+		hash, message, _ := strings.Cut(strings.TrimSpace(entry), "\n")
+		if hash != "" {
+			commits = append(commits, semver.Commit{Hash: hash, Message: strings.TrimSpace(message)})
+		}
+	}
+	return commits, nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// versionPattern matches version candidates in arbitrary text, with an optional "v" prefix.
+// Candidates are validated with semver.Parse, which also rejects leading zeros in numeric identifiers.
+var versionPattern = regexp.MustCompile(`v?\d+\.\d+\.\d+(?:-[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?(?:\+[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?`)
+
+// runGrep prints every version found in the files as "file:line:version", optionally only those
+// satisfying a constraint. It succeeds if at least one version was found, like grep.
+func runGrep(e env, args []string) int {
+	fs := newFlagSet(e, "grep")
+	constraint := fs.String("constraint", "", "only print versions satisfying this constraint")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	var c *semver.Constraint
+	if *constraint != "" {
+		parsed, err := semver.ParseConstraint(*constraint)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver grep: %v\n", err)
+			return exitFailure
+		}
+		c = &parsed
+	}
+
+	found := false
+	report := func(name string, r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		for line := 1; scanner.Scan(); line++ {
+			for _, match := range findVersions(scanner.Text()) {
+				if c != nil && !c.Allows(match.version) {
+					continue
+				}
+				found = true
+				fmt.Fprintf(e.stdout, "%s:%d:%s\n", name, line, match.text)
+			}
+		}
+		return scanner.Err()
+	}
+
+	if fs.NArg() == 0 {
+		if err := report("(standard input)", e.stdin); err != nil {
+			fmt.Fprintf(e.stderr, "semver grep: %v\n", err)
+			return exitFailure
+		}
+	}
+
+	result := exitOK
+	for _, name := range fs.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver grep: %v\n", err)
+			result = exitFailure
+			continue
+		}
+		err = report(name, f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver grep: %s: %v\n", name, err)
+			result = exitFailure
+		}
+	}
+
+	if !found {
+		return exitFailure
+	}
+	return result
+}
+
+// versionMatch is a version found in a line of text.
+type versionMatch struct {
+	text    string
+	version semver.SemVer
+}
+
+// findVersions returns the valid versions in a line of text. A candidate must stand on its own,
+// so the "1.2.3" in "a1.2.3" or "1.2.3.4" is not reported.
+func findVersions(line string) []versionMatch {
+	var matches []versionMatch
+	for _, loc := range versionPattern.FindAllStringIndex(line, -1) {
+		start, end := loc[0], loc[1]
+
+		// Check the candidate is not part of a longer word or dotted number
+		if start > 0 && (isWordByte(line[start-1]) || line[start-1] == '.') {
+			continue
+		}
+		if end < len(line) && (isWordByte(line[end]) || line[end] == '.' && end+1 < len(line) && isWordByte(line[end+1])) {
+			continue
+		}
+
+		text := line[start:end]
+		v, err := semver.Parse(strings.TrimPrefix(text, "v"))
+		if err != nil {
+			continue
+		}
+		matches = append(matches, versionMatch{text, v})
+	}
+	return matches
+}
+
+// isWordByte reports whether b is alphanumeric or "_".
+func isWordByte(b byte) bool {
+	return b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b == '_'
+}
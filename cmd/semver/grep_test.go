@@ -0,0 +1,87 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFindVersions(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected []string
+	}{
+		{"FROM golang:1.22.3-alpine AS build", []string{"1.22.3-alpine"}},
+		{"VERSION ?= v1.2.3", []string{"v1.2.3"}},
+		{"  image: app:2.0.0-rc.1+build.5", []string{"2.0.0-rc.1+build.5"}},
+		{"upgrade from 1.0.0 to 1.1.0.", []string{"1.0.0", "1.1.0"}},
+		{"ip 10.0.0.1", nil},
+		{"dev1.2.3 and .1.2.3", nil},
+		{"leading zero 01.2.3 and 1.2.3-01", nil},
+		{"partial 1.2", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			var result []string
+			for _, match := range findVersions(tt.line) {
+				result = append(result, match.text)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("findVersions() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGrep(t *testing.T) {
+	dir := t.TempDir()
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	if err := os.WriteFile(dockerfile, []byte("FROM golang:1.22.3 AS build\nRUN make\nFROM alpine:3.19.1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	makefile := filepath.Join(dir, "Makefile")
+	if err := os.WriteFile(makefile, []byte("VERSION ?= v2.0.0-rc.1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	runCommandTests(t, []commandTest{
+		{
+			name:           "Files",
+			args:           []string{"grep", dockerfile, makefile},
+			expectedCode:   exitOK,
+			expectedStdout: dockerfile + ":1:1.22.3\n" + dockerfile + ":3:3.19.1\n" + makefile + ":1:v2.0.0-rc.1\n",
+		},
+		{
+			name:           "Constraint",
+			args:           []string{"grep", "-constraint", "^3", dockerfile, makefile},
+			expectedCode:   exitOK,
+			expectedStdout: dockerfile + ":3:3.19.1\n",
+		},
+		{
+			name:           "Standard input",
+			args:           []string{"grep"},
+			stdin:          "no version\nversion: 0.1.0\n",
+			expectedCode:   exitOK,
+			expectedStdout: "(standard input):2:0.1.0\n",
+		},
+		{
+			name:         "No versions",
+			args:         []string{"grep", "-constraint", ">=4"},
+			stdin:        "FROM alpine:3.19.1\n",
+			expectedCode: exitFailure,
+		},
+		{
+			name:           "Missing file",
+			args:           []string{"grep", filepath.Join(dir, "missing"), makefile},
+			expectedCode:   exitFailure,
+			expectedStdout: makefile + ":1:v2.0.0-rc.1\n",
+		},
+		{
+			name:         "Invalid constraint",
+			args:         []string{"grep", "-constraint", "=>1"},
+			expectedCode: exitFailure,
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runImpact lists the commits between the tags of two versions classified as breaking, feature and fix,
+// and fails if the bump between the versions does not match them.
+func runImpact(e env, args []string) int {
+	fs := newFlagSet(e, "impact")
+	dir := fs.String("C", ".", "path of the git repository")
+	breakingBumpsMinor := fs.Bool("zero-minor", false, "expect breaking changes to bump 0.y.z versions to 0.(y+1).0")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		return usageError(e, fs, "expected exactly two versions")
+	}
+
+	var versions [2]semver.SemVer
+	var tags [2]string
+	for i := range versions {
+		v, err := semver.ParseWith(fs.Arg(i), semver.WithVPrefix(semver.VPrefixAllow))
+		if err != nil {
+			return usageError(e, fs, "%v", err)
+		}
+		if tags[i], err = gitVersionTagName(*dir, v); err != nil {
+			fmt.Fprintf(e.stderr, "semver impact: %v\n", err)
+			return exitFailure
+		}
+		versions[i] = v
+	}
+
+	commits, err := gitCommits(*dir, tags[0], tags[1])
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver impact: %v\n", err)
+		return exitFailure
+	}
+	impact := semver.AnalyzeImpact(versions[0], versions[1], commits)
+
+	// Print the releasable commits by category
+	for _, category := range []struct {
+		title   string
+		commits []semver.Commit
+	}{
+		{"Breaking changes", impact.Breaking},
+		{"Features", impact.Features},
+		{"Fixes", impact.Fixes},
+	} {
+		if len(category.commits) == 0 {
+			continue
+		}
+		fmt.Fprintf(e.stdout, "%s:\n", category.title)
+		for _, c := range category.commits {
+			fmt.Fprintf(e.stdout, "  %s %s\n", c.Hash, c.Subject())
+		}
+	}
+	fmt.Fprintf(e.stdout, "%s bump from %s to %s, %s bump required\n", impact.Actual(), versions[0], versions[1], impact.Required())
+
+	if err := impact.Check(semver.ZeroPolicy{BreakingBumpsMinor: *breakingBumpsMinor}); err != nil {
+		fmt.Fprintf(e.stderr, "semver impact: %v\n", err)
+		return exitFailure
+	}
+	return exitOK
+}
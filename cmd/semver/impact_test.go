@@ -0,0 +1,67 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestImpact(t *testing.T) {
+	r := newGitRepo(t)
+	r.commit("feat: initial implementation")
+	r.tag("v1.0.0")
+	r.commit("fix: crash")
+	r.commit("docs: typo")
+	r.tag("v1.0.1")
+	r.commit("feat!: new API")
+	r.tag("1.0.2")
+
+	// Abbreviated hashes differ between runs
+	hash := regexp.MustCompile(`(?m)^  [0-9a-f]+ `)
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectedCode   int
+		expectedStdout string
+	}{
+		{
+			name:           "Matching bump",
+			args:           []string{"impact", "-C", r.dir, "1.0.0", "1.0.1"},
+			expectedCode:   exitOK,
+			expectedStdout: "Fixes:\n  <hash> fix: crash\npatch bump from 1.0.0 to 1.0.1, patch bump required\n",
+		},
+		{
+			name:           "Breaking change with a patch bump",
+			args:           []string{"impact", "-C", r.dir, "v1.0.1", "1.0.2"},
+			expectedCode:   exitFailure,
+			expectedStdout: "Breaking changes:\n  <hash> feat!: new API\npatch bump from 1.0.1 to 1.0.2, major bump required\n",
+		},
+		{
+			name:         "Missing tag",
+			args:         []string{"impact", "-C", r.dir, "1.0.0", "2.0.0"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Invalid version",
+			args:         []string{"impact", "-C", r.dir, "1.0", "1.0.1"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "Missing version",
+			args:         []string{"impact", "1.0.0"},
+			expectedCode: exitUsage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCommand(tt.args, "")
+			if code != tt.expectedCode {
+				t.Errorf("exit code = %v, want %v (stderr: %s)", code, tt.expectedCode, stderr)
+			}
+			if stdout = hash.ReplaceAllString(stdout, "  <hash> "); stdout != tt.expectedStdout {
+				t.Errorf("stdout = %q, want %q", stdout, tt.expectedStdout)
+			}
+		})
+	}
+}
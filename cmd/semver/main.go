@@ -0,0 +1,144 @@
+// Command semver parses, validates, compares, sorts and bumps semantic versions
+// with exactly the same semantics as the github.com/mkyc/go-semver package,
+// so shell scripts and CI jobs agree with Go callers.
+//
+// Usage:
+//
+//	semver <command> [arguments]
+//
+// Run "semver help" for the list of commands.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// Exit codes shared by all commands.
+// Commands answering a yes or no question, like satisfies, exit with exitInvalid on malformed input,
+// so scripts can tell a negative answer from an error.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+	exitInvalid = 3
+)
+
+// env bundles the standard streams a command reads from and writes to.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// command is a single subcommand of the CLI.
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(e env, args []string) int
+}
+
+// commands lists all subcommands in the order they are shown in the help output.
+// It is populated in init, as the commands themselves refer to it for their usage output.
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "parse", usage: "parse [-json] [-github] <version>", summary: "print the components of a version", run: runParse},
+		{name: "validate", usage: "validate [versions...]", summary: "check that versions are valid, reading stdin if none are given", run: runValidate},
+		{name: "compare", usage: "compare <version> <version>", summary: "print -1, 0 or 1 comparing two versions by precedence", run: runCompare},
+		{name: "sort", usage: "sort [-r] [versions...]", summary: "sort versions by precedence, reading stdin if none are given", run: runSort},
+		{name: "max", usage: "max [versions...]", summary: "print the highest version, reading stdin if none are given", run: runMax},
+		{name: "min", usage: "min [versions...]", summary: "print the lowest version, reading stdin if none are given", run: runMin},
+		{name: "bump", usage: "bump <major|minor|patch> <version>", summary: "print the next version", run: runBump},
+		{name: "filter", usage: "filter [-r] [-report] <constraint>", summary: "print the versions from stdin satisfying a constraint, sorted", run: runFilter},
+		{name: "next", usage: "next [-C dir] [-pre id] [-build meta] [-github]", summary: "print the next version of a git repository from its tags and commits", run: runNext},
+		{name: "impact", usage: "impact [-C dir] [-zero-minor] <version> <version>", summary: "list the commits between the tags of two versions and exit with 1 if the bump does not match them", run: runImpact},
+		{name: "satisfies", usage: "satisfies <version> <constraint>", summary: "exit with 0 if the version satisfies the constraint, 1 if not and 3 if either is invalid", run: runSatisfies},
+		{name: "grep", usage: "grep [-constraint c] [files...]", summary: "print the versions found in files with their location, reading stdin if none are given", run: runGrep},
+		{name: "generate", usage: "generate [-file VERSION | -git dir] [-o file]", summary: "write a Go file declaring the version, for use with go:generate", run: runGenerate},
+	}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], env{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}))
+}
+
+// run dispatches the arguments to the matching command and returns the exit code.
+func run(args []string, e env) int {
+	if len(args) == 0 {
+		printUsage(e.stderr)
+		return exitUsage
+	}
+
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(e.stdout)
+		return exitOK
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(e, args[1:])
+		}
+	}
+
+	fmt.Fprintf(e.stderr, "semver: unknown command %q\n", args[0])
+	printUsage(e.stderr)
+	return exitUsage
+}
+
+// printUsage writes the list of commands.
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: semver <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(tw, "  %s\t%s\n", cmd.usage, cmd.summary)
+	}
+	tw.Flush()
+}
+
+// newFlagSet returns a flag set for a command that reports errors instead of exiting.
+func newFlagSet(e env, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		for _, cmd := range commands {
+			if cmd.name == name {
+				fmt.Fprintf(e.stderr, "Usage: semver %s\n", cmd.usage)
+			}
+		}
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// usageError reports wrong usage of a command and returns the usage exit code.
+func usageError(e env, fs *flag.FlagSet, format string, args ...any) int {
+	fmt.Fprintf(e.stderr, "semver %s: %s\n", fs.Name(), fmt.Sprintf(format, args...))
+	fs.Usage()
+	return exitUsage
+}
+
+// readLines returns the arguments if there are any, otherwise the non-empty lines of stdin.
+func readLines(e env, args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(e.stdin)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// runCommand runs the CLI with the given arguments and stdin and returns the exit code and both outputs.
+func runCommand(args []string, stdin string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, env{stdin: strings.NewReader(stdin), stdout: &stdout, stderr: &stderr})
+	return code, stdout.String(), stderr.String()
+}
+
+// commandTest describes a single CLI invocation and its expected outcome.
+type commandTest struct {
+	name           string
+	args           []string
+	stdin          string
+	expectedCode   int
+	expectedStdout string
+}
+
+// runCommandTests runs a table of CLI invocations.
+func runCommandTests(t *testing.T, tests []commandTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCommand(tt.args, tt.stdin)
+			if code != tt.expectedCode {
+				t.Errorf("exit code = %v, want %v (stderr: %s)", code, tt.expectedCode, stderr)
+			}
+			if stdout != tt.expectedStdout {
+				t.Errorf("stdout = %q, want %q", stdout, tt.expectedStdout)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	code, stdout, _ := runCommand([]string{"help"}, "")
+	if code != exitOK {
+		t.Errorf("exit code = %v, want %v", code, exitOK)
+	}
+	for _, cmd := range commands {
+		if !strings.Contains(stdout, cmd.usage) {
+			t.Errorf("help output does not mention %q", cmd.usage)
+		}
+	}
+
+	if code, _, _ := runCommand(nil, ""); code != exitUsage {
+		t.Errorf("exit code without arguments = %v, want %v", code, exitUsage)
+	}
+	if code, _, _ := runCommand([]string{"frobnicate"}, ""); code != exitUsage {
+		t.Errorf("exit code of unknown command = %v, want %v", code, exitUsage)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/mkyc/go-semver/github"
+)
+
+// runNext prints the recommended next version of a git repository. The latest version tag reachable
+// from HEAD, with or without a "v" prefix, is bumped according to the conventional commits since.
+// Without any version tag, all commits are analyzed starting from 0.0.0.
+func runNext(e env, args []string) int {
+	fs := newFlagSet(e, "next")
+	dir := fs.String("C", ".", "path of the git repository")
+	pre := fs.String("pre", "", "make the next version a pre-release with this identifier, numbered after existing tags, e.g. rc")
+	build := fs.String("build", "", "build metadata to append, the value sha appends the abbreviated commit hash")
+	githubOutput := fs.Bool("github", false, "also write the version information to the GitHub Actions step outputs")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 0 {
+		return usageError(e, fs, "unexpected arguments")
+	}
+
+	tags, err := gitVersionTags(*dir)
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver next: %v\n", err)
+		return exitFailure
+	}
+	latest, _ := latestVersionTag(tags)
+
+	messages, err := gitCommitMessages(*dir, latest.name)
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver next: %v\n", err)
+		return exitFailure
+	}
+
+	change := semver.AnalyzeCommits(messages)
+	if change == semver.ChangeNone {
+		fmt.Fprintf(e.stderr, "semver next: no releasable changes since %s\n", latest.version)
+		if *githubOutput {
+			if err := github.WriteOutput(latest.version); err != nil {
+				fmt.Fprintf(e.stderr, "semver next: %v\n", err)
+				return exitFailure
+			}
+		}
+		fmt.Fprintln(e.stdout, latest.version)
+		return exitOK
+	}
+
+	next := latest.version.Bump(change)
+	if *pre != "" {
+		pattern, err := semver.NewPreReleasePattern(next, *pre+".%d")
+		if err != nil {
+			return usageError(e, fs, "%v", err)
+		}
+		existing := make([]semver.SemVer, len(tags))
+		for i, tag := range tags {
+			existing[i] = tag.version
+		}
+		next = pattern.Next(existing)
+	}
+	if *build == "sha" {
+		if *build, err = gitShortSHA(*dir); err != nil {
+			fmt.Fprintf(e.stderr, "semver next: %v\n", err)
+			return exitFailure
+		}
+	}
+	next.Build = *build
+
+	// Reject identifiers given on the command line that do not make a valid version
+	if _, err := semver.Parse(next.String()); err != nil {
+		return usageError(e, fs, "%v", err)
+	}
+
+	if *githubOutput {
+		if err := github.WriteOutput(next); err != nil {
+			fmt.Fprintf(e.stderr, "semver next: %v\n", err)
+			return exitFailure
+		}
+	}
+	fmt.Fprintln(e.stdout, next)
+	return exitOK
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// gitRepo is a temporary git repository for tests.
+type gitRepo struct {
+	t   *testing.T
+	dir string
+}
+
+// newGitRepo initializes an empty git repository, skipping the test if git is not installed.
+func newGitRepo(t *testing.T) gitRepo {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	r := gitRepo{t: t, dir: t.TempDir()}
+	r.run("init", "-q")
+	return r
+}
+
+func (r gitRepo) run(args ...string) {
+	r.t.Helper()
+	args = append([]string{"-C", r.dir, "-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "tag.gpgSign=false", "-c", "commit.gpgSign=false"}, args...)
+	if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+		r.t.Fatalf("git %s: %v: %s", strings.Join(args, " "), err, out)
+	}
+}
+
+func (r gitRepo) commit(message string) {
+	r.t.Helper()
+	r.run("commit", "-q", "--allow-empty", "-m", message)
+}
+
+func (r gitRepo) tag(name string) {
+	r.t.Helper()
+	r.run("tag", name)
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name           string
+		setup          func(r gitRepo)
+		args           []string
+		expectedCode   int
+		expectedStdout string
+	}{
+		{
+			name: "No tags",
+			setup: func(r gitRepo) {
+				r.commit("feat: initial implementation")
+			},
+			expectedCode:   exitOK,
+			expectedStdout: "0.1.0\n",
+		},
+		{
+			name: "Fix since tag",
+			setup: func(r gitRepo) {
+				r.commit("feat: initial implementation")
+				r.tag("v1.2.3")
+				r.commit("fix: crash")
+				r.commit("docs: typo")
+			},
+			expectedCode:   exitOK,
+			expectedStdout: "1.2.4\n",
+		},
+		{
+			name: "Breaking change since tag",
+			setup: func(r gitRepo) {
+				r.commit("feat: initial implementation")
+				r.tag("1.2.3")
+				r.commit("fix: crash")
+				r.commit("feat: new API\n\nBREAKING CHANGE: old API removed")
+			},
+			expectedCode:   exitOK,
+			expectedStdout: "2.0.0\n",
+		},
+		{
+			name: "Only commits since the latest tag",
+			setup: func(r gitRepo) {
+				r.commit("feat!: initial implementation")
+				r.tag("v1.0.0")
+				r.tag("not-a-version")
+				r.commit("feat: sort command")
+				r.tag("v1.1.0")
+				r.commit("fix: crash")
+			},
+			expectedCode:   exitOK,
+			expectedStdout: "1.1.1\n",
+		},
+		{
+			name: "No releasable changes",
+			setup: func(r gitRepo) {
+				r.commit("feat: initial implementation")
+				r.tag("v1.2.3")
+				r.commit("chore: update dependencies")
+			},
+			expectedCode:   exitOK,
+			expectedStdout: "1.2.3\n",
+		},
+		{
+			name: "Pre-release",
+			setup: func(r gitRepo) {
+				r.commit("feat: initial implementation")
+				r.tag("v1.2.3")
+				r.commit("feat: sort command")
+			},
+			args:           []string{"-pre", "rc"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.3.0-rc.1\n",
+		},
+		{
+			name: "Pre-release after existing pre-releases",
+			setup: func(r gitRepo) {
+				r.commit("feat: initial implementation")
+				r.tag("v1.2.3")
+				r.commit("feat: sort command")
+				r.tag("v1.3.0-rc.1")
+				r.tag("v1.3.0-rc.2")
+				r.commit("fix: crash")
+			},
+			args:           []string{"-pre", "rc"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.3.0-rc.3\n",
+		},
+		{
+			name: "Build metadata",
+			setup: func(r gitRepo) {
+				r.commit("fix: crash")
+			},
+			args:           []string{"-build", "ci.42"},
+			expectedCode:   exitOK,
+			expectedStdout: "0.0.1+ci.42\n",
+		},
+		{
+			name: "Invalid pre-release identifier",
+			setup: func(r gitRepo) {
+				r.commit("fix: crash")
+			},
+			args:         []string{"-pre", "r_c"},
+			expectedCode: exitUsage,
+		},
+		{
+			name:         "Not a repository",
+			setup:        func(r gitRepo) {},
+			expectedCode: exitFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newGitRepo(t)
+			tt.setup(r)
+			code, stdout, stderr := runCommand(append([]string{"next", "-C", r.dir}, tt.args...), "")
+			if code != tt.expectedCode {
+				t.Errorf("exit code = %v, want %v (stderr: %s)", code, tt.expectedCode, stderr)
+			}
+			if stdout != tt.expectedStdout {
+				t.Errorf("stdout = %q, want %q", stdout, tt.expectedStdout)
+			}
+		})
+	}
+}
+
+func TestNextBuildSHA(t *testing.T) {
+	r := newGitRepo(t)
+	r.commit("fix: crash")
+
+	code, stdout, stderr := runCommand([]string{"next", "-C", r.dir, "-build", "sha"}, "")
+	if code != exitOK {
+		t.Fatalf("exit code = %v, want %v (stderr: %s)", code, exitOK, stderr)
+	}
+	if !strings.HasPrefix(stdout, "0.0.1+") || len(stdout) < len("0.0.1+1234567\n") {
+		t.Errorf("stdout = %q, want 0.0.1 with the commit hash as build metadata", stdout)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/mkyc/go-semver/github"
+)
+
+// runParse prints the components of a version as key=value lines or as JSON.
+func runParse(e env, args []string) int {
+	fs := newFlagSet(e, "parse")
+	asJSON := fs.Bool("json", false, "print the components as a JSON object")
+	githubOutput := fs.Bool("github", false, "also write the version information to the GitHub Actions step outputs")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 1 {
+		return usageError(e, fs, "expected exactly one version")
+	}
+
+	v, err := semver.Parse(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver parse: %v\n", err)
+		return exitFailure
+	}
+
+	if *githubOutput {
+		if err := github.WriteOutput(v); err != nil {
+			fmt.Fprintf(e.stderr, "semver parse: %v\n", err)
+			return exitFailure
+		}
+	}
+
+	if *asJSON {
+		output := struct {
+			Major      uint   `json:"major"`
+			Minor      uint   `json:"minor"`
+			Patch      uint   `json:"patch"`
+			PreRelease string `json:"prerelease"`
+			Build      string `json:"build"`
+		}{v.Major, v.Minor, v.Patch, v.PreRelease, v.Build}
+		encoder := json.NewEncoder(e.stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(e.stderr, "semver parse: %v\n", err)
+			return exitFailure
+		}
+		return exitOK
+	}
+
+	fmt.Fprintf(e.stdout, "major=%d\n", v.Major)
+	fmt.Fprintf(e.stdout, "minor=%d\n", v.Minor)
+	fmt.Fprintf(e.stdout, "patch=%d\n", v.Patch)
+	fmt.Fprintf(e.stdout, "prerelease=%s\n", v.PreRelease)
+	fmt.Fprintf(e.stdout, "build=%s\n", v.Build)
+	return exitOK
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	runCommandTests(t, []commandTest{
+		{
+			name:           "Key value output",
+			args:           []string{"parse", "1.2.3-rc.1+build.5"},
+			expectedCode:   exitOK,
+			expectedStdout: "major=1\nminor=2\npatch=3\nprerelease=rc.1\nbuild=build.5\n",
+		},
+		{
+			name:           "JSON output",
+			args:           []string{"parse", "-json", "1.2.3"},
+			expectedCode:   exitOK,
+			expectedStdout: `{"major":1,"minor":2,"patch":3,"prerelease":"","build":""}` + "\n",
+		},
+		{
+			name:         "Invalid version",
+			args:         []string{"parse", "1.2"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Missing version",
+			args:         []string{"parse"},
+			expectedCode: exitUsage,
+		},
+	})
+}
+
+func TestParseGitHubOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output")
+	t.Setenv("GITHUB_OUTPUT", path)
+
+	code, _, stderr := runCommand([]string{"parse", "-github", "1.2.3-rc.1"}, "")
+	if code != exitOK {
+		t.Fatalf("exit code = %v, want %v (stderr: %s)", code, exitOK, stderr)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "version=1.2.3-rc.1\nmajor=1\nminor=2\npatch=3\nprerelease=rc.1\nis_release=false\n"
+	if string(data) != expected {
+		t.Errorf("GITHUB_OUTPUT = %q, want %q", data, expected)
+	}
+
+	t.Setenv("GITHUB_OUTPUT", "")
+	if code, _, _ := runCommand([]string{"parse", "-github", "1.2.3"}, ""); code != exitFailure {
+		t.Errorf("exit code without GITHUB_OUTPUT = %v, want %v", code, exitFailure)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runSatisfies checks whether a version satisfies a constraint, for use in shell conditions:
+//
+//	if semver satisfies "$VERSION" ">=1.2.0 <2.0.0"; then ...
+//
+// It prints nothing and exits with exitOK if the version satisfies the constraint and exitFailure if not.
+// A malformed version or constraint is reported on stderr with exitInvalid.
+func runSatisfies(e env, args []string) int {
+	fs := newFlagSet(e, "satisfies")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		return usageError(e, fs, "expected a version and a constraint")
+	}
+
+	v, err := semver.Parse(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver satisfies: %v\n", err)
+		return exitInvalid
+	}
+	c, err := semver.ParseConstraint(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver satisfies: %v\n", err)
+		return exitInvalid
+	}
+
+	if !c.Allows(v) {
+		return exitFailure
+	}
+	return exitOK
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestSatisfies(t *testing.T) {
+	runCommandTests(t, []commandTest{
+		{
+			name:         "Satisfied",
+			args:         []string{"satisfies", "1.4.0", "^1.2"},
+			expectedCode: exitOK,
+		},
+		{
+			name:         "Not satisfied",
+			args:         []string{"satisfies", "2.0.0", "^1.2"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Pre-release not named in constraint",
+			args:         []string{"satisfies", "1.5.0-rc.1", ">=1.2.0"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Invalid version",
+			args:         []string{"satisfies", "1.4", "^1.2"},
+			expectedCode: exitInvalid,
+		},
+		{
+			name:         "Invalid constraint",
+			args:         []string{"satisfies", "1.4.0", "=>1.2"},
+			expectedCode: exitInvalid,
+		},
+		{
+			name:         "Missing constraint",
+			args:         []string{"satisfies", "1.4.0"},
+			expectedCode: exitUsage,
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runSort prints the versions in ascending (or with -r descending) order of precedence, as semver.ComparePrecedence,
+// so 2.0.0-rc.1 sorts after 1.5.0. Versions differing in build metadata only keep their input order.
+// It fails without output if any version is invalid.
+func runSort(e env, args []string) int {
+	fs := newFlagSet(e, "sort")
+	reverse := fs.Bool("r", false, "sort in descending order")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	lines, err := readLines(e, fs.Args())
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver sort: %v\n", err)
+		return exitFailure
+	}
+
+	versions := make([]semver.SemVer, 0, len(lines))
+	for _, line := range lines {
+		v, err := semver.Parse(line)
+		if err != nil {
+			fmt.Fprintf(e.stderr, "semver sort: %v\n", err)
+			return exitFailure
+		}
+		versions = append(versions, v)
+	}
+
+	slices.SortStableFunc(versions, semver.ComparePrecedence)
+	if *reverse {
+		for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+			versions[i], versions[j] = versions[j], versions[i]
+		}
+	}
+
+	for _, v := range versions {
+		fmt.Fprintln(e.stdout, v)
+	}
+	return exitOK
+}
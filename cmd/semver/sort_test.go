@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	runCommandTests(t, []commandTest{
+		{
+			name:           "Arguments",
+			args:           []string{"sort", "1.10.0", "1.2.0", "1.2.0-rc.1"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.2.0-rc.1\n1.2.0\n1.10.0\n",
+		},
+		{
+			name:           "Stdin in reverse",
+			args:           []string{"sort", "-r"},
+			stdin:          "1.0.0\n2.0.0\n1.5.0\n",
+			expectedCode:   exitOK,
+			expectedStdout: "2.0.0\n1.5.0\n1.0.0\n",
+		},
+		{
+			name:           "Pre-releases and releases of different cores",
+			args:           []string{"sort", "1.0.0", "2.0.0-rc.1", "1.5.0", "1.5.0-beta.1"},
+			expectedCode:   exitOK,
+			expectedStdout: "1.0.0\n1.5.0-beta.1\n1.5.0\n2.0.0-rc.1\n",
+		},
+		{
+			name:           "Pre-release of a higher core in reverse",
+			args:           []string{"sort", "-r", "1.0.0", "2.0.0-rc.1", "1.5.0"},
+			expectedCode:   exitOK,
+			expectedStdout: "2.0.0-rc.1\n1.5.0\n1.0.0\n",
+		},
+		{
+			name:         "Invalid version",
+			args:         []string{"sort", "1.0.0", "latest"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Unknown flag",
+			args:         []string{"sort", "-x"},
+			expectedCode: exitUsage,
+		},
+	})
+}
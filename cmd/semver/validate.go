@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// runValidate checks every version and reports the invalid ones on stderr.
+// It fails if at least one version is invalid.
+func runValidate(e env, args []string) int {
+	fs := newFlagSet(e, "validate")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	lines, err := readLines(e, fs.Args())
+	if err != nil {
+		fmt.Fprintf(e.stderr, "semver validate: %v\n", err)
+		return exitFailure
+	}
+	if len(lines) == 0 {
+		return usageError(e, fs, "expected at least one version")
+	}
+
+	result := exitOK
+	for _, line := range lines {
+		if _, err := semver.Parse(line); err != nil {
+			fmt.Fprintf(e.stderr, "semver validate: %s: %v\n", line, err)
+			result = exitFailure
+		}
+	}
+	return result
+}
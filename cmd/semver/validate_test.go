@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	runCommandTests(t, []commandTest{
+		{
+			name:         "Valid arguments",
+			args:         []string{"validate", "1.2.3", "1.0.0-alpha+001"},
+			expectedCode: exitOK,
+		},
+		{
+			name:         "Invalid argument",
+			args:         []string{"validate", "1.2.3", "01.2.3"},
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Valid stdin",
+			args:         []string{"validate"},
+			stdin:        "1.2.3\n\n2.0.0\n",
+			expectedCode: exitOK,
+		},
+		{
+			name:         "Invalid stdin",
+			args:         []string{"validate"},
+			stdin:        "1.2.3\nlatest\n",
+			expectedCode: exitFailure,
+		},
+		{
+			name:         "Empty stdin",
+			args:         []string{"validate"},
+			expectedCode: exitUsage,
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// compareOptions holds the configuration of NewComparator.
+type compareOptions struct {
+	preReleasesFirst bool
+	buildTieBreak    bool
+	descending       bool
+}
+
+// CompareOption configures the order of a comparator returned by NewComparator.
+type CompareOption func(*compareOptions)
+
+// WithPreReleasesFirst orders every pre-release before every release, as Compare does, instead of by precedence.
+func WithPreReleasesFirst() CompareOption {
+	return func(o *compareOptions) {
+		o.preReleasesFirst = true
+	}
+}
+
+// WithBuildTieBreak orders versions of equal precedence lexically by their build metadata, making the order total.
+func WithBuildTieBreak() CompareOption {
+	return func(o *compareOptions) {
+		o.buildTieBreak = true
+	}
+}
+
+// WithDescending reverses the order, so the highest version comes first.
+func WithDescending() CompareOption {
+	return func(o *compareOptions) {
+		o.descending = true
+	}
+}
+
+// identifier is a parsed pre-release identifier.
+type identifier struct {
+	text    string
+	number  uint64
+	numeric bool
+}
+
+// NewComparator returns a function comparing two versions like ComparePrecedence, adjusted by the options,
+// for use with slices.SortFunc or slices.BinarySearchFunc. The options are resolved once and the parsed
+// identifiers of each pre-release are memoized, which speeds up repeated sorts of the same versions
+// in long-running services. The memo grows with the distinct pre-releases compared, so use a comparator
+// per working set. The comparator is safe for concurrent use.
+func NewComparator(opts ...CompareOption) func(a, b SemVer) int {
+	var options compareOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var memo sync.Map
+	identifiers := func(preRelease string) []identifier {
+		if cached, ok := memo.Load(preRelease); ok {
+			return cached.([]identifier)
+		}
+		var parsed []identifier
+		for _, part := range strings.Split(preRelease, ".") {
+			number, err := strconv.ParseUint(part, 10, 64)
+			parsed = append(parsed, identifier{text: part, number: number, numeric: err == nil})
+		}
+		memo.Store(preRelease, parsed)
+		return parsed
+	}
+
+	compare := func(a, b SemVer) int {
+		if options.preReleasesFirst && (a.PreRelease == "") != (b.PreRelease == "") {
+			if a.PreRelease != "" {
+				return -1
+			}
+			return 1
+		}
+
+		// Compare the version core, then the pre-release
+		if result := compareCore(a, b); result != 0 {
+			return result
+		}
+		result := 0
+		switch {
+		case a.PreRelease == b.PreRelease:
+		case a.PreRelease == "":
+			result = 1
+		case b.PreRelease == "":
+			result = -1
+		default:
+			result = compareIdentifiers(identifiers(a.PreRelease), identifiers(b.PreRelease))
+		}
+
+		if result == 0 && options.buildTieBreak {
+			result = strings.Compare(a.Build, b.Build)
+		}
+		return result
+	}
+
+	if options.descending {
+		return func(a, b SemVer) int {
+			return compare(b, a)
+		}
+	}
+	return compare
+}
+
+// compareCore compares the major, minor and patch versions.
+func compareCore(a, b SemVer) int {
+	switch {
+	case a.Major != b.Major:
+		return cmpUint(a.Major, b.Major)
+	case a.Minor != b.Minor:
+		return cmpUint(a.Minor, b.Minor)
+	}
+	return cmpUint(a.Patch, b.Patch)
+}
+
+// cmpUint returns -1, 0 or 1 as a is less than, equal to or greater than b.
+func cmpUint[T uint | uint64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareIdentifiers compares parsed pre-release identifiers by the precedence rules of the specification.
+func compareIdentifiers(a, b []identifier) int {
+	for i := 0; i < min(len(a), len(b)); i++ {
+		x, y := a[i], b[i]
+		switch {
+		case x.numeric && y.numeric:
+			if result := cmpUint(x.number, y.number); result != 0 {
+				return result
+			}
+		case x.numeric:
+			return -1
+		case y.numeric:
+			return 1
+		default:
+			if result := strings.Compare(x.text, y.text); result != 0 {
+				return result
+			}
+		}
+	}
+	return cmpUint(uint(len(a)), uint(len(b)))
+}
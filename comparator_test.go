@@ -0,0 +1,100 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewComparator(t *testing.T) {
+	g := NewGenerator(11, WithMaxComponents(2, 2, 2), WithPreReleaseProbability(0.6), WithBuildProbability(0.3))
+	var versions []SemVer
+	for i := 0; i < 150; i++ {
+		versions = append(versions, g.Next())
+	}
+	versions = append(versions, mustParse(t, "1.0.0-99999999999999999999"), mustParse(t, "1.0.0-rc.1+b"), mustParse(t, "1.0.0-rc.1+a"))
+
+	tests := []struct {
+		name     string
+		opts     []CompareOption
+		expected func(a, b SemVer) int
+	}{
+		{name: "Precedence", expected: ComparePrecedence},
+		{name: "Pre-releases first", opts: []CompareOption{WithPreReleasesFirst()}, expected: SemVer.Compare},
+		{
+			name: "Build tie break",
+			opts: []CompareOption{WithBuildTieBreak()},
+			expected: func(a, b SemVer) int {
+				if result := ComparePrecedence(a, b); result != 0 {
+					return result
+				}
+				return strings.Compare(a.Build, b.Build)
+			},
+		},
+		{
+			name:     "Descending",
+			opts:     []CompareOption{WithDescending()},
+			expected: func(a, b SemVer) int { return ComparePrecedence(b, a) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compare := NewComparator(tt.opts...)
+			// Compare twice to exercise the memoized identifiers
+			for round := 0; round < 2; round++ {
+				for _, a := range versions {
+					for _, b := range versions {
+						if result, expected := compare(a, b), tt.expected(a, b); result != expected {
+							t.Fatalf("compare(%v, %v) = %v, want %v", a, b, result, expected)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNewComparatorSort(t *testing.T) {
+	var versions []SemVer
+	for _, s := range []string{"2.0.0", "1.0.0-rc.1", "1.0.0", "1.0.0-alpha", "0.9.0"} {
+		versions = append(versions, mustParse(t, s))
+	}
+	slices.SortFunc(versions, NewComparator(WithDescending()))
+	if result, expected := versionStrings(versions), []string{"2.0.0", "1.0.0", "1.0.0-rc.1", "1.0.0-alpha", "0.9.0"}; !slices.Equal(result, expected) {
+		t.Errorf("SortFunc() = %v, want %v", result, expected)
+	}
+}
+
+func TestNewComparatorConcurrent(t *testing.T) {
+	compare := NewComparator()
+	a, b := mustParse(t, "1.0.0-rc.1"), mustParse(t, "1.0.0-rc.2")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if compare(a, b) != -1 {
+					t.Errorf("compare(%v, %v) != -1", a, b)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkNewComparator(b *testing.B) {
+	versions := NewGenerator(1, WithPreReleaseProbability(0.8)).Versions(1000)
+	compare := NewComparator()
+	work := make([]SemVer, len(versions))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(work, versions)
+		slices.Reverse(work)
+		slices.SortFunc(work, compare)
+	}
+}
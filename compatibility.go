@@ -0,0 +1,128 @@
+package semver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CompatibilityEntry states that the versions of component A matching A work with the versions of component B matching B.
+type CompatibilityEntry struct {
+	A Constraint
+	B Constraint
+}
+
+// CompatibilityMatrix records which version ranges of a component A work with which version ranges
+// of a component B, e.g. which server versions a client release supports.
+type CompatibilityMatrix struct {
+	Entries []CompatibilityEntry
+}
+
+// Add parses both constraints and appends an entry to the matrix.
+// It returns an error if a constraint cannot be parsed.
+func (m *CompatibilityMatrix) Add(a, b string) error {
+	ca, err := ParseConstraint(a)
+	if err != nil {
+		return err
+	}
+	cb, err := ParseConstraint(b)
+	if err != nil {
+		return err
+	}
+	m.Entries = append(m.Entries, CompatibilityEntry{A: ca, B: cb})
+	return nil
+}
+
+// CompatibleWith returns the constraint matching the versions of B that work with the version a of A,
+// the union of the B constraints of all entries matching a. The constraint matches nothing if no entry matches a.
+func (m CompatibilityMatrix) CompatibleWith(a SemVer) Constraint {
+	var matching []Constraint
+	for _, e := range m.Entries {
+		if e.A.Allows(a) {
+			matching = append(matching, e.B)
+		}
+	}
+	return unionConstraints(matching)
+}
+
+// CompatibleWithB returns the constraint matching the versions of A that work with the version b of B,
+// the union of the A constraints of all entries matching b. The constraint matches nothing if no entry matches b.
+func (m CompatibilityMatrix) CompatibleWithB(b SemVer) Constraint {
+	var matching []Constraint
+	for _, e := range m.Entries {
+		if e.B.Allows(b) {
+			matching = append(matching, e.A)
+		}
+	}
+	return unionConstraints(matching)
+}
+
+// Compatible reports whether the version a of A works with the version b of B.
+func (m CompatibilityMatrix) Compatible(a, b SemVer) bool {
+	for _, e := range m.Entries {
+		if e.A.Allows(a) && e.B.Allows(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the A constraints of the entries neither overlap nor leave gaps,
+// so every version of A between the lowest and the highest covered version matches exactly one entry.
+// Comparators with != are ignored.
+// It returns an error describing the first overlap or gap found.
+func (m CompatibilityMatrix) Validate() error {
+	type entryInterval struct {
+		entry int
+		Interval
+	}
+
+	var intervals []entryInterval
+	for i, e := range m.Entries {
+		for _, comparators := range e.A.ranges {
+			if iv := rangeInterval(comparators); !iv.Empty() {
+				intervals = append(intervals, entryInterval{i, iv})
+			}
+		}
+	}
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].Lower.lowerBefore(intervals[j].Lower)
+	})
+
+	// Check for overlaps between entries
+	for i := range intervals {
+		for j := i + 1; j < len(intervals); j++ {
+			a, b := intervals[i], intervals[j]
+			if a.entry != b.entry && a.Overlaps(b.Interval) {
+				return fmt.Errorf("invalid compatibility matrix: %q overlaps %q", m.Entries[a.entry].A, m.Entries[b.entry].A)
+			}
+		}
+	}
+
+	// Check for gaps between consecutive intervals
+	if len(intervals) == 0 {
+		return nil
+	}
+	reach := intervals[0].Upper
+	for _, next := range intervals[1:] {
+		if reach.separates(next.Lower) {
+			return fmt.Errorf("invalid compatibility matrix: gap between %s and %s", reach.comparator("<"), next.Lower.comparator(">"))
+		}
+		if reach.upperBefore(next.Upper) {
+			reach = next.Upper
+		}
+	}
+	return nil
+}
+
+// unionConstraints returns the constraint matching the versions matched by any of the constraints.
+func unionConstraints(constraints []Constraint) Constraint {
+	var union Constraint
+	raws := make([]string, len(constraints))
+	for i, c := range constraints {
+		raws[i] = c.raw
+		union.ranges = append(union.ranges, c.ranges...)
+	}
+	union.raw = strings.Join(raws, " || ")
+	return union
+}
@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"testing"
+)
+
+// mustMatrix builds a compatibility matrix from pairs of A and B constraints.
+func mustMatrix(t *testing.T, pairs ...string) CompatibilityMatrix {
+	t.Helper()
+	var m CompatibilityMatrix
+	for i := 0; i < len(pairs); i += 2 {
+		if err := m.Add(pairs[i], pairs[i+1]); err != nil {
+			t.Fatalf("Add(%q, %q) failed: %v", pairs[i], pairs[i+1], err)
+		}
+	}
+	return m
+}
+
+func TestCompatibilityMatrixQueries(t *testing.T) {
+	m := mustMatrix(t,
+		">=1.0.0 <1.5.0", "^2.0.0",
+		">=1.5.0 <2.0.0", "^2.3.0 || ^3.0.0",
+		"^2.0.0", "^3.0.0",
+	)
+
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "First entry", a: "1.2.0", b: "2.9.0", expected: true},
+		{name: "First entry, B too new", a: "1.2.0", b: "3.0.0", expected: false},
+		{name: "Second entry, first alternative", a: "1.5.0", b: "2.3.1", expected: true},
+		{name: "Second entry, B too old", a: "1.5.0", b: "2.2.0", expected: false},
+		{name: "Second entry, second alternative", a: "1.9.9", b: "3.1.0", expected: true},
+		{name: "Third entry", a: "2.0.0", b: "3.0.0", expected: true},
+		{name: "A not covered", a: "0.9.0", b: "2.0.0", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustParse(t, tt.a), mustParse(t, tt.b)
+			if result := m.Compatible(a, b); result != tt.expected {
+				t.Errorf("Compatible() = %v, want %v", result, tt.expected)
+			}
+			if result := m.CompatibleWith(a).Allows(b); result != tt.expected {
+				t.Errorf("CompatibleWith(%v) = %q, allows %v = %v, want %v", a, m.CompatibleWith(a), b, result, tt.expected)
+			}
+			if result := m.CompatibleWithB(b).Allows(a); result != tt.expected {
+				t.Errorf("CompatibleWithB(%v) = %q, allows %v = %v, want %v", b, m.CompatibleWithB(b), a, result, tt.expected)
+			}
+		})
+	}
+
+	if c := m.CompatibleWithB(mustParse(t, "3.0.0")).String(); c != ">=1.5.0 <2.0.0 || ^2.0.0" {
+		t.Errorf("CompatibleWithB() = %q, want %q", c, ">=1.5.0 <2.0.0 || ^2.0.0")
+	}
+}
+
+func TestCompatibilityMatrixValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		pairs       []string
+		expectError bool
+	}{
+		{name: "Empty"},
+		{name: "Adjacent ranges", pairs: []string{">=1.0.0 <1.5.0", "^2", ">=1.5.0 <2.0.0", "^3", "^2.0.0", "^3"}},
+		{name: "Adjacent inclusive upper bound", pairs: []string{"<=1.4.9", "^2", ">1.4.9", "^3"}},
+		{name: "Alternatives of one entry may overlap", pairs: []string{"^1.0.0 || >=1.2.0 <1.3.0", "^2"}},
+		{name: "Unordered entries", pairs: []string{"^2.0.0", "^3", "1.x", "^2"}},
+		{name: "Overlap", pairs: []string{">=1.0.0 <1.5.0", "^2", ">=1.4.0 <2.0.0", "^3"}, expectError: true},
+		{name: "Overlap at inclusive bound", pairs: []string{"<=1.5.0", "^2", ">=1.5.0", "^3"}, expectError: true},
+		{name: "Gap", pairs: []string{">=1.0.0 <1.5.0", "^2", ">=1.6.0 <2.0.0", "^3"}, expectError: true},
+		{name: "Gap at exclusive bounds", pairs: []string{"<1.5.0", "^2", ">1.5.0", "^3"}, expectError: true},
+		{name: "Overlap with wildcard", pairs: []string{"*", "^2", "=3.0.0", "^3"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mustMatrix(t, tt.pairs...).Validate()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"sync"
+)
+
+// DefaultConstraintCacheSize is the number of constraints kept by the cache used by CompileConstraint.
+const DefaultConstraintCacheSize = 1024
+
+// CompiledConstraint is a parsed constraint shared through a ConstraintCache.
+// It is immutable and safe for concurrent use.
+type CompiledConstraint struct {
+	Constraint
+}
+
+// compileResult is a cached parse result, failures are cached as well.
+type compileResult struct {
+	constraint *CompiledConstraint
+	err        error
+}
+
+// ConstraintCache caches parsed constraints by their string, so constraints evaluated over and over,
+// e.g. by an API server, are only parsed once. It is safe for concurrent use.
+type ConstraintCache struct {
+	mu      sync.RWMutex
+	size    int
+	entries map[string]compileResult
+}
+
+// NewConstraintCache returns a cache holding up to size constraints. When it is full,
+// an arbitrary entry is evicted for each new one. A size of zero or less disables the limit.
+func NewConstraintCache(size int) *ConstraintCache {
+	return &ConstraintCache{size: size, entries: map[string]compileResult{}}
+}
+
+// Compile returns the cached constraint for the string, parsing and caching it on first use.
+// It returns the error of ParseConstraint if the constraint cannot be parsed; the error is cached too.
+func (c *ConstraintCache) Compile(s string) (*CompiledConstraint, error) {
+	c.mu.RLock()
+	result, ok := c.entries[s]
+	c.mu.RUnlock()
+	if ok {
+		return result.constraint, result.err
+	}
+
+	// Parse outside the lock, concurrent first uses may parse the same string more than once
+	constraint, err := ParseConstraint(s)
+	result = compileResult{err: err}
+	if err == nil {
+		result.constraint = &CompiledConstraint{constraint}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.entries[s]; ok {
+		return existing.constraint, existing.err
+	}
+	if c.size > 0 && len(c.entries) >= c.size {
+		for key := range c.entries {
+			delete(c.entries, key)
+			break
+		}
+	}
+	c.entries[s] = result
+	return result.constraint, result.err
+}
+
+// Len returns the number of cached constraints.
+func (c *ConstraintCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.entries)
+}
+
+// defaultConstraintCache is the cache of CompileConstraint.
+var defaultConstraintCache = NewConstraintCache(DefaultConstraintCacheSize)
+
+// CompileConstraint returns the constraint for the string from a package-wide cache of
+// DefaultConstraintCacheSize entries, parsing it on first use.
+func CompileConstraint(s string) (*CompiledConstraint, error) {
+	return defaultConstraintCache.Compile(s)
+}
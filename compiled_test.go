@@ -0,0 +1,84 @@
+package semver
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestConstraintCacheCompile(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraint  string
+		version     string
+		expected    bool
+		expectError bool
+	}{
+		{name: "Caret", constraint: "^1.2", version: "1.5.0", expected: true},
+		{name: "Range", constraint: ">=1.0.0 <2.0.0", version: "2.0.0", expected: false},
+		{name: "Invalid", constraint: ">=x.y", expectError: true},
+	}
+
+	cache := NewConstraintCache(0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := cache.Compile(tt.constraint)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				if _, again := cache.Compile(tt.constraint); again != err {
+					t.Errorf("Compile() error = %v, want the cached %v", again, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result := c.Allows(mustParse(t, tt.version)); result != tt.expected {
+				t.Errorf("Allows() = %v, want %v", result, tt.expected)
+			}
+			if again, _ := cache.Compile(tt.constraint); again != c {
+				t.Errorf("Compile() did not return the cached constraint")
+			}
+		})
+	}
+	if cache.Len() != len(tests) {
+		t.Errorf("Len() = %v, want %v", cache.Len(), len(tests))
+	}
+}
+
+func TestConstraintCacheSize(t *testing.T) {
+	cache := NewConstraintCache(3)
+	for i := 0; i < 10; i++ {
+		if _, err := cache.Compile(fmt.Sprintf("^%d.0", i)); err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+		if cache.Len() > 3 {
+			t.Fatalf("Len() = %v, want at most 3", cache.Len())
+		}
+	}
+}
+
+func TestCompileConstraintConcurrent(t *testing.T) {
+	v := SemVer{Major: 1, Minor: 4}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c, err := CompileConstraint(fmt.Sprintf(">=1.%d", j%5))
+				if err != nil {
+					t.Errorf("Did not expect error but got: %v", err)
+					return
+				}
+				if !c.Allows(v) {
+					t.Errorf("%v does not allow %v", c, v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
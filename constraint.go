@@ -0,0 +1,396 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// comparator is a single requirement like ">=1.2.3" that a version is checked against.
+type comparator struct {
+	op      string
+	version SemVer
+}
+
+// String returns the comparator in its canonical form, e.g. ">=1.2.3".
+func (c comparator) String() string {
+	return c.op + c.version.String()
+}
+
+// allows reports whether the version fulfills the comparator, comparing by specification precedence.
+func (c comparator) allows(v SemVer) bool {
+	result := ComparePrecedence(v, c.version)
+	switch c.op {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	}
+	return false
+}
+
+// Constraint represents a set of version requirements, e.g. ">=1.2.0 <2.0.0 || ^3.1".
+// It is a disjunction of ranges, each range being a conjunction of comparators.
+type Constraint struct {
+	raw    string
+	ranges [][]comparator
+
+	// exactPreReleases restricts pre-releases to ranges that name them with "=", as Terraform does
+	exactPreReleases bool
+}
+
+// ParseConstraint parses a constraint string into a Constraint.
+//
+// Alternatives are separated by "||", the comparators of a range by whitespace or commas.
+// Supported operators are =, !=, >, >=, <, <=, ~ (patch-level changes, ~1.2.3 is >=1.2.3 <1.3.0)
+// and ^ (changes that do not modify the left-most non-zero component, ^1.2.3 is >=1.2.3 <2.0.0
+// and ^0.2.3 is >=0.2.3 <0.3.0). A missing operator means =.
+//
+// A hyphen range "A - B" is >=A <=B, where a partial B excludes everything above it:
+// "1.2 - 2.3" is >=1.2.0 <2.4.0.
+//
+// Versions may be partial, with missing or wildcard ("x", "X", "*") components matching anything:
+// "1.2" and "1.2.x" are >=1.2.0 <1.3.0, and "*" matches every release.
+//
+// Pre-release versions only satisfy a range if one of its comparators names a pre-release of the
+// same major.minor.patch, so ">=1.0.0-rc.1" matches 1.0.0-rc.2 but not 1.1.0-rc.1.
+//
+// It returns an error if the constraint is empty or a comparator is malformed.
+func ParseConstraint(s string) (Constraint, error) {
+	return parseConstraint(s, ZeroPolicy{})
+}
+
+// parseConstraint implements ParseConstraint, expanding caret ranges on 0.y.z versions according to the policy.
+func parseConstraint(s string, policy ZeroPolicy) (Constraint, error) {
+	c := Constraint{raw: s}
+
+	for _, alternative := range strings.Split(s, "||") {
+		fields := strings.FieldsFunc(alternative, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ','
+		})
+		if len(fields) == 0 {
+			return Constraint{}, fmt.Errorf("invalid constraint: %q, empty range", s)
+		}
+
+		var comparators []comparator
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+
+			// Allow whitespace between operator and version, e.g. ">= 1.2.3"
+			if strings.Trim(field, "=!<>~^") == "" && i+1 < len(fields) {
+				i++
+				field += fields[i]
+			}
+
+			// Expand hyphen ranges, e.g. "1.2 - 2.3.4"
+			if i+2 < len(fields) && fields[i+1] == "-" {
+				expanded, err := parseHyphenRange(field, fields[i+2], policy)
+				if err != nil {
+					return Constraint{}, fmt.Errorf("invalid constraint: %q: %w", s, err)
+				}
+				comparators = append(comparators, expanded...)
+				i += 2
+				continue
+			}
+
+			expanded, err := parseComparator(field, policy)
+			if err != nil {
+				return Constraint{}, fmt.Errorf("invalid constraint: %q: %w", s, err)
+			}
+			comparators = append(comparators, expanded...)
+		}
+		c.ranges = append(c.ranges, comparators)
+	}
+
+	return c, nil
+}
+
+// MustParseConstraint is like ParseConstraint but panics if the constraint cannot be parsed.
+// It simplifies the initialization of global constraint variables.
+func MustParseConstraint(s string) Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// String returns the constraint as it was parsed.
+func (c Constraint) String() string {
+	return c.raw
+}
+
+// Allows reports whether the version satisfies the constraint.
+func (c Constraint) Allows(v SemVer) bool {
+	for _, comparators := range c.ranges {
+		if c.rangeAllows(comparators, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Satisfies reports whether the version satisfies the constraint.
+func (s SemVer) Satisfies(c Constraint) bool {
+	return c.Allows(s)
+}
+
+// NextOutside returns the lowest release above the version that the constraint does not allow, i.e. the version
+// where an upgrade leaves the constraint: 2.0.0 for "^1.2" and 1.5.0. If the release directly above v is not
+// allowed, it is returned itself. It returns false if the constraint allows every release above v.
+// Pre-releases are not considered, as constraints only allow those they name.
+func (c Constraint) NextOutside(v SemVer) (SemVer, bool) {
+	// Start at the lowest release above v
+	next := SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if v.PreRelease == "" {
+		next.Patch++
+	}
+
+	// Skip to the end of each interval containing the candidate
+	intervals := c.Intervals()
+	for {
+		i := slices.IndexFunc(intervals, func(iv Interval) bool { return iv.Contains(next) })
+		if i < 0 {
+			return next, true
+		}
+		upper := intervals[i].Upper
+		switch {
+		case upper.Unbounded:
+			return SemVer{}, false
+		case upper.Inclusive && upper.Version.PreRelease == "":
+			next = SemVer{Major: upper.Version.Major, Minor: upper.Version.Minor, Patch: upper.Version.Patch + 1}
+		default:
+			next = SemVer{Major: upper.Version.Major, Minor: upper.Version.Minor, Patch: upper.Version.Patch}
+		}
+	}
+}
+
+// rangeAllows reports whether the version fulfills all comparators of a range, applying the pre-release rules.
+func (c Constraint) rangeAllows(comparators []comparator, v SemVer) bool {
+	for _, comp := range comparators {
+		if !comp.allows(v) {
+			return false
+		}
+	}
+
+	if v.PreRelease == "" {
+		return true
+	}
+
+	// Pre-releases must be named explicitly by a comparator of the same version core
+	for _, comp := range comparators {
+		if comp.version.PreRelease == "" || !sameCore(comp.version, v) {
+			continue
+		}
+		if !c.exactPreReleases || comp.op == "=" {
+			return true
+		}
+	}
+	return false
+}
+
+// sameCore reports whether two versions have the same major, minor and patch version.
+func sameCore(a, b SemVer) bool {
+	return a.Major == b.Major && a.Minor == b.Minor && a.Patch == b.Patch
+}
+
+// partialVersion is a version whose trailing components may be missing or wildcards.
+type partialVersion struct {
+	version SemVer
+	// parts is the number of numeric components given, from 0 for "*" to 3 for a full version
+	parts int
+}
+
+// parsePartialVersion parses a version that may be partial like "1.2", "1.x" or "*".
+// Pre-release and build metadata are only allowed on full versions, build metadata is dropped.
+func parsePartialVersion(s string) (partialVersion, error) {
+	if s == "" {
+		return partialVersion{}, fmt.Errorf("missing version")
+	}
+
+	// Build metadata does not figure into precedence
+	versionPart, _, _ := strings.Cut(s, "+")
+	versionCore, preRelease, hasPreRelease := strings.Cut(versionPart, "-")
+
+	versionParts := strings.Split(versionCore, ".")
+	if len(versionParts) > 3 {
+		return partialVersion{}, fmt.Errorf("invalid version format: %s, expected at most major.minor.patch", versionCore)
+	}
+
+	// Count the numeric components before the first wildcard
+	var p partialVersion
+	for _, part := range versionParts {
+		if part == "x" || part == "X" || part == "*" {
+			break
+		}
+		p.parts++
+	}
+	for _, part := range versionParts[p.parts:] {
+		if part != "x" && part != "X" && part != "*" {
+			return partialVersion{}, fmt.Errorf("invalid version: %s, numeric component after wildcard", s)
+		}
+	}
+
+	if p.parts < 3 && hasPreRelease {
+		return partialVersion{}, fmt.Errorf("invalid version: %s, pre-release requires major.minor.patch", s)
+	}
+
+	// Let the strict parser validate the components by padding missing ones with zeros
+	padded := append(versionParts[:p.parts:p.parts], "0", "0", "0")[:3]
+	full := strings.Join(padded, ".")
+	if hasPreRelease {
+		full += "-" + preRelease
+	}
+	v, err := Parse(full)
+	if err != nil {
+		return partialVersion{}, err
+	}
+	p.version = v
+
+	return p, nil
+}
+
+// parseComparator parses a single comparator like ">=1.2", "~1.2.3" or "1.x" and expands it
+// into primitive comparators with full versions.
+func parseComparator(s string, policy ZeroPolicy) ([]comparator, error) {
+	// Split operator and version
+	i := strings.IndexFunc(s, func(r rune) bool { return !strings.ContainsRune("=!<>~^", r) })
+	if i < 0 {
+		return nil, fmt.Errorf("missing version in %q", s)
+	}
+	op, versionPart := s[:i], strings.TrimPrefix(s[i:], "v")
+	if op == "" || op == "==" {
+		op = "="
+	}
+
+	p, err := parsePartialVersion(versionPart)
+	if err != nil {
+		return nil, err
+	}
+
+	return expandComparator(op, p, policy)
+}
+
+// parseHyphenRange parses the bounds of a hyphen range "lower - upper" into primitive comparators.
+func parseHyphenRange(lower, upper string, policy ZeroPolicy) ([]comparator, error) {
+	lowerVersion, err := parsePartialVersion(strings.TrimPrefix(lower, "v"))
+	if err != nil {
+		return nil, err
+	}
+	upperVersion, err := parsePartialVersion(strings.TrimPrefix(upper, "v"))
+	if err != nil {
+		return nil, err
+	}
+
+	var comparators []comparator
+	if lowerVersion.parts > 0 {
+		comparators = append(comparators, comparator{">=", lowerVersion.version})
+	}
+	if upperVersion.parts > 0 {
+		expanded, err := expandComparator("<=", upperVersion, policy)
+		if err != nil {
+			return nil, err
+		}
+		comparators = append(comparators, expanded...)
+	}
+	if len(comparators) == 0 {
+		comparators = append(comparators, comparator{">=", SemVer{}})
+	}
+
+	return comparators, nil
+}
+
+// expandComparator turns an operator and a partial version into primitive comparators.
+func expandComparator(op string, p partialVersion, policy ZeroPolicy) ([]comparator, error) {
+	v := p.version
+
+	// Wildcards match everything
+	if p.parts == 0 {
+		switch op {
+		case "=", ">=", "<=", "~", "^":
+			return []comparator{{">=", SemVer{}}}, nil
+		}
+		return nil, fmt.Errorf("operator %s cannot be used with a wildcard", op)
+	}
+
+	switch op {
+	case "=":
+		if p.parts == 3 {
+			return []comparator{{"=", v}}, nil
+		}
+		return []comparator{{">=", v}, {"<", p.next()}}, nil
+	case "!=":
+		if p.parts < 3 {
+			return nil, fmt.Errorf("operator != requires major.minor.patch")
+		}
+		return []comparator{{"!=", v}}, nil
+	case ">":
+		if p.parts == 3 {
+			return []comparator{{">", v}}, nil
+		}
+		return []comparator{{">=", p.next()}}, nil
+	case ">=":
+		return []comparator{{">=", v}}, nil
+	case "<":
+		return []comparator{{"<", v}}, nil
+	case "<=":
+		if p.parts == 3 {
+			return []comparator{{"<=", v}}, nil
+		}
+		return []comparator{{"<", p.next()}}, nil
+	case "~":
+		// Allow patch-level changes if a minor version is given, minor-level changes otherwise
+		if p.parts == 1 {
+			return []comparator{{">=", v}, {"<", SemVer{Major: v.Major + 1}}}, nil
+		}
+		return []comparator{{">=", v}, {"<", SemVer{Major: v.Major, Minor: v.Minor + 1}}}, nil
+	case "^":
+		return []comparator{{">=", v}, {"<", caretUpperBound(p, policy)}}, nil
+	}
+
+	return nil, fmt.Errorf("unknown operator %s", op)
+}
+
+// next returns the smallest version above every version matching the partial version, e.g. 1.3.0 for "1.2".
+func (p partialVersion) next() SemVer {
+	v := p.version
+	switch p.parts {
+	case 1:
+		return SemVer{Major: v.Major + 1}
+	case 2:
+		return SemVer{Major: v.Major, Minor: v.Minor + 1}
+	}
+	return SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+}
+
+// caretUpperBound returns the exclusive upper bound of a caret range, which increments the
+// left-most non-zero component among the given ones. The policy decides for 0.y versions.
+func caretUpperBound(p partialVersion, policy ZeroPolicy) SemVer {
+	v := p.version
+	if v.Major > 0 || p.parts == 1 {
+		return SemVer{Major: v.Major + 1}
+	}
+
+	switch policy.Compatible {
+	case ZeroCompatibleMajor:
+		return SemVer{Major: 1}
+	case ZeroCompatibleMinor:
+		return SemVer{Minor: v.Minor + 1}
+	case ZeroCompatibleNone:
+		return p.next()
+	}
+
+	switch {
+	case v.Minor > 0 || p.parts == 2:
+		return SemVer{Minor: v.Minor + 1}
+	}
+	return SemVer{Patch: v.Patch + 1}
+}
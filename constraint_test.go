@@ -0,0 +1,196 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraint  string
+		expectError bool
+	}{
+		{name: "Exact version", constraint: "1.2.3"},
+		{name: "Range with whitespace", constraint: ">=1.2.0 <2.0.0"},
+		{name: "Range with commas", constraint: ">= 1.2.0, < 2.0.0"},
+		{name: "Alternatives", constraint: "^1.2 || ~3.4.5"},
+		{name: "Wildcards", constraint: "1.x || 2.3.* || *"},
+		{name: "Pre-release", constraint: ">=1.0.0-rc.1"},
+		{name: "Hyphen range", constraint: "1.2.3 - 2.3"},
+		{name: "Empty constraint", constraint: "", expectError: true},
+		{name: "Empty alternative", constraint: "1.2.3 ||", expectError: true},
+		{name: "Unknown operator", constraint: "=>1.2.3", expectError: true},
+		{name: "Missing version", constraint: ">=", expectError: true},
+		{name: "Invalid version", constraint: ">=1.2.3.4", expectError: true},
+		{name: "Pre-release on partial version", constraint: ">=1.2-rc.1", expectError: true},
+		{name: "Number after wildcard", constraint: "1.x.3", expectError: true},
+		{name: "Not equal partial version", constraint: "!=1.2", expectError: true},
+		{name: "Less than wildcard", constraint: "<*", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseConstraint(tt.constraint)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if c.String() != tt.constraint {
+				t.Errorf("String() = %v, want %v", c.String(), tt.constraint)
+			}
+		})
+	}
+}
+
+func TestConstraintAllows(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		// Primitive operators
+		{"1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.3+build", true},
+		{"1.2.3", "1.2.4", false},
+		{"!=1.2.3", "1.2.3", false},
+		{"!=1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">=1.2.3", "1.2.3", true},
+		{"<1.2.3", "1.2.2", true},
+		{"<1.2.3", "1.2.3", false},
+		{"<=1.2.3", "1.2.3", true},
+		{">=1.2.0 <2.0.0", "1.9.9", true},
+		{">=1.2.0 <2.0.0", "2.0.0", false},
+		{">=1.2.0, <2.0.0", "1.1.0", false},
+		{"<1.0.0 || >=2.0.0", "0.9.0", true},
+		{"<1.0.0 || >=2.0.0", "1.5.0", false},
+
+		// Partial versions and wildcards
+		{"1.2", "1.2.9", true},
+		{"1.2", "1.3.0", false},
+		{"1.2.x", "1.2.0", true},
+		{"1.*", "1.99.0", true},
+		{"1", "2.0.0", false},
+		{"*", "0.0.1", true},
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{"<=1.2", "1.2.9", true},
+		{"<=1.2", "1.3.0", false},
+		{"<1.2", "1.1.9", true},
+		{"<1.2", "1.2.0", false},
+
+		// Hyphen ranges
+		{"1.2.3 - 2.3.4", "1.2.3", true},
+		{"1.2.3 - 2.3.4", "2.3.4", true},
+		{"1.2.3 - 2.3.4", "2.3.5", false},
+		{"1.2 - 2.3", "2.3.9", true},
+		{"1.2 - 2.3", "2.4.0", false},
+		{"1.2 - 2.3", "1.1.9", false},
+		{"* - 2", "0.1.0", true},
+
+		// Tilde ranges
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2.3", "1.2.2", false},
+		{"~1.2", "1.2.0", true},
+		{"~1", "1.9.0", true},
+		{"~1", "2.0.0", false},
+
+		// Caret ranges
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^1.2.3", "1.2.2", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+		{"^0.0", "0.0.9", true},
+		{"^0.0", "0.1.0", false},
+		{"^0", "0.9.0", true},
+		{"^0", "1.0.0", false},
+
+		// Pre-releases
+		{">=1.0.0", "2.0.0-alpha", false},
+		{"<2.0.0", "2.0.0-alpha", false},
+		{"*", "1.0.0-alpha", false},
+		{">=1.0.0-rc.1", "1.0.0-rc.2", true},
+		{">=1.0.0-rc.1", "1.0.0-rc.0", false},
+		{">=1.0.0-rc.1", "1.0.0", true},
+		{">=1.0.0-rc.1", "1.1.0-rc.1", false},
+		{"^1.0.0-beta", "1.0.0-rc.1", true},
+		{"^1.0.0-beta", "1.5.0", true},
+		{"<=2.0.0-rc.1", "1.5.0", true},
+		{">0.9.0 <1.0.0-rc.1 || 1.0.0-rc.1", "1.0.0-rc.1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" allows "+tt.version, func(t *testing.T) {
+			c, err := ParseConstraint(tt.constraint)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			v, err := Parse(tt.version)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := c.Allows(v); result != tt.expected {
+				t.Errorf("Allows() = %v, want %v", result, tt.expected)
+			}
+			if result := v.Satisfies(c); result != tt.expected {
+				t.Errorf("Satisfies() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMustParseConstraint(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic but got none")
+		}
+	}()
+	MustParseConstraint(">=x.y")
+}
+
+func TestConstraintNextOutside(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expected   string
+		found      bool
+	}{
+		{"^1.2", "1.5.0", "2.0.0", true},
+		{"~1.2.3", "1.2.3", "1.3.0", true},
+		{">=1.0.0 <=1.4.2", "1.1.0", "1.4.3", true},
+		{"1.x || 2.x", "1.5.0", "3.0.0", true},
+		{"1.x || 3.x", "1.5.0", "2.0.0", true},
+		{">=1.0.0 !=1.2.0", "1.1.0", "1.2.0", true},
+		{"1.2.3", "1.2.3", "1.2.4", true},
+		{"<1.0.0", "2.0.0", "2.0.1", true},
+		{"<2.0.0-rc.1", "1.9.0", "2.0.0", true},
+		{"<=2.0.0-rc.1", "1.9.0", "2.0.0", true},
+		{"^1.2", "1.5.0-beta", "2.0.0", true},
+		{">=1.0.0", "1.5.0", "", false},
+		{"*", "0.1.0", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" after "+tt.version, func(t *testing.T) {
+			c := MustParseConstraint(tt.constraint)
+			result, found := c.NextOutside(mustParse(t, tt.version))
+			if found != tt.found {
+				t.Fatalf("NextOutside() found = %v, want %v", found, tt.found)
+			}
+			if found && result.String() != tt.expected {
+				t.Errorf("NextOutside() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
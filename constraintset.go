@@ -0,0 +1,94 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// maxIndexedMajors is the number of major versions a constraint of a ConstraintSet may span to be indexed by major version.
+// Wider constraints, like ">=1.0.0", are checked for every version.
+const maxIndexedMajors = 16
+
+// ConstraintSet holds named constraints, e.g. the rules of a policy engine, and finds those a version satisfies.
+// Constraints are indexed by the major versions they span, so only a few of them are checked for each version,
+// and equal constraint strings are parsed once. It is safe for concurrent use. The zero value is ready to use.
+type ConstraintSet struct {
+	mu          sync.RWMutex
+	names       []string
+	constraints []Constraint
+	parsed      map[string]Constraint
+	byMajor     map[uint][]int
+	wide        []int
+}
+
+// Add parses the constraint and adds it to the set under the name.
+// It returns an error if the name is already taken or the constraint cannot be parsed.
+func (s *ConstraintSet) Add(name, constraint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if slices.Contains(s.names, name) {
+		return fmt.Errorf("constraint %s already exists", name)
+	}
+	c, ok := s.parsed[constraint]
+	if !ok {
+		var err error
+		if c, err = ParseConstraint(constraint); err != nil {
+			return fmt.Errorf("invalid constraint %s: %w", name, err)
+		}
+		if s.parsed == nil {
+			s.parsed, s.byMajor = map[string]Constraint{}, map[uint][]int{}
+		}
+		s.parsed[constraint] = c
+	}
+
+	i := len(s.names)
+	s.names = append(s.names, name)
+	s.constraints = append(s.constraints, c)
+
+	// Index the constraint by the major versions it spans
+	for _, iv := range c.Intervals() {
+		var lowest uint
+		if !iv.Lower.Unbounded {
+			lowest = iv.Lower.Version.Major
+		}
+		if iv.Upper.Unbounded || iv.Upper.Version.Major-lowest >= maxIndexedMajors {
+			s.wide = append(s.wide, i)
+			continue
+		}
+		for major := lowest; major <= iv.Upper.Version.Major; major++ {
+			if indexes := s.byMajor[major]; len(indexes) == 0 || indexes[len(indexes)-1] != i {
+				s.byMajor[major] = append(indexes, i)
+			}
+		}
+	}
+	return nil
+}
+
+// Len returns the number of constraints in the set.
+func (s *ConstraintSet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.names)
+}
+
+// Matching returns the names of the constraints the version satisfies, in the order they were added.
+func (s *ConstraintSet) Matching(v SemVer) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Check the candidates for the major version once each, in the order they were added
+	candidates := slices.Concat(s.byMajor[v.Major], s.wide)
+	slices.Sort(candidates)
+	candidates = slices.Compact(candidates)
+
+	var names []string
+	for _, i := range candidates {
+		if s.constraints[i].Allows(v) {
+			names = append(names, s.names[i])
+		}
+	}
+	return names
+}
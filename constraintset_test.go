@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestConstraintSetMatching(t *testing.T) {
+	var set ConstraintSet
+	rules := []struct{ name, constraint string }{
+		{"legacy", "<1.0.0"},
+		{"v1", "^1.0.0"},
+		{"v1-or-v3", "1.x || 3.x"},
+		{"modern", ">=2.0.0"},
+		{"rc", ">=2.0.0-rc.1 <2.0.0"},
+		{"wide", ">=1.0.0 <40.0.0"},
+		{"blocked", "!=2.1.0"},
+		{"also-v1", "^1.0.0"},
+	}
+	for _, r := range rules {
+		if err := set.Add(r.name, r.constraint); err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+	}
+
+	tests := []struct {
+		version  string
+		expected []string
+	}{
+		{"0.5.0", []string{"legacy", "blocked"}},
+		{"1.2.0", []string{"v1", "v1-or-v3", "wide", "blocked", "also-v1"}},
+		{"2.0.0-rc.2", []string{"rc"}},
+		{"2.1.0", []string{"modern", "wide"}},
+		{"3.0.0", []string{"v1-or-v3", "modern", "wide", "blocked"}},
+		{"45.0.0", []string{"modern", "blocked"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if result := set.Matching(mustParse(t, tt.version)); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Matching() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	if set.Len() != len(rules) {
+		t.Errorf("Len() = %v, want %v", set.Len(), len(rules))
+	}
+}
+
+func TestConstraintSetAdd(t *testing.T) {
+	var set ConstraintSet
+	if err := set.Add("a", "^1.0"); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if err := set.Add("a", "^2.0"); err == nil {
+		t.Errorf("Expected error for a duplicate name but got none")
+	}
+	if err := set.Add("b", ">=1.y"); err == nil {
+		t.Errorf("Expected error for an invalid constraint but got none")
+	}
+	if set.Len() != 1 {
+		t.Errorf("Len() = %v, want 1", set.Len())
+	}
+}
+
+func TestConstraintSetMatchesLinearScan(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	var set ConstraintSet
+	var constraints []Constraint
+	for i := 0; i < 200; i++ {
+		c := MustParseConstraint(RandomConstraint(r, 30))
+		constraints = append(constraints, c)
+		if err := set.Add(fmt.Sprint(i), c.String()); err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+	}
+
+	for i := 0; i < 200; i++ {
+		v := RandomVersion(r, 30)
+		var expected []string
+		for j, c := range constraints {
+			if c.Allows(v) {
+				expected = append(expected, fmt.Sprint(j))
+			}
+		}
+		if result := set.Matching(v); !reflect.DeepEqual(result, expected) {
+			t.Fatalf("Matching(%v) = %v, want %v", v, result, expected)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package semver
+
+import (
+	"context"
+)
+
+// contextKey is the key of the version stored in a context.
+type contextKey struct{}
+
+// NewContext returns a copy of the context carrying the version, e.g. the API version a middleware
+// negotiated with the client, for handlers further down to read with FromContext.
+func NewContext(ctx context.Context, v SemVer) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// FromContext returns the version stored in the context by NewContext, or false if there is none.
+func FromContext(ctx context.Context) (SemVer, bool) {
+	v, ok := ctx.Value(contextKey{}).(SemVer)
+	return v, ok
+}
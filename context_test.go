@@ -0,0 +1,29 @@
+package semver
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Errorf("FromContext() found a version in an empty context")
+	}
+
+	v := mustParse(t, "1.2.0-rc.1+build.5")
+	ctx := NewContext(context.Background(), v)
+	if result, ok := FromContext(ctx); !ok || result != v {
+		t.Errorf("FromContext() = %v, %v, want %v, true", result, ok, v)
+	}
+
+	// Derived contexts carry the version, a nested NewContext replaces it
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if result, _ := FromContext(child); result != v {
+		t.Errorf("FromContext() of derived context = %v, want %v", result, v)
+	}
+	other := mustParse(t, "2.0.0")
+	if result, _ := FromContext(NewContext(child, other)); result != other {
+		t.Errorf("FromContext() of nested context = %v, want %v", result, other)
+	}
+}
@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"strings"
+)
+
+// ConventionalCommit is the parsed header and breaking change marker of a commit message
+// following the Conventional Commits specification, e.g. "feat(parser)!: drop v prefix".
+type ConventionalCommit struct {
+	Type        string
+	Scope       string
+	Description string
+	Breaking    bool
+}
+
+// ParseConventionalCommit parses a commit message following the Conventional Commits specification.
+// The commit is breaking if the header has a "!" before the colon or a footer starts with
+// "BREAKING CHANGE:" or "BREAKING-CHANGE:". The type is returned in lower case.
+// It returns false if the header does not follow the specification.
+func ParseConventionalCommit(message string) (ConventionalCommit, bool) {
+	header, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+
+	// Split the header into prefix and description
+	prefix, description, found := strings.Cut(header, ": ")
+	if !found || strings.TrimSpace(description) == "" {
+		return ConventionalCommit{}, false
+	}
+
+	c := ConventionalCommit{Description: strings.TrimSpace(description)}
+
+	// Parse breaking change marker
+	if strings.HasSuffix(prefix, "!") {
+		c.Breaking = true
+		prefix = strings.TrimSuffix(prefix, "!")
+	}
+
+	// Parse scope
+	if open := strings.Index(prefix, "("); open >= 0 {
+		if !strings.HasSuffix(prefix, ")") || open+2 > len(prefix)-1 {
+			return ConventionalCommit{}, false
+		}
+		c.Scope = prefix[open+1 : len(prefix)-1]
+		prefix = prefix[:open]
+	}
+
+	// Parse type
+	if prefix == "" || strings.IndexFunc(prefix, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	}) >= 0 {
+		return ConventionalCommit{}, false
+	}
+	c.Type = strings.ToLower(prefix)
+
+	// Parse breaking change footers
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			c.Breaking = true
+		}
+	}
+
+	return c, true
+}
+
+// ChangeType returns the change type of the commit: ChangeMajor for breaking changes,
+// ChangeMinor for "feat", ChangePatch for "fix" and "perf" and ChangeNone otherwise.
+func (c ConventionalCommit) ChangeType() ChangeType {
+	switch {
+	case c.Breaking:
+		return ChangeMajor
+	case c.Type == "feat":
+		return ChangeMinor
+	case c.Type == "fix" || c.Type == "perf":
+		return ChangePatch
+	}
+	return ChangeNone
+}
+
+// AnalyzeCommits returns the change type required by a list of commit messages,
+// which is the largest change type of the messages following the Conventional Commits specification.
+// Other messages are ignored.
+func AnalyzeCommits(messages []string) ChangeType {
+	change := ChangeNone
+	for _, message := range messages {
+		if c, ok := ParseConventionalCommit(message); ok {
+			change = max(change, c.ChangeType())
+		}
+	}
+	return change
+}
@@ -0,0 +1,88 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	tests := []struct {
+		name        string
+		message     string
+		expected    ConventionalCommit
+		expectError bool
+	}{
+		{
+			name:     "Type only",
+			message:  "fix: handle empty input",
+			expected: ConventionalCommit{Type: "fix", Description: "handle empty input"},
+		},
+		{
+			name:     "Type with scope",
+			message:  "feat(parser): accept v prefix",
+			expected: ConventionalCommit{Type: "feat", Scope: "parser", Description: "accept v prefix"},
+		},
+		{
+			name:     "Breaking marker",
+			message:  "refactor(api)!: rename Parse",
+			expected: ConventionalCommit{Type: "refactor", Scope: "api", Description: "rename Parse", Breaking: true},
+		},
+		{
+			name:     "Breaking change footer",
+			message:  "Feat: new API\n\nBody.\n\nBREAKING CHANGE: Parse returns a pointer",
+			expected: ConventionalCommit{Type: "feat", Description: "new API", Breaking: true},
+		},
+		{
+			name:     "Breaking change footer with hyphen",
+			message:  "chore: drop Go 1.20\n\nBREAKING-CHANGE: requires Go 1.21",
+			expected: ConventionalCommit{Type: "chore", Description: "drop Go 1.20", Breaking: true},
+		},
+		{name: "Missing colon", message: "fix handle empty input", expectError: true},
+		{name: "Missing description", message: "fix: ", expectError: true},
+		{name: "Missing type", message: "(parser): fix", expectError: true},
+		{name: "Empty scope", message: "fix(): fix", expectError: true},
+		{name: "Unclosed scope", message: "fix(parser: fix", expectError: true},
+		{name: "Merge commit", message: "Merge branch 'main' into feature", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ok := ParseConventionalCommit(tt.message)
+			if tt.expectError {
+				if ok {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if !ok {
+				t.Errorf("Did not expect error but got one")
+				return
+			}
+			if c != tt.expected {
+				t.Errorf("ParseConventionalCommit() = %+v, want %+v", c, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnalyzeCommits(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		expected ChangeType
+	}{
+		{name: "No commits", messages: nil, expected: ChangeNone},
+		{name: "Chores only", messages: []string{"docs: typo", "chore: update deps", "WIP"}, expected: ChangeNone},
+		{name: "Fix", messages: []string{"docs: typo", "fix: crash"}, expected: ChangePatch},
+		{name: "Performance", messages: []string{"perf: faster compare"}, expected: ChangePatch},
+		{name: "Feature", messages: []string{"fix: crash", "feat: sort command", "fix: typo"}, expected: ChangeMinor},
+		{name: "Breaking", messages: []string{"feat: sort command", "fix!: reject empty input"}, expected: ChangeMajor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := AnalyzeCommits(tt.messages); result != tt.expected {
+				t.Errorf("AnalyzeCommits() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
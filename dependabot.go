@@ -0,0 +1,69 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Update types as used in Dependabot ignore conditions and the update-type output of dependabot/fetch-metadata.
+const (
+	DependabotSemVerMajor = "version-update:semver-major"
+	DependabotSemVerMinor = "version-update:semver-minor"
+	DependabotSemVerPatch = "version-update:semver-patch"
+)
+
+// ParseDependabotVersions normalizes the versions list of a Dependabot ignore condition,
+// e.g. ["4.x", ">= 5.0.0, < 6"], into a single Constraint matching any of the entries.
+// Entries use the same syntax as ParseConstraint, with comparators separated by commas.
+// It returns an error if the list is empty or an entry is malformed.
+func ParseDependabotVersions(versions []string) (Constraint, error) {
+	if len(versions) == 0 {
+		return Constraint{}, fmt.Errorf("invalid dependabot versions: empty list")
+	}
+
+	alternatives := make([]string, len(versions))
+	for i, entry := range versions {
+		// Validate every entry on its own so errors point at the offending one
+		if _, err := ParseConstraint(entry); err != nil {
+			return Constraint{}, fmt.Errorf("invalid dependabot versions: %w", err)
+		}
+		alternatives[i] = strings.TrimSpace(entry)
+	}
+
+	return ParseConstraint(strings.Join(alternatives, " || "))
+}
+
+// ParseDependabotUpdateTypes normalizes the update-types of a Dependabot ignore condition or
+// fetch-metadata output into a Constraint matching the versions they describe, relative to
+// the current version of the dependency:
+//
+//	version-update:semver-major  >=2.0.0 for 1.2.3
+//	version-update:semver-minor  >=1.3.0 <2.0.0 for 1.2.3
+//	version-update:semver-patch  >=1.2.4 <1.3.0 for 1.2.3
+//
+// It returns an error if the list is empty or contains an unknown update type.
+func ParseDependabotUpdateTypes(updateTypes []string, current SemVer) (Constraint, error) {
+	if len(updateTypes) == 0 {
+		return Constraint{}, fmt.Errorf("invalid dependabot update types: empty list")
+	}
+
+	nextMajor := SemVer{Major: current.Major + 1}
+	nextMinor := SemVer{Major: current.Major, Minor: current.Minor + 1}
+	nextPatch := SemVer{Major: current.Major, Minor: current.Minor, Patch: current.Patch + 1}
+
+	alternatives := make([]string, len(updateTypes))
+	for i, updateType := range updateTypes {
+		switch strings.TrimSpace(updateType) {
+		case DependabotSemVerMajor:
+			alternatives[i] = ">=" + nextMajor.String()
+		case DependabotSemVerMinor:
+			alternatives[i] = ">=" + nextMinor.String() + " <" + nextMajor.String()
+		case DependabotSemVerPatch:
+			alternatives[i] = ">=" + nextPatch.String() + " <" + nextMinor.String()
+		default:
+			return Constraint{}, fmt.Errorf("invalid dependabot update type: %s", updateType)
+		}
+	}
+
+	return ParseConstraint(strings.Join(alternatives, " || "))
+}
@@ -0,0 +1,135 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseDependabotVersions(t *testing.T) {
+	tests := []struct {
+		name        string
+		versions    []string
+		allowed     []string
+		rejected    []string
+		expectError bool
+	}{
+		{
+			name:     "Wildcard and range",
+			versions: []string{"4.x", ">= 5.0.0, < 6"},
+			allowed:  []string{"4.2.0", "5.9.9"},
+			rejected: []string{"3.9.9", "6.0.0"},
+		},
+		{
+			name:     "Exact version",
+			versions: []string{"1.2.3"},
+			allowed:  []string{"1.2.3"},
+			rejected: []string{"1.2.4"},
+		},
+		{
+			name:        "Empty list",
+			versions:    nil,
+			expectError: true,
+		},
+		{
+			name:        "Malformed entry",
+			versions:    []string{"4.x", "five"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseDependabotVersions(tt.versions)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			for _, tag := range tt.allowed {
+				if !c.Allows(mustParse(t, tag)) {
+					t.Errorf("Allows(%s) = false, want true", tag)
+				}
+			}
+			for _, tag := range tt.rejected {
+				if c.Allows(mustParse(t, tag)) {
+					t.Errorf("Allows(%s) = true, want false", tag)
+				}
+			}
+		})
+	}
+}
+
+func TestParseDependabotUpdateTypes(t *testing.T) {
+	current := SemVer{Major: 1, Minor: 2, Patch: 3}
+	tests := []struct {
+		name        string
+		updateTypes []string
+		allowed     []string
+		rejected    []string
+		expectError bool
+	}{
+		{
+			name:        "Major",
+			updateTypes: []string{DependabotSemVerMajor},
+			allowed:     []string{"2.0.0", "3.1.0"},
+			rejected:    []string{"1.9.0", "1.2.4"},
+		},
+		{
+			name:        "Minor",
+			updateTypes: []string{DependabotSemVerMinor},
+			allowed:     []string{"1.3.0"},
+			rejected:    []string{"1.2.4", "2.0.0"},
+		},
+		{
+			name:        "Patch",
+			updateTypes: []string{DependabotSemVerPatch},
+			allowed:     []string{"1.2.4"},
+			rejected:    []string{"1.2.3", "1.3.0"},
+		},
+		{
+			name:        "Minor and major",
+			updateTypes: []string{DependabotSemVerMinor, DependabotSemVerMajor},
+			allowed:     []string{"1.3.0", "2.0.0"},
+			rejected:    []string{"1.2.4"},
+		},
+		{
+			name:        "Unknown update type",
+			updateTypes: []string{"version-update:semver-epoch"},
+			expectError: true,
+		},
+		{
+			name:        "Empty list",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseDependabotUpdateTypes(tt.updateTypes, current)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			for _, tag := range tt.allowed {
+				if !c.Allows(mustParse(t, tag)) {
+					t.Errorf("Allows(%s) = false, want true", tag)
+				}
+			}
+			for _, tag := range tt.rejected {
+				if c.Allows(mustParse(t, tag)) {
+					t.Errorf("Allows(%s) = true, want false", tag)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Deprecation records a deprecated feature or API of a product.
+type Deprecation struct {
+	// Name identifies the feature or API, e.g. "flag --legacy-auth".
+	Name string
+	// DeprecatedSince is the first version the feature is deprecated in.
+	DeprecatedSince SemVer
+	// RemovedIn is the first version without the feature. The zero version means no removal is scheduled.
+	RemovedIn SemVer
+	// Message explains what to use instead, for warnings and documentation.
+	Message string
+}
+
+// removalScheduled reports whether a removal version is set.
+func (d Deprecation) removalScheduled() bool {
+	return d.RemovedIn != SemVer{}
+}
+
+// Deprecations holds the deprecations of a product, so runtime warnings and generated documentation
+// share one source of truth. It is safe for concurrent use. The zero value is ready to use.
+type Deprecations struct {
+	mu      sync.RWMutex
+	entries []Deprecation
+}
+
+// Add records a deprecation.
+// It returns an error if the name is empty or already recorded, or the removal is not after the deprecation.
+func (d *Deprecations) Add(deprecation Deprecation) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if deprecation.Name == "" {
+		return fmt.Errorf("invalid deprecation: empty name")
+	}
+	if slices.ContainsFunc(d.entries, func(e Deprecation) bool { return e.Name == deprecation.Name }) {
+		return fmt.Errorf("deprecation %s already exists", deprecation.Name)
+	}
+	if deprecation.removalScheduled() && ComparePrecedence(deprecation.RemovedIn, deprecation.DeprecatedSince) <= 0 {
+		return fmt.Errorf("invalid deprecation %s: removed in %s, not after deprecated since %s",
+			deprecation.Name, deprecation.RemovedIn, deprecation.DeprecatedSince)
+	}
+	d.entries = append(d.entries, deprecation)
+	return nil
+}
+
+// Lookup returns the deprecation recorded under the name, or false if there is none.
+func (d *Deprecations) Lookup(name string) (Deprecation, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	i := slices.IndexFunc(d.entries, func(e Deprecation) bool { return e.Name == name })
+	if i < 0 {
+		return Deprecation{}, false
+	}
+	return d.entries[i], true
+}
+
+// ActiveDeprecations returns the deprecations in effect in version v, i.e. deprecated in v or earlier
+// and not yet removed, in the order they were added.
+func (d *Deprecations) ActiveDeprecations(v SemVer) []Deprecation {
+	return d.filter(func(e Deprecation) bool {
+		return ComparePrecedence(v, e.DeprecatedSince) >= 0 && (!e.removalScheduled() || ComparePrecedence(v, e.RemovedIn) < 0)
+	})
+}
+
+// RemovedBy returns the deprecated features removed in version v or earlier, in the order they were added.
+func (d *Deprecations) RemovedBy(v SemVer) []Deprecation {
+	return d.filter(func(e Deprecation) bool {
+		return e.removalScheduled() && ComparePrecedence(v, e.RemovedIn) >= 0
+	})
+}
+
+// filter returns the deprecations matching the function.
+func (d *Deprecations) filter(matches func(Deprecation) bool) []Deprecation {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var result []Deprecation
+	for _, e := range d.entries {
+		if matches(e) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeprecations(t *testing.T) {
+	var d Deprecations
+	for _, deprecation := range []Deprecation{
+		{Name: "flag --legacy-auth", DeprecatedSince: mustParse(t, "1.4.0"), RemovedIn: mustParse(t, "2.0.0"), Message: "use --auth"},
+		{Name: "endpoint /v1/items", DeprecatedSince: mustParse(t, "1.8.0")},
+		{Name: "config key timeout", DeprecatedSince: mustParse(t, "2.1.0"), RemovedIn: mustParse(t, "2.3.0")},
+	} {
+		if err := d.Add(deprecation); err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+	}
+
+	names := func(deprecations []Deprecation) []string {
+		var result []string
+		for _, e := range deprecations {
+			result = append(result, e.Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		version         string
+		expectedActive  []string
+		expectedRemoved []string
+	}{
+		{version: "1.3.9"},
+		{version: "1.4.0", expectedActive: []string{"flag --legacy-auth"}},
+		{version: "1.9.0", expectedActive: []string{"flag --legacy-auth", "endpoint /v1/items"}},
+		{version: "2.0.0-rc.1", expectedActive: []string{"flag --legacy-auth", "endpoint /v1/items"}},
+		{version: "2.0.0", expectedActive: []string{"endpoint /v1/items"}, expectedRemoved: []string{"flag --legacy-auth"}},
+		{version: "2.2.0", expectedActive: []string{"endpoint /v1/items", "config key timeout"}, expectedRemoved: []string{"flag --legacy-auth"}},
+		{version: "3.0.0", expectedActive: []string{"endpoint /v1/items"}, expectedRemoved: []string{"flag --legacy-auth", "config key timeout"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v := mustParse(t, tt.version)
+			if result := names(d.ActiveDeprecations(v)); !slices.Equal(result, tt.expectedActive) {
+				t.Errorf("ActiveDeprecations() = %v, want %v", result, tt.expectedActive)
+			}
+			if result := names(d.RemovedBy(v)); !slices.Equal(result, tt.expectedRemoved) {
+				t.Errorf("RemovedBy() = %v, want %v", result, tt.expectedRemoved)
+			}
+		})
+	}
+
+	if e, ok := d.Lookup("flag --legacy-auth"); !ok || e.Message != "use --auth" {
+		t.Errorf("Lookup() = %+v, %v", e, ok)
+	}
+	if _, ok := d.Lookup("flag --unknown"); ok {
+		t.Errorf("Lookup() of unknown name = true, want false")
+	}
+}
+
+func TestDeprecationsAdd(t *testing.T) {
+	var d Deprecations
+	if err := d.Add(Deprecation{Name: "a", DeprecatedSince: mustParse(t, "1.0.0")}); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		deprecation Deprecation
+	}{
+		{"Duplicate name", Deprecation{Name: "a", DeprecatedSince: mustParse(t, "1.1.0")}},
+		{"Empty name", Deprecation{DeprecatedSince: mustParse(t, "1.1.0")}},
+		{"Removed before deprecated", Deprecation{Name: "b", DeprecatedSince: mustParse(t, "2.0.0"), RemovedIn: mustParse(t, "1.5.0")}},
+		{"Removed when deprecated", Deprecation{Name: "c", DeprecatedSince: mustParse(t, "2.0.0"), RemovedIn: mustParse(t, "2.0.0")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := d.Add(tt.deprecation); err == nil {
+				t.Errorf("Expected error but got none")
+			}
+		})
+	}
+}
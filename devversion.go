@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// devDateLayout is the layout of the date of a dev version.
+const devDateLayout = "20060102"
+
+// DevVersion is a nightly or development build of a planned release, e.g. 1.5.0-dev.20250610.4 for the
+// fourth build on June 10, 2025 heading for 1.5.0. Dev versions sort by date and counter, and below
+// "rc" pre-releases and the release itself, but above "alpha" and "beta" pre-releases of the same version.
+type DevVersion struct {
+	// Release is the planned release, its pre-release and build metadata are ignored
+	Release SemVer
+	// Date is the build date in UTC, the time of day is ignored
+	Date time.Time
+	// Counter numbers the builds of a day starting at 1
+	Counter uint64
+}
+
+// NextDevVersion returns the next dev build of the planned release on the date in UTC,
+// numbered after the dev builds of the same release and day among the existing versions.
+func NextDevVersion(planned SemVer, date time.Time, existing []SemVer) DevVersion {
+	// The pattern is valid, as the date of any year from 1000 on consists of digits without a leading zero
+	pattern, err := NewPreReleasePattern(planned, "dev."+date.UTC().Format(devDateLayout)+".%d")
+	if err != nil {
+		panic(err)
+	}
+	v := pattern.Next(existing)
+	dev, err := ParseDevVersion(v)
+	if err != nil {
+		panic(err)
+	}
+	return dev
+}
+
+// Version returns the dev build as a pre-release of the planned release, e.g. 1.5.0-dev.20250610.4.
+func (d DevVersion) Version() SemVer {
+	return SemVer{
+		Major:      d.Release.Major,
+		Minor:      d.Release.Minor,
+		Patch:      d.Release.Patch,
+		PreRelease: fmt.Sprintf("dev.%s.%d", d.Date.UTC().Format(devDateLayout), d.Counter),
+	}
+}
+
+// String returns the version of the dev build, e.g. "1.5.0-dev.20250610.4".
+func (d DevVersion) String() string {
+	return d.Version().String()
+}
+
+// ParseDevVersion parses a dev build as returned by DevVersion.Version. Build metadata is ignored.
+// It returns an error if the pre-release is not "dev.<yyyymmdd>.<counter>" with a valid date and positive counter.
+func ParseDevVersion(v SemVer) (DevVersion, error) {
+	parts := strings.Split(v.PreRelease, ".")
+	if len(parts) != 3 || parts[0] != "dev" {
+		return DevVersion{}, fmt.Errorf("invalid dev version: %s, expected dev.<yyyymmdd>.<counter> pre-release", v)
+	}
+
+	date, err := time.Parse(devDateLayout, parts[1])
+	if err != nil || len(parts[1]) != len(devDateLayout) {
+		return DevVersion{}, fmt.Errorf("invalid dev version: %s, malformed date %s", v, parts[1])
+	}
+	counter, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil || counter == 0 {
+		return DevVersion{}, fmt.Errorf("invalid dev version: %s, malformed counter %s", v, parts[2])
+	}
+
+	return DevVersion{
+		Release: SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch},
+		Date:    date,
+		Counter: counter,
+	}, nil
+}
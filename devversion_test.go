@@ -0,0 +1,75 @@
+package semver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextDevVersion(t *testing.T) {
+	var existing []SemVer
+	for _, s := range []string{"1.4.0", "1.5.0-dev.20250609.7", "1.5.0-dev.20250610.1", "1.5.0-dev.20250610.3", "1.5.0-rc.1", "1.6.0-dev.20250610.9"} {
+		existing = append(existing, mustParse(t, s))
+	}
+
+	tests := []struct {
+		name     string
+		planned  string
+		date     time.Time
+		expected string
+	}{
+		{name: "Next build of the day", planned: "1.5.0", date: time.Date(2025, 6, 10, 22, 0, 0, 0, time.UTC), expected: "1.5.0-dev.20250610.4"},
+		{name: "First build of the day", planned: "1.5.0", date: time.Date(2025, 6, 11, 1, 0, 0, 0, time.UTC), expected: "1.5.0-dev.20250611.1"},
+		{name: "Date in UTC", planned: "1.5.0", date: time.Date(2025, 6, 11, 1, 0, 0, 0, time.FixedZone("CEST", 2*3600)), expected: "1.5.0-dev.20250610.4"},
+		{name: "Other planned release", planned: "2.0.0-rc.1+meta", date: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), expected: "2.0.0-dev.20250610.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev := NextDevVersion(mustParse(t, tt.planned), tt.date, existing)
+			if result := dev.String(); result != tt.expected {
+				t.Errorf("NextDevVersion() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDevVersion(t *testing.T) {
+	tests := []struct {
+		version     string
+		expected    DevVersion
+		expectError bool
+	}{
+		{version: "1.5.0-dev.20250610.4", expected: DevVersion{Release: SemVer{Major: 1, Minor: 5}, Date: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), Counter: 4}},
+		{version: "1.5.0-dev.20250610.12+linux", expected: DevVersion{Release: SemVer{Major: 1, Minor: 5}, Date: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), Counter: 12}},
+		{version: "1.5.0", expectError: true},
+		{version: "1.5.0-rc.20250610.4", expectError: true},
+		{version: "1.5.0-dev.20251310.4", expectError: true},
+		{version: "1.5.0-dev.2025061.4", expectError: true},
+		{version: "1.5.0-dev.20250610.0", expectError: true},
+		{version: "1.5.0-dev.20250610", expectError: true},
+		{version: "1.5.0-dev.20250610.4.extra", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			dev, err := ParseDevVersion(mustParse(t, tt.version))
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if dev != tt.expected {
+				t.Errorf("ParseDevVersion() = %+v, want %+v", dev, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDevVersionOrder(t *testing.T) {
+	// Dev versions sort by date and counter and below release candidates
+	sortedVersions(t, "1.5.0-beta.1", "1.5.0-dev.20250609.12", "1.5.0-dev.20250610.2", "1.5.0-dev.20250610.10", "1.5.0-rc.1", "1.5.0")
+}
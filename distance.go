@@ -0,0 +1,67 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// Delta is the signed difference between the components of two versions.
+type Delta struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// Distance returns how far version b is from version a per component, b minus a.
+// For a = 1.2.5 and b = 1.5.0 it is {0, 3, -5}: b is 3 minor versions ahead of a.
+// Pre-releases and build metadata are ignored.
+func Distance(a, b SemVer) Delta {
+	return Delta{
+		Major: int(b.Major) - int(a.Major),
+		Minor: int(b.Minor) - int(a.Minor),
+		Patch: int(b.Patch) - int(a.Patch),
+	}
+}
+
+// Change returns the type of the most significant component that differs, ChangeNone for equal version cores.
+func (d Delta) Change() ChangeType {
+	switch {
+	case d.Major != 0:
+		return ChangeMajor
+	case d.Minor != 0:
+		return ChangeMinor
+	case d.Patch != 0:
+		return ChangePatch
+	}
+	return ChangeNone
+}
+
+// Steps returns the difference of the most significant component that differs, e.g. 3 for {0, 3, -5}.
+// It is negative if the second version is the lower one and zero for equal version cores.
+func (d Delta) Steps() int {
+	switch d.Change() {
+	case ChangeMajor:
+		return d.Major
+	case ChangeMinor:
+		return d.Minor
+	}
+	return d.Patch
+}
+
+// String describes the delta by its most significant component, e.g. "3 minor versions ahead",
+// "1 major version behind" or "equal".
+func (d Delta) String() string {
+	change, steps := d.Change(), d.Steps()
+	if change == ChangeNone {
+		return "equal"
+	}
+
+	direction := "ahead"
+	if steps < 0 {
+		direction, steps = "behind", -steps
+	}
+	noun := "versions"
+	if steps == 1 {
+		noun = "version"
+	}
+	return fmt.Sprintf("%d %s %s %s", steps, change, noun, direction)
+}
@@ -0,0 +1,41 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected Delta
+		change   ChangeType
+		steps    int
+		text     string
+	}{
+		{name: "Minor ahead", a: "1.2.5", b: "1.5.0", expected: Delta{0, 3, -5}, change: ChangeMinor, steps: 3, text: "3 minor versions ahead"},
+		{name: "Major behind", a: "2.1.0", b: "1.9.0", expected: Delta{-1, 8, 0}, change: ChangeMajor, steps: -1, text: "1 major version behind"},
+		{name: "Patch ahead", a: "1.2.3", b: "1.2.4", expected: Delta{0, 0, 1}, change: ChangePatch, steps: 1, text: "1 patch version ahead"},
+		{name: "Pre-release ignored", a: "1.2.3-rc.1", b: "1.2.3+build", expected: Delta{}, change: ChangeNone, steps: 0, text: "equal"},
+		{name: "Several majors", a: "1.0.0", b: "4.0.0", expected: Delta{3, 0, 0}, change: ChangeMajor, steps: 3, text: "3 major versions ahead"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Distance(mustParse(t, tt.a), mustParse(t, tt.b))
+			if d != tt.expected {
+				t.Errorf("Distance() = %v, want %v", d, tt.expected)
+			}
+			if result := d.Change(); result != tt.change {
+				t.Errorf("Change() = %v, want %v", result, tt.change)
+			}
+			if result := d.Steps(); result != tt.steps {
+				t.Errorf("Steps() = %v, want %v", result, tt.steps)
+			}
+			if result := d.String(); result != tt.text {
+				t.Errorf("String() = %v, want %v", result, tt.text)
+			}
+		})
+	}
+}
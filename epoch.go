@@ -0,0 +1,58 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EpochVersion is a version with an epoch ahead of it, like "2:1.0.0" in Debian or "2!1.0.0" in PEP 440.
+// Raising the epoch starts a new version line that sorts above every version of the previous epochs,
+// e.g. after a project reset its versions to 1.0.0. Versions without an epoch have epoch 0.
+type EpochVersion struct {
+	Epoch   uint
+	Version SemVer
+}
+
+// ParseEpochVersion parses a version with an optional epoch separated by ":" or "!", e.g. "2:1.0.0".
+// It returns an error if the epoch is not a decimal number or the version is invalid.
+func ParseEpochVersion(s string) (EpochVersion, error) {
+	var ev EpochVersion
+
+	// Split off the epoch, a colon or exclamation mark cannot occur in a version
+	if i := strings.IndexAny(s, ":!"); i >= 0 {
+		epoch := s[:i]
+		if !isDigits(epoch) {
+			return EpochVersion{}, fmt.Errorf("invalid epoch version: %s, epoch must be a decimal number", s)
+		}
+		n, err := strconv.ParseUint(epoch, 10, 0)
+		if err != nil {
+			return EpochVersion{}, fmt.Errorf("invalid epoch version: %s, epoch out of range", s)
+		}
+		ev.Epoch, s = uint(n), s[i+1:]
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return EpochVersion{}, fmt.Errorf("invalid epoch version: %w", err)
+	}
+	ev.Version = v
+	return ev, nil
+}
+
+// String returns the version with its epoch separated by a colon, e.g. "2:1.0.0", or the version alone for epoch 0.
+func (ev EpochVersion) String() string {
+	if ev.Epoch == 0 {
+		return ev.Version.String()
+	}
+	return fmt.Sprintf("%d:%s", ev.Epoch, ev.Version)
+}
+
+// Compare compares two versions by epoch first and by precedence within the same epoch, as ComparePrecedence does.
+// It returns -1, 0 or 1 as this version is lower than, equal to or higher than the other.
+func (ev EpochVersion) Compare(other EpochVersion) int {
+	if result := cmpUint(ev.Epoch, other.Epoch); result != 0 {
+		return result
+	}
+	return ComparePrecedence(ev.Version, other.Version)
+}
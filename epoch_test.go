@@ -0,0 +1,80 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseEpochVersion(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    EpochVersion
+		text        string
+		expectError bool
+	}{
+		{input: "2:1.0.0", expected: EpochVersion{2, SemVer{Major: 1}}, text: "2:1.0.0"},
+		{input: "2!1.0.0-rc.1", expected: EpochVersion{2, SemVer{Major: 1, PreRelease: "rc.1"}}, text: "2:1.0.0-rc.1"},
+		{input: "1.2.3", expected: EpochVersion{0, SemVer{Major: 1, Minor: 2, Patch: 3}}, text: "1.2.3"},
+		{input: "0:1.2.3", expected: EpochVersion{0, SemVer{Major: 1, Minor: 2, Patch: 3}}, text: "1.2.3"},
+		{input: ":1.2.3", expectError: true},
+		{input: "a:1.2.3", expectError: true},
+		{input: "-1:1.2.3", expectError: true},
+		{input: "99999999999999999999:1.2.3", expectError: true},
+		{input: "1:2:1.2.3", expectError: true},
+		{input: "1:1.2", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ev, err := ParseEpochVersion(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if ev != tt.expected {
+				t.Errorf("ParseEpochVersion() = %+v, want %+v", ev, tt.expected)
+			}
+			if result := ev.String(); result != tt.text {
+				t.Errorf("String() = %q, want %q", result, tt.text)
+			}
+		})
+	}
+}
+
+func TestEpochVersionCompare(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1:1.0.0", "9.9.9", 1},
+		{"9.9.9", "1:0.1.0", -1},
+		{"1:1.0.0", "1:1.0.1", -1},
+		{"1:2.0.0-rc.1", "1:1.9.0", 1},
+		{"1:1.0.0+a", "1!1.0.0+b", 0},
+		{"2:0.0.1", "1:9.0.0", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, err := ParseEpochVersion(tt.a)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			b, err := ParseEpochVersion(tt.b)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := a.Compare(b); result != tt.expected {
+				t.Errorf("Compare() = %v, want %v", result, tt.expected)
+			}
+			if result := b.Compare(a); result != -tt.expected {
+				t.Errorf("reverse Compare() = %v, want %v", result, -tt.expected)
+			}
+		})
+	}
+}
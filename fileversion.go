@@ -0,0 +1,153 @@
+package semver
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FileVersion represents a Windows FILEVERSION or PRODUCTVERSION quad as used in VERSIONINFO resources.
+// Each of the four fields is limited to 16 bits.
+type FileVersion [4]uint16
+
+// Values of the fourth FileVersion field. Pre-releases are encoded in blocks of 10000 per stage,
+// the release uses the largest possible value so that every pre-release sorts below it.
+const (
+	fileVersionStage   = 10000
+	fileVersionRelease = math.MaxUint16
+)
+
+// preReleaseStages lists the pre-release stages understood by the numeric encodings, in ascending order.
+var preReleaseStages = []string{"alpha", "beta", "rc"}
+
+// String returns the dotted representation of the quad, e.g. "1.2.3.65535".
+func (f FileVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", f[0], f[1], f[2], f[3])
+}
+
+// ResourceString returns the comma-separated representation used in resource scripts, e.g. "1,2,3,65535".
+func (f FileVersion) ResourceString() string {
+	return fmt.Sprintf("%d,%d,%d,%d", f[0], f[1], f[2], f[3])
+}
+
+// ParseFileVersion parses a quad separated by dots or commas into a FileVersion.
+// It returns an error if there are not exactly four fields or a field does not fit into 16 bits.
+func ParseFileVersion(s string) (FileVersion, error) {
+	var f FileVersion
+
+	parts := strings.FieldsFunc(s, func(c rune) bool { return c == '.' || c == ',' })
+	if len(parts) != 4 {
+		return FileVersion{}, fmt.Errorf("invalid file version format: %s, expected four fields", s)
+	}
+
+	for i, part := range parts {
+		n, err := strconv.ParseUint(strings.TrimSpace(part), 10, 16)
+		if err != nil {
+			return FileVersion{}, fmt.Errorf("invalid file version field: %s", part)
+		}
+		f[i] = uint16(n)
+	}
+
+	return f, nil
+}
+
+// FileVersion converts the SemVer into a Windows FileVersion.
+// Major, minor and patch are copied into the first three fields. The fourth field encodes the
+// pre-release deterministically so that quads sort like the semantic versions they came from:
+//
+//	alpha, beta, rc     10000, 20000, 30000
+//	alpha.N, beta.N...  stage base + 1 + N, for N up to 9998
+//	release             65535
+//
+// Build metadata is dropped.
+// It returns an error if a component exceeds 16 bits or the pre-release does not follow the
+// supported stage[.N] form.
+func (s SemVer) FileVersion() (FileVersion, error) {
+	var f FileVersion
+
+	// Copy the version core, checking the 16-bit limit
+	for i, n := range []uint{s.Major, s.Minor, s.Patch} {
+		if n > math.MaxUint16 {
+			return FileVersion{}, fmt.Errorf("cannot convert %s: component %d exceeds %d", s, n, math.MaxUint16)
+		}
+		f[i] = uint16(n)
+	}
+
+	// Releases use the largest fourth field
+	if s.PreRelease == "" {
+		f[3] = fileVersionRelease
+		return f, nil
+	}
+
+	// Encode the pre-release stage and optional counter
+	stage, ordinal, err := parseStage(s.PreRelease)
+	if err != nil {
+		return FileVersion{}, fmt.Errorf("cannot convert %s: %w", s, err)
+	}
+	if ordinal >= fileVersionStage {
+		return FileVersion{}, fmt.Errorf("cannot convert %s: pre-release number must be between 0 and %d", s, fileVersionStage-2)
+	}
+	f[3] = uint16((stage+1)*fileVersionStage) + uint16(ordinal)
+
+	return f, nil
+}
+
+// SemVer converts the FileVersion back into a SemVer, the inverse of SemVer.FileVersion.
+// A fourth field of 0 is accepted as a release too, as that is the common convention for
+// files not produced by SemVer.FileVersion.
+// It returns an error if the fourth field does not decode to a release or pre-release.
+func (f FileVersion) SemVer() (SemVer, error) {
+	s := SemVer{
+		Major: uint(f[0]),
+		Minor: uint(f[1]),
+		Patch: uint(f[2]),
+	}
+
+	if f[3] == fileVersionRelease || f[3] == 0 {
+		return s, nil
+	}
+
+	// Decode the pre-release stage and optional counter
+	stage := int(f[3]/fileVersionStage) - 1
+	if stage < 0 || stage >= len(preReleaseStages) {
+		return SemVer{}, fmt.Errorf("cannot convert %s: fourth field %d does not encode a pre-release", f, f[3])
+	}
+	s.PreRelease = formatStage(stage, uint64(f[3]%fileVersionStage))
+
+	return s, nil
+}
+
+// parseStage splits a pre-release of the form stage[.N] into the index of the stage in preReleaseStages
+// and an ordinal, which is 0 for the bare stage and N+1 otherwise, so that "rc" < "rc.0" < "rc.1" is preserved.
+// It returns an error for any other pre-release form.
+func parseStage(preRelease string) (int, uint64, error) {
+	stageName, number, hasNumber := strings.Cut(preRelease, ".")
+
+	stage := -1
+	for i, name := range preReleaseStages {
+		if name == stageName {
+			stage = i
+		}
+	}
+	if stage < 0 {
+		return 0, 0, fmt.Errorf("pre-release must be one of %s with an optional number", strings.Join(preReleaseStages, ", "))
+	}
+	if !hasNumber {
+		return stage, 0, nil
+	}
+
+	n, err := strconv.ParseUint(number, 10, 63)
+	if err != nil {
+		return 0, 0, fmt.Errorf("pre-release must be one of %s with an optional number", strings.Join(preReleaseStages, ", "))
+	}
+	return stage, n + 1, nil
+}
+
+// formatStage is the inverse of parseStage.
+func formatStage(stage int, ordinal uint64) string {
+	if ordinal == 0 {
+		return preReleaseStages[stage]
+	}
+	return preReleaseStages[stage] + "." + strconv.FormatUint(ordinal-1, 10)
+}
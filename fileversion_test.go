@@ -0,0 +1,160 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestFileVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		semver      SemVer
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Release",
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3},
+			expected: "1.2.3.65535",
+		},
+		{
+			name:     "Release with build metadata",
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3, Build: "build.1"},
+			expected: "1.2.3.65535",
+		},
+		{
+			name:     "Alpha without number",
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "alpha"},
+			expected: "1.2.3.10000",
+		},
+		{
+			name:     "Beta with number zero",
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta.0"},
+			expected: "1.2.3.20001",
+		},
+		{
+			name:     "Release candidate",
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.12"},
+			expected: "1.2.3.30013",
+		},
+		{
+			name:        "Component exceeds 16 bits",
+			semver:      SemVer{Major: 65536},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported pre-release stage",
+			semver:      SemVer{Major: 1, PreRelease: "preview"},
+			expectError: true,
+		},
+		{
+			name:        "Pre-release number too large",
+			semver:      SemVer{Major: 1, PreRelease: "rc.9999"},
+			expectError: true,
+		},
+		{
+			name:        "Too many pre-release identifiers",
+			semver:      SemVer{Major: 1, PreRelease: "rc.1.1"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := tt.semver.FileVersion()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if f.String() != tt.expected {
+				t.Errorf("FileVersion() = %v, want %v", f, tt.expected)
+			}
+
+			// Converting back must yield the original version without build metadata
+			back, err := f.SemVer()
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			expected := tt.semver
+			expected.Build = ""
+			if back != expected {
+				t.Errorf("SemVer() = %v, want %v", back, expected)
+			}
+		})
+	}
+}
+
+func TestFileVersionOrdering(t *testing.T) {
+	versions := []string{"1.0.0-alpha", "1.0.0-alpha.0", "1.0.0-alpha.1", "1.0.0-beta", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0"}
+
+	var previous FileVersion
+	for i, tag := range versions {
+		v, err := Parse(tag)
+		if err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+		f, err := v.FileVersion()
+		if err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+		if i > 0 && f[3] <= previous[3] {
+			t.Errorf("FileVersion() of %s = %v, want greater than %v", tag, f, previous)
+		}
+		previous = f
+	}
+}
+
+func TestParseFileVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    FileVersion
+		expectError bool
+	}{
+		{name: "Dotted", input: "1.2.3.4", expected: FileVersion{1, 2, 3, 4}},
+		{name: "Resource script", input: "1, 2, 3, 4", expected: FileVersion{1, 2, 3, 4}},
+		{name: "Three fields", input: "1.2.3", expectError: true},
+		{name: "Field exceeds 16 bits", input: "1.2.3.65536", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ParseFileVersion(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if f != tt.expected {
+				t.Errorf("ParseFileVersion() = %v, want %v", f, tt.expected)
+			}
+			if f.ResourceString() != "1,2,3,4" {
+				t.Errorf("ResourceString() = %v, want %v", f.ResourceString(), "1,2,3,4")
+			}
+		})
+	}
+}
+
+func TestFileVersionToSemVerInvalid(t *testing.T) {
+	if _, err := (FileVersion{1, 2, 3, 4}).SemVer(); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+	v, err := (FileVersion{1, 2, 3, 0}).SemVer()
+	if err != nil {
+		t.Errorf("Did not expect error but got: %v", err)
+	}
+	if v.String() != "1.2.3" {
+		t.Errorf("SemVer() = %v, want %v", v, "1.2.3")
+	}
+}
@@ -0,0 +1,87 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Format returns the version formatted according to a layout, e.g. "app-{major}.{minor}[-{prerelease}].tar.gz".
+//
+// The layout may contain the placeholders {major}, {minor}, {patch}, {prerelease}, {build},
+// {core} for major.minor.patch and {version} for the canonical String form.
+// A section enclosed in square brackets is only written if all placeholders in it are non-empty,
+// so "[-{prerelease}]" adds the hyphen for pre-releases only. Sections cannot be nested.
+// A backslash writes the following character literally, e.g. "\[" for a bracket.
+// Unknown placeholders and all other characters are written as they are.
+func (s SemVer) Format(layout string) string {
+	var out, section strings.Builder
+	inSection, sectionEmpty := false, false
+
+	// write appends to the current section or the output
+	write := func(text string) {
+		if inSection {
+			section.WriteString(text)
+		} else {
+			out.WriteString(text)
+		}
+	}
+
+	for i := 0; i < len(layout); i++ {
+		switch c := layout[i]; {
+		case c == '\\' && i+1 < len(layout):
+			i++
+			write(layout[i : i+1])
+		case c == '[' && !inSection:
+			inSection, sectionEmpty = true, false
+			section.Reset()
+		case c == ']' && inSection:
+			if !sectionEmpty {
+				out.WriteString(section.String())
+			}
+			inSection = false
+		case c == '{':
+			end := strings.IndexByte(layout[i:], '}')
+			if end < 0 {
+				write(layout[i:])
+				i = len(layout)
+				break
+			}
+			value, ok := s.placeholder(layout[i+1 : i+end])
+			if !ok {
+				value = layout[i : i+end+1]
+			}
+			sectionEmpty = sectionEmpty || value == ""
+			write(value)
+			i += end
+		default:
+			write(layout[i : i+1])
+		}
+	}
+
+	// An unterminated section is written literally
+	if inSection {
+		out.WriteString("[" + section.String())
+	}
+	return out.String()
+}
+
+// placeholder returns the value of a placeholder of Format, or false if the name is unknown.
+func (s SemVer) placeholder(name string) (string, bool) {
+	switch name {
+	case "major":
+		return strconv.FormatUint(uint64(s.Major), 10), true
+	case "minor":
+		return strconv.FormatUint(uint64(s.Minor), 10), true
+	case "patch":
+		return strconv.FormatUint(uint64(s.Patch), 10), true
+	case "prerelease":
+		return s.PreRelease, true
+	case "build":
+		return s.Build, true
+	case "core":
+		return SemVer{Major: s.Major, Minor: s.Minor, Patch: s.Patch}.String(), true
+	case "version":
+		return s.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,36 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		layout   string
+		expected string
+	}{
+		{name: "Components", version: "1.2.3", layout: "{major}.{minor}.{patch}", expected: "1.2.3"},
+		{name: "Artifact name", version: "1.2.3-rc.1+sha.abc", layout: "app-{major}.{minor}.{patch}[-{prerelease}][+{build}].tar.gz", expected: "app-1.2.3-rc.1+sha.abc.tar.gz"},
+		{name: "Optional sections omitted", version: "1.2.3", layout: "app-{core}[-{prerelease}][+{build}].tar.gz", expected: "app-1.2.3.tar.gz"},
+		{name: "Section with several placeholders", version: "1.2.3-rc.1", layout: "{core}[ ({prerelease}, {build})]", expected: "1.2.3"},
+		{name: "Section with literal text only", version: "1.2.3", layout: "[latest ]{version}", expected: "latest 1.2.3"},
+		{name: "Version", version: "1.2.3-rc.1+b", layout: "v{version}", expected: "v1.2.3-rc.1+b"},
+		{name: "Core drops pre-release", version: "1.2.3-rc.1+b", layout: "{core}", expected: "1.2.3"},
+		{name: "Unknown placeholder", version: "1.2.3", layout: "{major}-{epoch}", expected: "1-{epoch}"},
+		{name: "Unterminated placeholder", version: "1.2.3", layout: "{major}-{minor", expected: "1-{minor"},
+		{name: "Unterminated section", version: "1.2.3-rc.1", layout: "{core}[-{prerelease}", expected: "1.2.3[-rc.1"},
+		{name: "Escapes", version: "1.2.3", layout: "\\[{major}\\]\\{minor}\\\\", expected: "[1]{minor}\\"},
+		{name: "Trailing backslash", version: "1.2.3", layout: "{major}\\", expected: "1\\"},
+		{name: "Closing bracket outside section", version: "1.2.3", layout: "{major}]", expected: "1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := mustParse(t, tt.version).Format(tt.layout); result != tt.expected {
+				t.Errorf("Format(%q) = %q, want %q", tt.layout, result, tt.expected)
+			}
+		})
+	}
+}
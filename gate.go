@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// Feature is a capability supported from version Since on. If Removed is set, the feature is
+// no longer supported from that version on.
+type Feature struct {
+	Name    string
+	Since   SemVer
+	Removed SemVer
+}
+
+// Supported reports whether the feature is available at version v.
+// Pre-releases of Since do not support the feature yet, pre-releases of Removed still do.
+func (f Feature) Supported(v SemVer) bool {
+	if ComparePrecedence(v, f.Since) < 0 {
+		return false
+	}
+	return f.Removed == (SemVer{}) || ComparePrecedence(v, f.Removed) < 0
+}
+
+// Gate declares the versions supporting each feature, e.g. of a protocol or an API,
+// so that peers can check which features are available at the version of the other side.
+type Gate struct {
+	features []Feature
+	index    map[string]int
+}
+
+// NewGate returns a gate declaring the features.
+// It returns an error if a feature has no name, is declared twice or is removed before it is introduced.
+func NewGate(features ...Feature) (*Gate, error) {
+	g := &Gate{index: map[string]int{}}
+	for _, f := range features {
+		if f.Name == "" {
+			return nil, fmt.Errorf("invalid feature: empty name")
+		}
+		if _, ok := g.index[f.Name]; ok {
+			return nil, fmt.Errorf("invalid feature: %s declared twice", f.Name)
+		}
+		if f.Removed != (SemVer{}) && ComparePrecedence(f.Removed, f.Since) <= 0 {
+			return nil, fmt.Errorf("invalid feature: %s removed in %s before it was introduced in %s", f.Name, f.Removed, f.Since)
+		}
+		g.index[f.Name] = len(g.features)
+		g.features = append(g.features, f)
+	}
+	return g, nil
+}
+
+// MustNewGate is like NewGate but panics if a feature is invalid.
+// It simplifies the initialization of global gate variables.
+func MustNewGate(features ...Feature) *Gate {
+	g, err := NewGate(features...)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// Enabled reports whether the feature is available at version v. Unknown features are never enabled.
+func (g *Gate) Enabled(feature string, v SemVer) bool {
+	i, ok := g.index[feature]
+	return ok && g.features[i].Supported(v)
+}
+
+// Feature returns the declaration of the feature, or false if it is unknown.
+func (g *Gate) Feature(name string) (Feature, bool) {
+	i, ok := g.index[name]
+	if !ok {
+		return Feature{}, false
+	}
+	return g.features[i], true
+}
+
+// Features returns the names of the features available at version v, in the order of declaration.
+func (g *Gate) Features(v SemVer) []string {
+	var names []string
+	for _, f := range g.features {
+		if f.Supported(v) {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
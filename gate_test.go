@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGate(t *testing.T) {
+	g := MustNewGate(
+		Feature{Name: "streaming", Since: SemVer{Major: 1, Minor: 2}},
+		Feature{Name: "legacy-auth", Removed: SemVer{Major: 2}},
+		Feature{Name: "compression", Since: SemVer{Major: 1, Minor: 5}, Removed: SemVer{Major: 3}},
+	)
+
+	tests := []struct {
+		name     string
+		version  string
+		expected []string
+	}{
+		{name: "Initial version", version: "0.1.0", expected: []string{"legacy-auth"}},
+		{name: "Pre-release of introduction", version: "1.2.0-rc.1", expected: []string{"legacy-auth"}},
+		{name: "Introduction", version: "1.2.0", expected: []string{"streaming", "legacy-auth"}},
+		{name: "All features", version: "1.9.3", expected: []string{"streaming", "legacy-auth", "compression"}},
+		{name: "Pre-release of removal", version: "2.0.0-beta", expected: []string{"streaming", "legacy-auth", "compression"}},
+		{name: "Removal", version: "2.0.0", expected: []string{"streaming", "compression"}},
+		{name: "After removals", version: "3.1.0", expected: []string{"streaming"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := mustParse(t, tt.version)
+			if result := g.Features(v); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Features() = %v, want %v", result, tt.expected)
+			}
+			for _, f := range []string{"streaming", "legacy-auth", "compression"} {
+				expected := false
+				for _, e := range tt.expected {
+					expected = expected || e == f
+				}
+				if result := g.Enabled(f, v); result != expected {
+					t.Errorf("Enabled(%q) = %v, want %v", f, result, expected)
+				}
+			}
+		})
+	}
+
+	if g.Enabled("unknown", mustParse(t, "1.0.0")) {
+		t.Errorf("Enabled(%q) = true, want false", "unknown")
+	}
+	if f, ok := g.Feature("compression"); !ok || f.Since != (SemVer{Major: 1, Minor: 5}) {
+		t.Errorf("Feature() = %v, %v, want compression since 1.5.0", f, ok)
+	}
+}
+
+func TestNewGate(t *testing.T) {
+	tests := []struct {
+		name        string
+		features    []Feature
+		expectError bool
+	}{
+		{name: "No features"},
+		{name: "Valid", features: []Feature{{Name: "a", Since: SemVer{Major: 1}}, {Name: "b", Since: SemVer{Major: 1}, Removed: SemVer{Major: 1, Patch: 1}}}},
+		{name: "Empty name", features: []Feature{{Since: SemVer{Major: 1}}}, expectError: true},
+		{name: "Duplicate", features: []Feature{{Name: "a"}, {Name: "a", Since: SemVer{Major: 1}}}, expectError: true},
+		{name: "Removed before introduced", features: []Feature{{Name: "a", Since: SemVer{Major: 2}, Removed: SemVer{Major: 1}}}, expectError: true},
+		{name: "Removed when introduced", features: []Feature{{Name: "a", Since: SemVer{Major: 2}, Removed: SemVer{Major: 2}}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewGate(tt.features...)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
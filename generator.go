@@ -0,0 +1,111 @@
+package semver
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// generatorOptions holds the configuration of a Generator.
+type generatorOptions struct {
+	maxMajor, maxMinor, maxPatch uint
+	preReleaseProbability        float64
+	buildProbability             float64
+	channels                     []string
+}
+
+// GeneratorOption configures the distribution of the versions of a Generator.
+type GeneratorOption func(*generatorOptions)
+
+// WithMaxComponents returns a GeneratorOption setting the inclusive maximum of the major, minor and patch versions,
+// 5, 20 and 20 by default.
+func WithMaxComponents(major, minor, patch uint) GeneratorOption {
+	return func(o *generatorOptions) {
+		o.maxMajor, o.maxMinor, o.maxPatch = major, minor, patch
+	}
+}
+
+// WithPreReleaseProbability returns a GeneratorOption setting the probability of a version to be a pre-release,
+// 0.2 by default.
+func WithPreReleaseProbability(p float64) GeneratorOption {
+	return func(o *generatorOptions) {
+		o.preReleaseProbability = p
+	}
+}
+
+// WithBuildProbability returns a GeneratorOption setting the probability of a version to have build metadata,
+// 0 by default.
+func WithBuildProbability(p float64) GeneratorOption {
+	return func(o *generatorOptions) {
+		o.buildProbability = p
+	}
+}
+
+// WithChannels returns a GeneratorOption setting the pre-release channels, which are drawn uniformly and
+// numbered like "rc.3". The default channels are alpha, beta and rc.
+func WithChannels(channels ...string) GeneratorOption {
+	return func(o *generatorOptions) {
+		o.channels = channels
+	}
+}
+
+// Generator emits reproducible pseudo-random versions, e.g. to simulate the contents of a registry in load tests.
+// The same seed and options always produce the same sequence. A Generator is not safe for concurrent use.
+type Generator struct {
+	r       *rand.Rand
+	options generatorOptions
+}
+
+// NewGenerator returns a generator seeded with seed and configured by the options.
+func NewGenerator(seed int64, opts ...GeneratorOption) *Generator {
+	options := generatorOptions{
+		maxMajor:              5,
+		maxMinor:              20,
+		maxPatch:              20,
+		preReleaseProbability: 0.2,
+		channels:              []string{"alpha", "beta", "rc"},
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &Generator{r: rand.New(rand.NewSource(seed)), options: options}
+}
+
+// Next returns the next version. Components are uniformly distributed up to their maximum.
+// Build metadata is a "build.<n>" identifier.
+func (g *Generator) Next() SemVer {
+	o := g.options
+	v := SemVer{
+		Major: uint(g.r.Int63n(int64(o.maxMajor) + 1)),
+		Minor: uint(g.r.Int63n(int64(o.maxMinor) + 1)),
+		Patch: uint(g.r.Int63n(int64(o.maxPatch) + 1)),
+	}
+	if len(o.channels) > 0 && g.r.Float64() < o.preReleaseProbability {
+		v.PreRelease = fmt.Sprintf("%s.%d", o.channels[g.r.Intn(len(o.channels))], 1+g.r.Intn(5))
+	}
+	if g.r.Float64() < o.buildProbability {
+		v.Build = fmt.Sprintf("build.%d", 1+g.r.Intn(1000))
+	}
+	return v
+}
+
+// Versions returns n versions of distinct precedence sorted by precedence, like the release history of a package.
+// Fewer versions are returned if the options do not allow n distinct versions to be found in reasonable time.
+func (g *Generator) Versions(n int) []SemVer {
+	seen := map[SemVer]bool{}
+	var versions []SemVer
+	for attempts := 0; len(versions) < n && attempts < 10*n+100; attempts++ {
+		v := g.Next()
+		key := v
+		key.Build = ""
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return ComparePrecedence(versions[i], versions[j]) < 0
+	})
+	return versions
+}
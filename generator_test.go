@@ -0,0 +1,86 @@
+package semver
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGeneratorReproducible(t *testing.T) {
+	a := NewGenerator(42).Versions(50)
+	b := NewGenerator(42).Versions(50)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Versions() with the same seed differ: %v and %v", a, b)
+	}
+	if c := NewGenerator(43).Versions(50); reflect.DeepEqual(a, c) {
+		t.Errorf("Versions() with different seeds are equal: %v", a)
+	}
+}
+
+func TestGeneratorDistribution(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []GeneratorOption
+		check   func(v SemVer) bool
+	}{
+		{
+			name:    "Max components",
+			options: []GeneratorOption{WithMaxComponents(1, 2, 3)},
+			check:   func(v SemVer) bool { return v.Major <= 1 && v.Minor <= 2 && v.Patch <= 3 },
+		},
+		{
+			name:    "No pre-releases",
+			options: []GeneratorOption{WithPreReleaseProbability(0)},
+			check:   func(v SemVer) bool { return v.PreRelease == "" },
+		},
+		{
+			name:    "Only pre-releases of channels",
+			options: []GeneratorOption{WithPreReleaseProbability(1), WithChannels("nightly", "canary")},
+			check: func(v SemVer) bool {
+				return strings.HasPrefix(v.PreRelease, "nightly.") || strings.HasPrefix(v.PreRelease, "canary.")
+			},
+		},
+		{
+			name:    "Build metadata",
+			options: []GeneratorOption{WithBuildProbability(1)},
+			check:   func(v SemVer) bool { return strings.HasPrefix(v.Build, "build.") },
+		},
+		{
+			name:  "Default",
+			check: func(v SemVer) bool { return v.Major <= 5 && v.Minor <= 20 && v.Patch <= 20 && v.Build == "" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGenerator(1, tt.options...)
+			for i := 0; i < 500; i++ {
+				v := g.Next()
+				if !tt.check(v) {
+					t.Fatalf("Next() = %v, which does not match the options", v)
+				}
+				if _, err := Parse(v.String()); err != nil {
+					t.Fatalf("Next() = %v, which is invalid: %v", v, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratorVersions(t *testing.T) {
+	versions := NewGenerator(7, WithBuildProbability(0.5)).Versions(100)
+	if len(versions) != 100 {
+		t.Fatalf("Versions() returned %d versions, want 100", len(versions))
+	}
+	for i := 1; i < len(versions); i++ {
+		if ComparePrecedence(versions[i-1], versions[i]) >= 0 {
+			t.Errorf("Versions() not strictly ascending at %d: %v, %v", i, versions[i-1], versions[i])
+		}
+	}
+
+	// Only four distinct versions exist
+	small := NewGenerator(7, WithMaxComponents(1, 1, 0), WithPreReleaseProbability(0)).Versions(10)
+	if len(small) != 4 {
+		t.Errorf("Versions() = %v, want the 4 possible versions", small)
+	}
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Var is a named value handed to later workflow steps through a GitHub Actions environment file.
+type Var struct {
+	Name  string
+	Value string
+}
+
+// Vars returns the version information of a version as the variables "version", "major", "minor",
+// "patch", "prerelease" and "is_release", which is "true" or "false".
+func Vars(v semver.SemVer) []Var {
+	return []Var{
+		{"version", v.String()},
+		{"major", strconv.FormatUint(uint64(v.Major), 10)},
+		{"minor", strconv.FormatUint(uint64(v.Minor), 10)},
+		{"patch", strconv.FormatUint(uint64(v.Patch), 10)},
+		{"prerelease", v.PreRelease},
+		{"is_release", strconv.FormatBool(v.IsRelease())},
+	}
+}
+
+// EnvVars returns the variables of Vars named as environment variables: upper case with a prefix,
+// e.g. "VERSION_MAJOR" for the prefix "VERSION_".
+func EnvVars(prefix string, v semver.SemVer) []Var {
+	vars := Vars(v)
+	for i := range vars {
+		vars[i].Name = prefix + strings.ToUpper(vars[i].Name)
+	}
+	return vars
+}
+
+// Write writes the variables in the format of the GITHUB_OUTPUT and GITHUB_ENV files, one "name=value" line each.
+// It returns an error if a name is empty or contains "=" or a line break, or if a value contains a line break.
+func Write(w io.Writer, vars []Var) error {
+	var b strings.Builder
+	for _, v := range vars {
+		// Validate the variable, as a stray line break would inject further variables
+		if v.Name == "" || strings.ContainsAny(v.Name, "=\r\n") {
+			return fmt.Errorf("invalid variable name: %q", v.Name)
+		}
+		if strings.ContainsAny(v.Value, "\r\n") {
+			return fmt.Errorf("invalid value of variable %s: line breaks not allowed", v.Name)
+		}
+		b.WriteString(v.Name + "=" + v.Value + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteOutput appends the version information of Vars to the file named by GITHUB_OUTPUT,
+// setting the outputs of the current step.
+// It returns an error if GITHUB_OUTPUT is not set, i.e. when not running in GitHub Actions.
+func WriteOutput(v semver.SemVer) error {
+	return appendFile("GITHUB_OUTPUT", Vars(v))
+}
+
+// WriteEnv appends the version information of EnvVars to the file named by GITHUB_ENV,
+// setting environment variables for the following steps of the job.
+// It returns an error if GITHUB_ENV is not set, i.e. when not running in GitHub Actions.
+func WriteEnv(prefix string, v semver.SemVer) error {
+	return appendFile("GITHUB_ENV", EnvVars(prefix, v))
+}
+
+// appendFile writes the variables to the end of the file named by the environment variable.
+func appendFile(name string, vars []Var) error {
+	path := os.Getenv(name)
+	if path == "" {
+		return fmt.Errorf("%s is not set", name)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := Write(f, vars); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,97 @@
+package github
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name        string
+		vars        []Var
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Version information",
+			vars:     Vars(semver.SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1", Build: "abc"}),
+			expected: "version=1.2.3-rc.1+abc\nmajor=1\nminor=2\npatch=3\nprerelease=rc.1\nis_release=false\n",
+		},
+		{
+			name:     "Release",
+			vars:     Vars(semver.SemVer{Major: 2}),
+			expected: "version=2.0.0\nmajor=2\nminor=0\npatch=0\nprerelease=\nis_release=true\n",
+		},
+		{
+			name:     "Environment variables",
+			vars:     EnvVars("APP_", semver.SemVer{Major: 2}),
+			expected: "APP_VERSION=2.0.0\nAPP_MAJOR=2\nAPP_MINOR=0\nAPP_PATCH=0\nAPP_PRERELEASE=\nAPP_IS_RELEASE=true\n",
+		},
+		{
+			name:        "Line break in value",
+			vars:        []Var{{"notes", "fix\nother=injected"}},
+			expectError: true,
+		},
+		{
+			name:        "Equals sign in name",
+			vars:        []Var{{"a=b", "c"}},
+			expectError: true,
+		},
+		{
+			name:        "Empty name",
+			vars:        []Var{{"", "c"}},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b strings.Builder
+			err := Write(&b, test.vars)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				if b.Len() != 0 {
+					t.Errorf("Write() wrote %q despite the error", b.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if b.String() != test.expected {
+				t.Errorf("Write() = %q, want %q", b.String(), test.expected)
+			}
+		})
+	}
+}
+
+func TestWriteOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output")
+	if err := os.WriteFile(path, []byte("earlier=step\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GITHUB_OUTPUT", path)
+
+	if err := WriteOutput(semver.SemVer{Major: 1, Minor: 4}); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "earlier=step\nversion=1.4.0\nmajor=1\nminor=4\npatch=0\nprerelease=\nis_release=true\n"
+	if string(data) != expected {
+		t.Errorf("GITHUB_OUTPUT = %q, want %q", data, expected)
+	}
+
+	t.Setenv("GITHUB_ENV", "")
+	if err := WriteEnv("VERSION_", semver.SemVer{Major: 1}); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
@@ -0,0 +1,125 @@
+// Package github extracts semantic versions from GitHub webhook payloads and hands computed versions
+// to GitHub Actions workflows.
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Release is the version a webhook event announces, with the flags GitHub sets on the release.
+type Release struct {
+	Version semver.SemVer
+	// Tag is the tag name as pushed, e.g. "v1.2.3"
+	Tag string
+	// Action is the action of a release event, e.g. "published", and empty for a tag push
+	Action string
+	// Draft and PreRelease are the flags of a release event, always false for a tag push
+	Draft      bool
+	PreRelease bool
+	// Repository is the full name of the repository, e.g. "mkyc/go-semver"
+	Repository string
+}
+
+// Stable reports whether the release is published as a stable release: neither a draft
+// nor flagged as a pre-release on GitHub, and not a pre-release version.
+func (r Release) Stable() bool {
+	return !r.Draft && !r.PreRelease && r.Version.IsRelease()
+}
+
+// repositoryJSON is the repository object shared by all webhook payloads.
+type repositoryJSON struct {
+	FullName string `json:"full_name"`
+}
+
+// releaseEventJSON is the part of a release event payload parsed by ParseReleaseEvent.
+type releaseEventJSON struct {
+	Action  string `json:"action"`
+	Release *struct {
+		TagName    string `json:"tag_name"`
+		Draft      bool   `json:"draft"`
+		PreRelease bool   `json:"prerelease"`
+	} `json:"release"`
+	Repository repositoryJSON `json:"repository"`
+}
+
+// pushEventJSON is the part of a push event payload parsed by ParsePushEvent.
+type pushEventJSON struct {
+	Ref        string         `json:"ref"`
+	Deleted    bool           `json:"deleted"`
+	Repository repositoryJSON `json:"repository"`
+}
+
+// ParseReleaseEvent parses the payload of a release event. The tag name may have a "v" prefix.
+// It returns an error if the payload is malformed or the tag does not name a version.
+func ParseReleaseEvent(payload []byte) (Release, error) {
+	var event releaseEventJSON
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return Release{}, fmt.Errorf("invalid release event: %w", err)
+	}
+	if event.Release == nil {
+		return Release{}, fmt.Errorf("invalid release event: missing release")
+	}
+
+	v, err := parseTag(event.Release.TagName)
+	if err != nil {
+		return Release{}, fmt.Errorf("invalid release event: %w", err)
+	}
+	return Release{
+		Version:    v,
+		Tag:        event.Release.TagName,
+		Action:     event.Action,
+		Draft:      event.Release.Draft,
+		PreRelease: event.Release.PreRelease,
+		Repository: event.Repository.FullName,
+	}, nil
+}
+
+// ParsePushEvent parses the payload of a push event. It returns false if the push is not the creation
+// or update of a tag, e.g. a branch push or a tag deletion. The tag name may have a "v" prefix.
+// It returns an error if the payload is malformed or the tag does not name a version.
+func ParsePushEvent(payload []byte) (Release, bool, error) {
+	var event pushEventJSON
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return Release{}, false, fmt.Errorf("invalid push event: %w", err)
+	}
+
+	tag, isTag := strings.CutPrefix(event.Ref, "refs/tags/")
+	if !isTag || event.Deleted {
+		return Release{}, false, nil
+	}
+
+	v, err := parseTag(tag)
+	if err != nil {
+		return Release{}, false, fmt.Errorf("invalid push event: %w", err)
+	}
+	return Release{Version: v, Tag: tag, Repository: event.Repository.FullName}, true, nil
+}
+
+// ParseEvent parses the payload of a release or push event, as named by the X-GitHub-Event header.
+// It returns false for other events and for pushes that are not the creation or update of a tag.
+// It returns an error if the payload is malformed or the tag does not name a version.
+func ParseEvent(event string, payload []byte) (Release, bool, error) {
+	switch event {
+	case "release":
+		release, err := ParseReleaseEvent(payload)
+		if err != nil {
+			return Release{}, false, err
+		}
+		return release, true, nil
+	case "push":
+		return ParsePushEvent(payload)
+	}
+	return Release{}, false, nil
+}
+
+// parseTag parses a tag name with an optional "v" prefix.
+func parseTag(tag string) (semver.SemVer, error) {
+	if tag == "" {
+		return semver.SemVer{}, fmt.Errorf("missing tag name")
+	}
+	return semver.ParseWith(tag, semver.WithVPrefix(semver.VPrefixAllow))
+}
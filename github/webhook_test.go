@@ -0,0 +1,180 @@
+package github
+
+import (
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestParseReleaseEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     string
+		expected    Release
+		stable      bool
+		expectError bool
+	}{
+		{
+			name:     "Published release",
+			payload:  `{"action": "published", "release": {"tag_name": "v1.2.3", "draft": false, "prerelease": false}, "repository": {"full_name": "mkyc/go-semver"}}`,
+			expected: Release{Version: semver.SemVer{Major: 1, Minor: 2, Patch: 3}, Tag: "v1.2.3", Action: "published", Repository: "mkyc/go-semver"},
+			stable:   true,
+		},
+		{
+			name:     "Tag without v prefix",
+			payload:  `{"action": "released", "release": {"tag_name": "2.0.0"}}`,
+			expected: Release{Version: semver.SemVer{Major: 2}, Tag: "2.0.0", Action: "released"},
+			stable:   true,
+		},
+		{
+			name:     "Draft release",
+			payload:  `{"action": "created", "release": {"tag_name": "v1.3.0", "draft": true}}`,
+			expected: Release{Version: semver.SemVer{Major: 1, Minor: 3}, Tag: "v1.3.0", Action: "created", Draft: true},
+		},
+		{
+			name:     "Flagged as pre-release",
+			payload:  `{"action": "prereleased", "release": {"tag_name": "v1.3.0-rc.1", "prerelease": true}}`,
+			expected: Release{Version: semver.SemVer{Major: 1, Minor: 3, PreRelease: "rc.1"}, Tag: "v1.3.0-rc.1", Action: "prereleased", PreRelease: true},
+		},
+		{
+			name:     "Pre-release version not flagged",
+			payload:  `{"action": "published", "release": {"tag_name": "v1.3.0-rc.1"}}`,
+			expected: Release{Version: semver.SemVer{Major: 1, Minor: 3, PreRelease: "rc.1"}, Tag: "v1.3.0-rc.1", Action: "published"},
+		},
+		{
+			name:        "Tag not naming a version",
+			payload:     `{"action": "published", "release": {"tag_name": "nightly"}}`,
+			expectError: true,
+		},
+		{
+			name:        "Missing tag name",
+			payload:     `{"action": "published", "release": {}}`,
+			expectError: true,
+		},
+		{
+			name:        "Missing release",
+			payload:     `{"action": "published"}`,
+			expectError: true,
+		},
+		{
+			name:        "Malformed JSON",
+			payload:     `{"action": `,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			release, err := ParseReleaseEvent([]byte(test.payload))
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if release != test.expected {
+				t.Errorf("ParseReleaseEvent() = %+v, want %+v", release, test.expected)
+			}
+			if release.Stable() != test.stable {
+				t.Errorf("Stable() = %v, want %v", release.Stable(), test.stable)
+			}
+		})
+	}
+}
+
+func TestParsePushEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     string
+		expected    Release
+		expectedOK  bool
+		expectError bool
+	}{
+		{
+			name:       "Tag push",
+			payload:    `{"ref": "refs/tags/v1.2.3", "deleted": false, "repository": {"full_name": "mkyc/go-semver"}}`,
+			expected:   Release{Version: semver.SemVer{Major: 1, Minor: 2, Patch: 3}, Tag: "v1.2.3", Repository: "mkyc/go-semver"},
+			expectedOK: true,
+		},
+		{
+			name:       "Pre-release tag push",
+			payload:    `{"ref": "refs/tags/1.0.0-beta.2"}`,
+			expected:   Release{Version: semver.SemVer{Major: 1, PreRelease: "beta.2"}, Tag: "1.0.0-beta.2"},
+			expectedOK: true,
+		},
+		{
+			name:    "Branch push",
+			payload: `{"ref": "refs/heads/main"}`,
+		},
+		{
+			name:    "Tag deletion",
+			payload: `{"ref": "refs/tags/v1.2.3", "deleted": true}`,
+		},
+		{
+			name:        "Tag not naming a version",
+			payload:     `{"ref": "refs/tags/release-1"}`,
+			expectError: true,
+		},
+		{
+			name:        "Malformed JSON",
+			payload:     `[]`,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			release, ok, err := ParsePushEvent([]byte(test.payload))
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if ok != test.expectedOK || release != test.expected {
+				t.Errorf("ParsePushEvent() = %+v, %v, want %+v, %v", release, ok, test.expected, test.expectedOK)
+			}
+		})
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		event       string
+		payload     string
+		expectedTag string
+		expectedOK  bool
+		expectError bool
+	}{
+		{"Release event", "release", `{"action": "published", "release": {"tag_name": "v1.0.0"}}`, "v1.0.0", true, false},
+		{"Invalid release event", "release", `{"action": "published"}`, "", false, true},
+		{"Tag push event", "push", `{"ref": "refs/tags/v1.0.0"}`, "v1.0.0", true, false},
+		{"Branch push event", "push", `{"ref": "refs/heads/main"}`, "", false, false},
+		{"Other event", "ping", `{"zen": "Keep it logically awesome."}`, "", false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			release, ok, err := ParseEvent(test.event, []byte(test.payload))
+			if test.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if ok != test.expectedOK || release.Tag != test.expectedTag {
+				t.Errorf("ParseEvent() = %q, %v, want %q, %v", release.Tag, ok, test.expectedTag, test.expectedOK)
+			}
+		})
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/mkyc/go-semver
 
-go 1.24.1
+go 1.25.0
@@ -0,0 +1,235 @@
+package semver
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mkyc/go-semver/internal/atomicfile"
+)
+
+// GoModFile holds the go and toolchain directives of a go.mod file, e.g. for bots keeping the Go versions of
+// many repositories current. The rest of the file is kept verbatim, so rewriting it produces a minimal diff.
+type GoModFile struct {
+	Path string
+	// Go is the version of the go directive, e.g. "1.22.3", or empty if there is none
+	Go string
+	// Toolchain is the name of the toolchain directive, e.g. "go1.23.1", or empty if there is none
+	Toolchain string
+
+	lines          []string
+	moduleLine     int
+	goLine         int // -1 if the file has no go directive
+	toolchainLine  int // -1 if the file has no toolchain directive
+	goToken        string
+	toolchainToken string
+	carriage       string // "\r" for files with CRLF line endings
+}
+
+// ReadGoMod reads a go.mod file and validates its go and toolchain directives with ParseGoToolchain.
+// It returns an error if the file cannot be read, has no module directive, repeats a directive
+// or a directive is malformed.
+func ReadGoMod(path string) (GoModFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return GoModFile{}, fmt.Errorf("invalid go.mod file: %w", err)
+	}
+
+	inBlock := false
+	f := GoModFile{Path: path, lines: strings.Split(string(data), "\n"), moduleLine: -1, goLine: -1, toolchainLine: -1}
+	for i, line := range f.lines {
+		if strings.HasSuffix(line, "\r") {
+			f.carriage = "\r"
+		}
+		content, _, _ := strings.Cut(line, "//")
+		fields := strings.Fields(content)
+		if len(fields) == 0 {
+			continue
+		}
+
+		// Skip blocks like require ( ... ), which hold module paths rather than directives
+		if inBlock {
+			inBlock = fields[0] != ")"
+			continue
+		}
+		if fields[len(fields)-1] == "(" {
+			inBlock = true
+			continue
+		}
+
+		// Find the directives, the go and toolchain directives take a single version
+		keyword := fields[0]
+		switch keyword {
+		case "module":
+			if f.moduleLine < 0 {
+				f.moduleLine = i
+			}
+			continue
+		case "go", "toolchain":
+		default:
+			continue
+		}
+		if len(fields) != 2 {
+			return GoModFile{}, fmt.Errorf("invalid go.mod file: %s:%d: malformed %s directive", path, i+1, keyword)
+		}
+		value := fields[1]
+
+		if keyword == "go" {
+			if f.goLine >= 0 {
+				return GoModFile{}, fmt.Errorf("invalid go.mod file: %s:%d: repeated go directive", path, i+1)
+			}
+			if err := validateGoDirective(value); err != nil {
+				return GoModFile{}, fmt.Errorf("invalid go.mod file: %s:%d: %w", path, i+1, err)
+			}
+			f.Go, f.goLine, f.goToken = value, i, value
+		} else {
+			if f.toolchainLine >= 0 {
+				return GoModFile{}, fmt.Errorf("invalid go.mod file: %s:%d: repeated toolchain directive", path, i+1)
+			}
+			if err := validateToolchainDirective(value); err != nil {
+				return GoModFile{}, fmt.Errorf("invalid go.mod file: %s:%d: %w", path, i+1, err)
+			}
+			f.Toolchain, f.toolchainLine, f.toolchainToken = value, i, value
+		}
+	}
+	if f.moduleLine < 0 {
+		return GoModFile{}, fmt.Errorf("invalid go.mod file: %s: missing module directive", path)
+	}
+	return f, nil
+}
+
+// validateGoDirective checks the version of a go directive, which has no "go" prefix.
+func validateGoDirective(version string) error {
+	if strings.HasPrefix(version, "go") {
+		return fmt.Errorf("invalid go version: %s", version)
+	}
+	_, err := ParseGoToolchain(version)
+	return err
+}
+
+// validateToolchainDirective checks the name of a toolchain directive, which has a "go" prefix.
+func validateToolchainDirective(name string) error {
+	if !strings.HasPrefix(name, "go") {
+		return fmt.Errorf("invalid go toolchain: %s", name)
+	}
+	_, err := ParseGoToolchain(name)
+	return err
+}
+
+// EffectiveToolchain returns the minimum toolchain required by the file: the toolchain directive,
+// or the toolchain of the go version if there is none, as for the go command.
+// It returns an error if the file has neither directive or it is malformed.
+func (f GoModFile) EffectiveToolchain() (SemVer, error) {
+	switch {
+	case f.Toolchain != "":
+		return ParseGoToolchain(f.Toolchain)
+	case f.Go != "":
+		return ParseGoToolchain(f.Go)
+	}
+	return SemVer{}, fmt.Errorf("go.mod file %s has no go directive", f.Path)
+}
+
+// Check checks that the go version is at least minGo and the effective toolchain is at least minToolchain,
+// e.g. "1.22" and "go1.23.1". Empty minimums are not checked.
+// It returns an error if a minimum is malformed, the file has no go directive or a version is older than required.
+func (f GoModFile) Check(minGo, minToolchain string) error {
+	if minGo != "" {
+		if f.Go == "" {
+			return fmt.Errorf("go.mod file %s has no go directive, expected at least go %s", f.Path, minGo)
+		}
+		if err := CheckGoToolchain(f.Go, minGo); err != nil {
+			return fmt.Errorf("go.mod file %s: %w", f.Path, err)
+		}
+	}
+	if minToolchain != "" {
+		toolchain, err := f.EffectiveToolchain()
+		if err != nil {
+			return err
+		}
+		want, err := ParseGoToolchain(minToolchain)
+		if err != nil {
+			return err
+		}
+		if ComparePrecedence(toolchain, want) < 0 {
+			name := f.Toolchain
+			if name == "" {
+				name = "go" + f.Go
+			}
+			return fmt.Errorf("go.mod file %s requires toolchain %s, which is older than the required %s", f.Path, name, minToolchain)
+		}
+	}
+	return nil
+}
+
+// Bump raises the go version to at least minGo and the toolchain to at least minToolchain, e.g. "1.22.0"
+// and "go1.23.1". Directives are never lowered and empty minimums are ignored. A toolchain that is not above
+// the toolchain of the go version is dropped as redundant, as by the go command.
+// It reports whether the file changed, or returns an error if a minimum is malformed.
+func (f *GoModFile) Bump(minGo, minToolchain string) (bool, error) {
+	if minGo != "" {
+		if err := validateGoDirective(minGo); err != nil {
+			return false, err
+		}
+	}
+	if minToolchain != "" {
+		if err := validateToolchainDirective(minToolchain); err != nil {
+			return false, err
+		}
+	}
+	before := *f
+
+	if minGo != "" && (f.Go == "" || CheckGoToolchain(f.Go, minGo) != nil) {
+		f.Go = minGo
+	}
+	if minToolchain != "" {
+		want, _ := ParseGoToolchain(minToolchain)
+		if current, err := f.EffectiveToolchain(); err != nil || ComparePrecedence(current, want) < 0 {
+			f.Toolchain = minToolchain
+		}
+	}
+
+	// Drop a toolchain implied by the raised go version
+	if f.Go != before.Go && f.Toolchain != "" && CheckGoToolchain(f.Go, f.Toolchain) == nil {
+		f.Toolchain = ""
+	}
+
+	return f.Go != before.Go || f.Toolchain != before.Toolchain, nil
+}
+
+// String returns the content of the file with the current directives. Missing directives are added after
+// the module directive, and directives are removed if their field is empty.
+func (f GoModFile) String() string {
+	var lines []string
+	for i, line := range f.lines {
+		switch i {
+		case f.goLine:
+			if f.Go != "" {
+				lines = append(lines, strings.Replace(line, f.goToken, f.Go, 1))
+			}
+		case f.toolchainLine:
+			if f.Toolchain != "" {
+				lines = append(lines, strings.Replace(line, f.toolchainToken, f.Toolchain, 1))
+			}
+		default:
+			lines = append(lines, line)
+		}
+
+		// Add missing directives
+		if i == f.moduleLine && f.goLine < 0 && f.Go != "" {
+			lines = append(lines, f.carriage, "go "+f.Go+f.carriage)
+		}
+		if (i == f.goLine || i == f.moduleLine && f.goLine < 0) && f.toolchainLine < 0 && f.Toolchain != "" {
+			lines = append(lines, "toolchain "+f.Toolchain+f.carriage)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Write atomically replaces the file with its content.
+// The permissions of an existing file are kept.
+func (f GoModFile) Write() error {
+	if err := atomicfile.WriteFile(f.Path, []byte(f.String())); err != nil {
+		return fmt.Errorf("write go.mod file: %w", err)
+	}
+	return nil
+}
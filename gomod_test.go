@@ -0,0 +1,199 @@
+package semver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeGoMod writes a go.mod file with the content to a temporary directory and returns its path.
+func writeGoMod(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "go.mod")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadGoMod(t *testing.T) {
+	tests := []struct {
+		name              string
+		content           string
+		expectedGo        string
+		expectedToolchain string
+		expectError       bool
+	}{
+		{
+			name:              "Go and toolchain directives",
+			content:           "module example.com/m\n\ngo 1.22.3\n\ntoolchain go1.23.1\n\nrequire golang.org/x/mod v0.20.0\n",
+			expectedGo:        "1.22.3",
+			expectedToolchain: "go1.23.1",
+		},
+		{
+			name:       "Language version with comment",
+			content:    "module example.com/m // main module\n\ngo 1.21 // minimum\n",
+			expectedGo: "1.21",
+		},
+		{
+			name:    "No directives",
+			content: "module example.com/m\n",
+		},
+		{
+			name:       "Dependency named go is not a directive",
+			content:    "module example.com/m\n\ngo 1.22.0\n\nrequire (\n\tgo v1.0.0\n)\n",
+			expectedGo: "1.22.0",
+		},
+		{name: "Missing module directive", content: "go 1.22.0\n", expectError: true},
+		{name: "Repeated go directive", content: "module m\ngo 1.21\ngo 1.22\n", expectError: true},
+		{name: "Go version with prefix", content: "module m\ngo go1.22.0\n", expectError: true},
+		{name: "Toolchain without prefix", content: "module m\ntoolchain 1.22.0\n", expectError: true},
+		{name: "Malformed toolchain", content: "module m\ntoolchain go1.22.x\n", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ReadGoMod(writeGoMod(t, tt.content))
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if f.Go != tt.expectedGo || f.Toolchain != tt.expectedToolchain {
+				t.Errorf("ReadGoMod() = go %q, toolchain %q, want go %q, toolchain %q", f.Go, f.Toolchain, tt.expectedGo, tt.expectedToolchain)
+			}
+			if f.String() != tt.content {
+				t.Errorf("String() = %q, want %q", f.String(), tt.content)
+			}
+		})
+	}
+}
+
+func TestGoModFileCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		minGo        string
+		minToolchain string
+		expectError  bool
+	}{
+		{name: "Satisfied", content: "module m\ngo 1.22.3\ntoolchain go1.23.1\n", minGo: "1.22", minToolchain: "go1.23.0"},
+		{name: "Toolchain defaults to go version", content: "module m\ngo 1.23.1\n", minToolchain: "go1.23.0"},
+		{name: "No minimums", content: "module m\n"},
+		{name: "Go version too old", content: "module m\ngo 1.21.0\n", minGo: "1.22", expectError: true},
+		{name: "Toolchain too old", content: "module m\ngo 1.22.0\ntoolchain go1.22.5\n", minToolchain: "go1.23.0", expectError: true},
+		{name: "Missing go directive", content: "module m\n", minGo: "1.22", expectError: true},
+		{name: "Malformed minimum", content: "module m\ngo 1.22.0\n", minToolchain: "latest", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ReadGoMod(writeGoMod(t, tt.content))
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			err = f.Check(tt.minGo, tt.minToolchain)
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestGoModFileBump(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		minGo           string
+		minToolchain    string
+		expected        string
+		expectedChanged bool
+		expectError     bool
+	}{
+		{
+			name:            "Raise go version",
+			content:         "module m\n\ngo 1.21 // minimum\n",
+			minGo:           "1.22.0",
+			expected:        "module m\n\ngo 1.22.0 // minimum\n",
+			expectedChanged: true,
+		},
+		{
+			name:            "Go version is never lowered",
+			content:         "module m\n\ngo 1.23.0\n",
+			minGo:           "1.22.0",
+			expected:        "module m\n\ngo 1.23.0\n",
+			expectedChanged: false,
+		},
+		{
+			name:            "Add toolchain directive",
+			content:         "module m\n\ngo 1.22.0\n\nrequire example.com/dep v1.0.0\n",
+			minToolchain:    "go1.23.1",
+			expected:        "module m\n\ngo 1.22.0\ntoolchain go1.23.1\n\nrequire example.com/dep v1.0.0\n",
+			expectedChanged: true,
+		},
+		{
+			name:            "Add go directive",
+			content:         "module m\r\n",
+			minGo:           "1.22.0",
+			expected:        "module m\r\n\r\ngo 1.22.0\r\n",
+			expectedChanged: true,
+		},
+		{
+			name:            "Drop toolchain implied by go version",
+			content:         "module m\n\ngo 1.21.0\n\ntoolchain go1.22.5\n",
+			minGo:           "1.23.0",
+			expected:        "module m\n\ngo 1.23.0\n\n",
+			expectedChanged: true,
+		},
+		{
+			name:            "Toolchain satisfied by go version",
+			content:         "module m\n\ngo 1.23.2\n",
+			minToolchain:    "go1.23.1",
+			expected:        "module m\n\ngo 1.23.2\n",
+			expectedChanged: false,
+		},
+		{name: "Malformed go version", content: "module m\n", minGo: "go1.22.0", expectError: true},
+		{name: "Malformed toolchain", content: "module m\n", minToolchain: "1.22.0", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeGoMod(t, tt.content)
+			f, err := ReadGoMod(path)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			changed, err := f.Bump(tt.minGo, tt.minToolchain)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if changed != tt.expectedChanged {
+				t.Errorf("Bump() = %v, want %v", changed, tt.expectedChanged)
+			}
+
+			if err := f.Write(); err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Write() wrote %q, want %q", data, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// goPreReleaseKinds lists the pre-release kinds of Go toolchains, e.g. "go1.23rc1".
+var goPreReleaseKinds = []string{"alpha", "beta", "rc"}
+
+// ParseGoToolchain parses a Go toolchain name like "go1.22.3" or "go1.23rc1", or a version of a go.mod
+// go directive like "1.22.3", into a SemVer ordered as by the go/version package:
+//
+//	go1.22.3    1.22.3
+//	go1.23rc1   1.23.0-rc.1
+//	go1.23      1.23.0-0, the language version, below all of its pre-releases and releases
+//	go1.20      1.20.0, as before Go 1.21 the first release of a minor version had no patch number
+//
+// The number of a pre-release becomes an identifier of its own, so rc10 sorts after rc2. A major version
+// without a minor version like "go1" is read as "go1.0". The "go" prefix is optional.
+// It returns an error if the name is malformed, e.g. has leading zeros or a pre-release of a patch release.
+func ParseGoToolchain(s string) (SemVer, error) {
+	x := strings.TrimPrefix(s, "go")
+
+	// Split off the pre-release
+	var kind, number string
+	for _, k := range goPreReleaseKinds {
+		if i := strings.Index(x, k); i >= 0 {
+			x, kind, number = x[:i], k, x[i+len(k):]
+			break
+		}
+	}
+	if kind != "" && !isGoNumber(number) {
+		return SemVer{}, fmt.Errorf("invalid go toolchain: %s, malformed %s number", s, kind)
+	}
+
+	// Parse the numeric components
+	parts := strings.Split(x, ".")
+	if len(parts) > 3 || kind != "" && len(parts) == 3 {
+		return SemVer{}, fmt.Errorf("invalid go toolchain: %s", s)
+	}
+	numbers := make([]uint, 3)
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 0)
+		if err != nil || !isGoNumber(part) {
+			return SemVer{}, fmt.Errorf("invalid go toolchain: %s", s)
+		}
+		numbers[i] = uint(n)
+	}
+
+	v := SemVer{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}
+	switch {
+	case len(parts) == 3, v.Major == 1 && v.Minor < 21 && kind == "":
+	case kind != "":
+		v.PreRelease = kind + "." + number
+	default:
+		v.PreRelease = "0"
+	}
+	return v, nil
+}
+
+// isGoNumber reports whether s is a number without leading zeros.
+func isGoNumber(s string) bool {
+	return isDigits(s) && (s == "0" || !strings.HasPrefix(s, "0"))
+}
+
+// GoToolchain returns the Go toolchain name of a version as returned by ParseGoToolchain,
+// e.g. "go1.22.3", "go1.23rc1", "go1.23" for 1.23.0-0 or "go1.20" for 1.20.0. The build metadata is ignored.
+// It returns an error if the version has no Go toolchain equivalent, e.g. a pre-release of a patch release.
+func (s SemVer) GoToolchain() (string, error) {
+	name := fmt.Sprintf("go%d.%d", s.Major, s.Minor)
+	beforeGo121 := s.Major == 1 && s.Minor < 21
+	if s.PreRelease == "" {
+		if beforeGo121 && s.Patch == 0 {
+			return name, nil
+		}
+		return fmt.Sprintf("%s.%d", name, s.Patch), nil
+	}
+	if s.Patch == 0 {
+		if s.PreRelease == "0" && !beforeGo121 {
+			return name, nil
+		}
+		kind, number, _ := strings.Cut(s.PreRelease, ".")
+		for _, k := range goPreReleaseKinds {
+			if kind == k && isGoNumber(number) {
+				return name + kind + number, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("version %s has no go toolchain equivalent", s)
+}
+
+// CheckGoToolchain checks that a Go toolchain, e.g. the output of runtime.Version, is at least the minimum
+// toolchain or go.mod go version, e.g. "go1.22.0" or "1.22".
+// It returns an error if either is malformed or the toolchain is older than the minimum.
+func CheckGoToolchain(toolchain, minimum string) error {
+	have, err := ParseGoToolchain(toolchain)
+	if err != nil {
+		return err
+	}
+	want, err := ParseGoToolchain(minimum)
+	if err != nil {
+		return err
+	}
+	if ComparePrecedence(have, want) < 0 {
+		return fmt.Errorf("go toolchain %s is older than the required %s", toolchain, minimum)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package semver
+
+import (
+	"go/version"
+	"testing"
+)
+
+func TestParseGoToolchain(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{name: "Patch release", input: "go1.22.3", expected: "1.22.3"},
+		{name: "Release candidate", input: "go1.23rc1", expected: "1.23.0-rc.1"},
+		{name: "Beta", input: "go1.22beta2", expected: "1.22.0-beta.2"},
+		{name: "Language version", input: "go1.21", expected: "1.21.0-0"},
+		{name: "First release before Go 1.21", input: "go1.20", expected: "1.20.0"},
+		{name: "Major only", input: "go1", expected: "1.0.0"},
+		{name: "Without prefix", input: "1.22.3", expected: "1.22.3"},
+		{name: "Multi-digit pre-release number", input: "go1.23rc10", expected: "1.23.0-rc.10"},
+		{name: "Pre-release of patch release", input: "go1.21.3rc1", expectError: true},
+		{name: "Leading zero", input: "go1.021", expectError: true},
+		{name: "Missing pre-release number", input: "go1.23rc", expectError: true},
+		{name: "Unknown suffix", input: "go1.22.3-custom", expectError: true},
+		{name: "Too many components", input: "go1.22.3.1", expectError: true},
+		{name: "Empty", input: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := ParseGoToolchain(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if v.String() != tt.expected {
+				t.Errorf("ParseGoToolchain(%q) = %v, want %v", tt.input, v, tt.expected)
+			}
+
+			name, err := v.GoToolchain()
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if parsed, _ := ParseGoToolchain(name); ComparePrecedence(parsed, v) != 0 {
+				t.Errorf("GoToolchain() = %v, which does not round-trip to %v", name, v)
+			}
+		})
+	}
+}
+
+func TestParseGoToolchainOrder(t *testing.T) {
+	toolchains := []string{"go1", "go1.0", "go1.9.2", "go1.20", "go1.20rc1", "go1.20.1", "go1.21", "go1.21beta1",
+		"go1.21rc1", "go1.21rc2", "go1.21rc10", "go1.21.0", "go1.21.10", "go1.22.3", "go1.23rc1"}
+
+	for _, a := range toolchains {
+		for _, b := range toolchains {
+			va, err := ParseGoToolchain(a)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			vb, err := ParseGoToolchain(b)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if got, want := ComparePrecedence(va, vb), version.Compare(a, b); got != want {
+				t.Errorf("ComparePrecedence(%v, %v) = %d, go/version compares %s and %s as %d", va, vb, got, a, b, want)
+			}
+		}
+	}
+}
+
+func TestSemVerGoToolchain(t *testing.T) {
+	tests := []struct {
+		name        string
+		semver      SemVer
+		expected    string
+		expectError bool
+	}{
+		{name: "Release", semver: SemVer{Major: 1, Minor: 22, Patch: 3}, expected: "go1.22.3"},
+		{name: "First release of a minor version", semver: SemVer{Major: 1, Minor: 22}, expected: "go1.22.0"},
+		{name: "Release candidate", semver: SemVer{Major: 1, Minor: 23, PreRelease: "rc.1"}, expected: "go1.23rc1"},
+		{name: "Language version", semver: SemVer{Major: 1, Minor: 21, PreRelease: "0"}, expected: "go1.21"},
+		{name: "First release before Go 1.21", semver: SemVer{Major: 1, Minor: 20}, expected: "go1.20"},
+		{name: "Language version before Go 1.21", semver: SemVer{Major: 1, Minor: 20, PreRelease: "0"}, expectError: true},
+		{name: "Build metadata is ignored", semver: SemVer{Major: 1, Minor: 22, Patch: 3, Build: "x"}, expected: "go1.22.3"},
+		{name: "Pre-release of patch release", semver: SemVer{Major: 1, Minor: 22, Patch: 1, PreRelease: "rc.1"}, expectError: true},
+		{name: "Unknown pre-release", semver: SemVer{Major: 1, Minor: 22, PreRelease: "dev.1"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := tt.semver.GoToolchain()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if name != tt.expected {
+				t.Errorf("GoToolchain() = %v, want %v", name, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckGoToolchain(t *testing.T) {
+	tests := []struct {
+		name        string
+		toolchain   string
+		minimum     string
+		expectError bool
+	}{
+		{name: "Newer patch release", toolchain: "go1.22.3", minimum: "go1.22.0"},
+		{name: "Same release", toolchain: "go1.22.0", minimum: "go1.22.0"},
+		{name: "Release candidate satisfies language version", toolchain: "go1.23rc1", minimum: "1.23"},
+		{name: "Older patch release", toolchain: "go1.22.1", minimum: "go1.22.3", expectError: true},
+		{name: "Release candidate before release", toolchain: "go1.23rc2", minimum: "1.23.0", expectError: true},
+		{name: "Malformed toolchain", toolchain: "devel", minimum: "1.22", expectError: true},
+		{name: "Malformed minimum", toolchain: "go1.22.3", minimum: "latest", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckGoToolchain(tt.toolchain, tt.minimum)
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package semver
+
+import (
+	"sort"
+)
+
+// GroupByMajor groups the versions by major version, e.g. for a release page with a section per major version.
+// Each group is sorted by precedence, so pre-releases come right before their release.
+func GroupByMajor(versions []SemVer) map[uint][]SemVer {
+	return groupBy(versions, func(v SemVer) uint { return v.Major })
+}
+
+// GroupByMinor groups the versions by series, e.g. for a support matrix with a row per minor version.
+// Each group is sorted by precedence, so pre-releases come right before their release.
+func GroupByMinor(versions []SemVer) map[Series][]SemVer {
+	return groupBy(versions, SeriesOf)
+}
+
+// groupBy groups the versions by key, sorting each group by precedence.
+func groupBy[K comparable](versions []SemVer, key func(SemVer) K) map[K][]SemVer {
+	groups := map[K][]SemVer{}
+	for _, v := range versions {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	for _, group := range groups {
+		sort.SliceStable(group, func(i, j int) bool {
+			return ComparePrecedence(group[i], group[j]) < 0
+		})
+	}
+	return groups
+}
@@ -0,0 +1,65 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+// versionStrings returns the string forms of the versions.
+func versionStrings(versions []SemVer) []string {
+	var result []string
+	for _, v := range versions {
+		result = append(result, v.String())
+	}
+	return result
+}
+
+func TestGroupByMajor(t *testing.T) {
+	var versions []SemVer
+	for _, s := range []string{"2.0.0", "1.5.0", "1.10.0", "2.0.0-rc.1", "1.5.0-beta", "0.1.0", "1.5.0+build.2"} {
+		versions = append(versions, mustParse(t, s))
+	}
+
+	groups := GroupByMajor(versions)
+	expected := map[uint][]string{
+		0: {"0.1.0"},
+		1: {"1.5.0-beta", "1.5.0", "1.5.0+build.2", "1.10.0"},
+		2: {"2.0.0-rc.1", "2.0.0"},
+	}
+	if len(groups) != len(expected) {
+		t.Errorf("GroupByMajor() has %d groups, want %d", len(groups), len(expected))
+	}
+	for major, want := range expected {
+		if result := versionStrings(groups[major]); !reflect.DeepEqual(result, want) {
+			t.Errorf("GroupByMajor()[%d] = %v, want %v", major, result, want)
+		}
+	}
+}
+
+func TestGroupByMinor(t *testing.T) {
+	var versions []SemVer
+	for _, s := range []string{"1.4.10", "1.4.2", "1.5.0", "1.4.3-rc.1", "2.0.0"} {
+		versions = append(versions, mustParse(t, s))
+	}
+
+	groups := GroupByMinor(versions)
+	expected := map[Series][]string{
+		{1, 4}: {"1.4.2", "1.4.3-rc.1", "1.4.10"},
+		{1, 5}: {"1.5.0"},
+		{2, 0}: {"2.0.0"},
+	}
+	if len(groups) != len(expected) {
+		t.Errorf("GroupByMinor() has %d groups, want %d", len(groups), len(expected))
+	}
+	for series, want := range expected {
+		if result := versionStrings(groups[series]); !reflect.DeepEqual(result, want) {
+			t.Errorf("GroupByMinor()[%v] = %v, want %v", series, result, want)
+		}
+	}
+}
+
+func TestGroupByEmpty(t *testing.T) {
+	if groups := GroupByMajor(nil); len(groups) != 0 {
+		t.Errorf("GroupByMajor(nil) = %v, want no groups", groups)
+	}
+}
@@ -0,0 +1,152 @@
+// Package grpcversion provides gRPC interceptors exchanging the client version via metadata
+// and rejecting clients whose version does not satisfy a server-side constraint.
+package grpcversion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMetadataKey is the metadata key the client version is sent under.
+const DefaultMetadataKey = "x-client-version"
+
+// ViolationType is the type of the precondition failure detail attached to rejections.
+const ViolationType = "CLIENT_VERSION"
+
+// Option configures the interceptors.
+type Option func(*options)
+
+type options struct {
+	key          string
+	allowMissing bool
+}
+
+// WithMetadataKey sends and reads the client version under the given metadata key instead of DefaultMetadataKey.
+func WithMetadataKey(key string) Option {
+	return func(o *options) {
+		o.key = strings.ToLower(key)
+	}
+}
+
+// AllowMissing lets server interceptors accept calls without a client version, e.g. from clients
+// that predate version negotiation. By default such calls are rejected.
+func AllowMissing() Option {
+	return func(o *options) {
+		o.allowMissing = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{key: DefaultMetadataKey}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// UnaryClientInterceptor returns an interceptor sending the client version with every unary call.
+func UnaryClientInterceptor(version semver.SemVer, opts ...Option) grpc.UnaryClientInterceptor {
+	o := newOptions(opts)
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, o.key, version.String())
+		return invoker(ctx, method, req, reply, cc, callOpts...)
+	}
+}
+
+// StreamClientInterceptor returns an interceptor sending the client version with every streaming call.
+func StreamClientInterceptor(version semver.SemVer, opts ...Option) grpc.StreamClientInterceptor {
+	o := newOptions(opts)
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx = metadata.AppendToOutgoingContext(ctx, o.key, version.String())
+		return streamer(ctx, desc, cc, method, callOpts...)
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor rejecting unary calls from clients whose version
+// does not satisfy the constraint. The client version is available to handlers via FromContext.
+func UnaryServerInterceptor(c semver.Constraint, opts ...Option) grpc.UnaryServerInterceptor {
+	o := newOptions(opts)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := o.check(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor rejecting streaming calls from clients whose version
+// does not satisfy the constraint. The client version is available to handlers via FromContext.
+func StreamServerInterceptor(c semver.Constraint, opts ...Option) grpc.StreamServerInterceptor {
+	o := newOptions(opts)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := o.check(ss.Context(), c)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream overrides the context of a server stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// FromContext returns the client version of the call with this context, as checked by the server interceptors.
+// The version is stored with semver.NewContext, so semver.FromContext returns it as well.
+func FromContext(ctx context.Context) (semver.SemVer, bool) {
+	return semver.FromContext(ctx)
+}
+
+// check validates the client version in the incoming metadata against the constraint
+// and returns the context with the client version.
+//
+// Calls without a client version or with an outdated one fail with codes.FailedPrecondition
+// and a PreconditionFailure detail of type ViolationType. Malformed versions fail with codes.InvalidArgument.
+func (o options) check(ctx context.Context, c semver.Constraint) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(o.key)
+	if len(values) == 0 {
+		if o.allowMissing {
+			return ctx, nil
+		}
+		return nil, rejection(o.key, fmt.Sprintf("client version is required and must satisfy %s", c))
+	}
+
+	v, err := semver.Parse(values[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s metadata: %v", o.key, err)
+	}
+	if !c.Allows(v) {
+		return nil, rejection(o.key, fmt.Sprintf("client version %s does not satisfy %s, please upgrade", v, c))
+	}
+	return semver.NewContext(ctx, v), nil
+}
+
+// rejection returns a FailedPrecondition status error with a PreconditionFailure detail.
+func rejection(key, description string) error {
+	st := status.New(codes.FailedPrecondition, description)
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: ViolationType, Subject: key, Description: description},
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,148 @@
+package grpcversion
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// healthServer records the client version seen by the handlers.
+type healthServer struct {
+	*health.Server
+	seen chan semver.SemVer
+}
+
+func (s *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	// The interceptors store the version with semver.NewContext
+	v, _ := semver.FromContext(ctx)
+	s.seen <- v
+	return s.Server.Check(ctx, req)
+}
+
+func (s *healthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	v, _ := FromContext(stream.Context())
+	s.seen <- v
+	return stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING})
+}
+
+// startServer starts a health server requiring client versions satisfying the constraint.
+func startServer(t *testing.T, constraint string, opts ...Option) (*bufconn.Listener, *healthServer) {
+	t.Helper()
+	c := semver.MustParseConstraint(constraint)
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor(c, opts...)),
+		grpc.StreamInterceptor(StreamServerInterceptor(c, opts...)),
+	)
+	hs := &healthServer{Server: health.NewServer(), seen: make(chan semver.SemVer, 1)}
+	healthpb.RegisterHealthServer(srv, hs)
+
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis, hs
+}
+
+// dial connects to the server, sending the client version if it is not empty.
+func dial(t *testing.T, lis *bufconn.Listener, version string, opts ...Option) healthpb.HealthClient {
+	t.Helper()
+	dialOpts := []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	if version != "" {
+		v, err := semver.Parse(version)
+		if err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+		dialOpts = append(dialOpts,
+			grpc.WithUnaryInterceptor(UnaryClientInterceptor(v, opts...)),
+			grpc.WithStreamInterceptor(StreamClientInterceptor(v, opts...)),
+		)
+	}
+	conn, err := grpc.NewClient("passthrough:///bufnet", dialOpts...)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return healthpb.NewHealthClient(conn)
+}
+
+func TestInterceptors(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		metadata     string
+		opts         []Option
+		expectedCode codes.Code
+	}{
+		{name: "Satisfying version", version: "1.4.0", expectedCode: codes.OK},
+		{name: "Outdated version", version: "1.1.0", expectedCode: codes.FailedPrecondition},
+		{name: "Missing version", expectedCode: codes.FailedPrecondition},
+		{name: "Missing version allowed", opts: []Option{AllowMissing()}, expectedCode: codes.OK},
+		{name: "Malformed version", metadata: "1.2", expectedCode: codes.InvalidArgument},
+		{name: "Custom metadata key", version: "1.2.0", opts: []Option{WithMetadataKey("X-App-Version")}, expectedCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lis, hs := startServer(t, ">=1.2.0", tt.opts...)
+			client := dial(t, lis, tt.version, tt.opts...)
+			ctx := context.Background()
+			if tt.metadata != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, DefaultMetadataKey, tt.metadata)
+			}
+
+			_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Fatalf("Check() code = %v, want %v (%v)", code, tt.expectedCode, err)
+			}
+			if tt.expectedCode == codes.OK {
+				if v := <-hs.seen; tt.version != "" && v.String() != tt.version {
+					t.Errorf("FromContext() = %v, want %v", v, tt.version)
+				}
+			}
+
+			stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("Watch() code = %v, want %v (%v)", code, tt.expectedCode, err)
+			}
+			if tt.expectedCode == codes.OK {
+				if v := <-hs.seen; tt.version != "" && v.String() != tt.version {
+					t.Errorf("FromContext() = %v, want %v", v, tt.version)
+				}
+			}
+		})
+	}
+}
+
+func TestRejectionDetails(t *testing.T) {
+	lis, _ := startServer(t, "^2")
+	client := dial(t, lis, "1.9.0")
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	st := status.Convert(err)
+	if len(st.Details()) != 1 {
+		t.Fatalf("Details() = %v, want one PreconditionFailure", st.Details())
+	}
+	failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	if !ok || len(failure.Violations) != 1 {
+		t.Fatalf("Details() = %v, want one PreconditionFailure", st.Details())
+	}
+	if violation := failure.Violations[0]; violation.Type != ViolationType || violation.Subject != DefaultMetadataKey {
+		t.Errorf("violation = %v, want type %v and subject %v", violation, ViolationType, DefaultMetadataKey)
+	}
+}
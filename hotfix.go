@@ -0,0 +1,65 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NextHotfix returns the version of the next patch release on an older release line, regardless of newer
+// series in the list of published versions: with 1.4.7 and 2.1.0 published, the hotfix of 1.4.x is 1.4.8.
+//
+// If preRelease is not empty, the hotfix is a pre-release "<preRelease>.<n>" numbered after the published
+// pre-releases with the same identifier, e.g. 1.4.8-rc.2 if 1.4.8-rc.1 is published.
+//
+// It returns an error if the series has no release, the identifier does not make a valid pre-release,
+// or the pre-release would not be higher than a published pre-release of the same patch version.
+func NextHotfix(published []SemVer, series Series, preRelease string) (SemVer, error) {
+	hasRelease := false
+	for _, v := range published {
+		hasRelease = hasRelease || series.Contains(v) && v.IsRelease()
+	}
+	if !hasRelease {
+		return SemVer{}, fmt.Errorf("invalid hotfix: no release in series %s", series)
+	}
+
+	next := series.NextPatch(published)
+	if preRelease == "" {
+		return next, nil
+	}
+
+	// Number the pre-release after the published ones of the same patch version
+	var number uint64 = 1
+	var highest *SemVer
+	for _, v := range published {
+		if v.Major != next.Major || v.Minor != next.Minor || v.Patch != next.Patch || v.IsRelease() {
+			continue
+		}
+		if highest == nil || ComparePrecedence(v, *highest) > 0 {
+			highest = &v
+		}
+		if n, err := strconv.ParseUint(strings.TrimPrefix(v.PreRelease, preRelease+"."), 10, 64); err == nil && strings.HasPrefix(v.PreRelease, preRelease+".") {
+			number = max(number, n+1)
+		}
+	}
+	next.PreRelease = fmt.Sprintf("%s.%d", preRelease, number)
+
+	if _, err := Parse(next.String()); err != nil {
+		return SemVer{}, fmt.Errorf("invalid hotfix: %w", err)
+	}
+	if highest != nil && ComparePrecedence(next, *highest) <= 0 {
+		return SemVer{}, fmt.Errorf("invalid hotfix: %s would not be higher than the published %s", next, *highest)
+	}
+	return next, nil
+}
+
+// FindCollision returns the published version with the same precedence as v, which would collide with
+// publishing v, e.g. 1.4.8+build.2 for 1.4.8+build.3. It returns false if v has not been published.
+func FindCollision(published []SemVer, v SemVer) (SemVer, bool) {
+	for _, p := range published {
+		if ComparePrecedence(p, v) == 0 {
+			return p, true
+		}
+	}
+	return SemVer{}, false
+}
@@ -0,0 +1,84 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestNextHotfix(t *testing.T) {
+	var published []SemVer
+	for _, v := range []string{"1.3.2", "1.4.0", "1.4.7", "1.5.0-rc.1", "1.6.0", "1.6.1-beta.1", "1.6.1-beta.2", "2.0.0", "2.1.0"} {
+		published = append(published, mustParse(t, v))
+	}
+
+	tests := []struct {
+		name        string
+		series      Series
+		preRelease  string
+		expected    string
+		expectError bool
+	}{
+		{name: "Old line", series: Series{Major: 1, Minor: 4}, expected: "1.4.8"},
+		{name: "Older line", series: Series{Major: 1, Minor: 3}, expected: "1.3.3"},
+		{name: "Latest line", series: Series{Major: 2, Minor: 1}, expected: "2.1.1"},
+		{name: "Pre-release", series: Series{Major: 1, Minor: 4}, preRelease: "rc", expected: "1.4.8-rc.1"},
+		{name: "Pre-release numbered after published ones", series: Series{Major: 1, Minor: 6}, preRelease: "beta", expected: "1.6.1-beta.3"},
+		{name: "Release after pre-releases", series: Series{Major: 1, Minor: 6}, expected: "1.6.1"},
+		{name: "Higher pre-release identifier", series: Series{Major: 1, Minor: 6}, preRelease: "rc", expected: "1.6.1-rc.1"},
+		{name: "Lower pre-release identifier", series: Series{Major: 1, Minor: 6}, preRelease: "alpha", expectError: true},
+		{name: "Invalid pre-release identifier", series: Series{Major: 1, Minor: 4}, preRelease: "r_c", expectError: true},
+		{name: "Series with pre-releases only", series: Series{Major: 1, Minor: 5}, expectError: true},
+		{name: "Unknown series", series: Series{Major: 3}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NextHotfix(published, tt.series, tt.preRelease)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result.String() != tt.expected {
+				t.Errorf("NextHotfix() = %v, want %v", result, tt.expected)
+			}
+			if c, ok := FindCollision(published, result); ok {
+				t.Errorf("NextHotfix() = %v collides with %v", result, c)
+			}
+		})
+	}
+}
+
+func TestFindCollision(t *testing.T) {
+	published := []SemVer{mustParse(t, "1.4.7"), mustParse(t, "1.4.8+build.2"), mustParse(t, "1.4.9-rc.1")}
+
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{version: "1.4.7", expected: "1.4.7"},
+		{version: "1.4.8+build.3", expected: "1.4.8+build.2"},
+		{version: "1.4.9-rc.1", expected: "1.4.9-rc.1"},
+		{version: "1.4.9-rc.2"},
+		{version: "1.4.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			result, ok := FindCollision(published, mustParse(t, tt.version))
+			if tt.expected == "" {
+				if ok {
+					t.Errorf("FindCollision() = %v, want none", result)
+				}
+				return
+			}
+			if !ok || result.String() != tt.expected {
+				t.Errorf("FindCollision() = %v, %v, want %v", result, ok, tt.expected)
+			}
+		})
+	}
+}
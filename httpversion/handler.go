@@ -0,0 +1,110 @@
+package httpversion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Info is the version information of an application served by Handler.
+// Commit and BuildTime are optional.
+type Info struct {
+	Version   semver.SemVer
+	Commit    string
+	BuildTime time.Time
+}
+
+// infoJSON is the JSON representation of Info.
+type infoJSON struct {
+	Version    string `json:"version"`
+	Major      uint   `json:"major"`
+	Minor      uint   `json:"minor"`
+	Patch      uint   `json:"patch"`
+	PreRelease string `json:"prerelease,omitempty"`
+	Build      string `json:"build,omitempty"`
+	Commit     string `json:"commit,omitempty"`
+	BuildTime  string `json:"buildTime,omitempty"`
+}
+
+// representation is a precomputed response body with its content type and entity tag.
+type representation struct {
+	contentType string
+	body        []byte
+	etag        string
+}
+
+func newRepresentation(contentType string, body []byte) representation {
+	sum := sha256.Sum256(body)
+	return representation{contentType: contentType, body: body, etag: `"` + hex.EncodeToString(sum[:8]) + `"`}
+}
+
+// Handler returns a handler serving the version information, e.g. on /version, so every service
+// exposes it the same way. It serves JSON like
+//
+//	{"version":"1.2.3+abc","major":1,"minor":2,"patch":3,"build":"abc","commit":"abc1234","buildTime":"2024-01-02T15:04:05Z"}
+//
+// or, if the client accepts text/plain but not application/json, the version as a single line.
+// Responses carry an ETag, and conditional requests with a matching If-None-Match are answered with 304 Not Modified.
+// Methods other than GET and HEAD are answered with 405 Method Not Allowed.
+func Handler(info Info) http.Handler {
+	output := infoJSON{
+		Version:    info.Version.String(),
+		Major:      info.Version.Major,
+		Minor:      info.Version.Minor,
+		Patch:      info.Version.Patch,
+		PreRelease: info.Version.PreRelease,
+		Build:      info.Version.Build,
+		Commit:     info.Commit,
+	}
+	if !info.BuildTime.IsZero() {
+		output.BuildTime = info.BuildTime.UTC().Format(time.RFC3339)
+	}
+
+	// Marshaling strings and numbers cannot fail
+	body, _ := json.Marshal(output)
+	jsonRepresentation := newRepresentation("application/json", append(body, '\n'))
+	textRepresentation := newRepresentation("text/plain; charset=utf-8", []byte(info.Version.String()+"\n"))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		rep := jsonRepresentation
+		accept := r.Header.Get("Accept")
+		if strings.Contains(accept, "text/plain") && !strings.Contains(accept, "application/json") {
+			rep = textRepresentation
+		}
+
+		w.Header().Set("Vary", "Accept")
+		w.Header().Set("ETag", rep.etag)
+		if etagMatches(r.Header.Get("If-None-Match"), rep.etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", rep.contentType)
+		if r.Method == http.MethodHead {
+			return
+		}
+		w.Write(rep.body)
+	})
+}
+
+// etagMatches reports whether an If-None-Match header matches the entity tag, using weak comparison.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package httpversion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestHandler(t *testing.T) {
+	handler := Handler(Info{
+		Version:   semver.SemVer{Major: 1, Minor: 2, Patch: 3, Build: "abc"},
+		Commit:    "abc1234",
+		BuildTime: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+	})
+	minimal := Handler(Info{Version: semver.SemVer{Major: 2, PreRelease: "rc.1"}})
+
+	tests := []struct {
+		name                string
+		handler             http.Handler
+		method              string
+		accept              string
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "JSON",
+			handler:             handler,
+			method:              http.MethodGet,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+			expectedBody:        `{"version":"1.2.3+abc","major":1,"minor":2,"patch":3,"build":"abc","commit":"abc1234","buildTime":"2024-01-02T15:04:05Z"}` + "\n",
+		},
+		{
+			name:                "JSON without optional fields",
+			handler:             minimal,
+			method:              http.MethodGet,
+			accept:              "application/json, text/plain",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+			expectedBody:        `{"version":"2.0.0-rc.1","major":2,"minor":0,"patch":0,"prerelease":"rc.1"}` + "\n",
+		},
+		{
+			name:                "Plain text",
+			handler:             handler,
+			method:              http.MethodGet,
+			accept:              "text/plain",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        "1.2.3+abc\n",
+		},
+		{
+			name:                "Head",
+			handler:             handler,
+			method:              http.MethodHead,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+		},
+		{
+			name:           "Post",
+			handler:        handler,
+			method:         http.MethodPost,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/version", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.expectedStatus)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			if contentType := rec.Header().Get("Content-Type"); contentType != tt.expectedContentType {
+				t.Errorf("Content-Type = %v, want %v", contentType, tt.expectedContentType)
+			}
+			if rec.Body.String() != tt.expectedBody {
+				t.Errorf("body = %v, want %v", rec.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestHandlerETag(t *testing.T) {
+	handler := Handler(Info{Version: semver.SemVer{Major: 1}})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatalf("ETag is missing")
+	}
+
+	// The plain text representation has its own entity tag
+	req := httptest.NewRequest(http.MethodGet, "/version", nil)
+	req.Header.Set("Accept", "text/plain")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Header().Get("ETag") == etag {
+		t.Errorf("ETag of plain text = %v, want it to differ from JSON", etag)
+	}
+
+	tests := []struct {
+		ifNoneMatch    string
+		expectedStatus int
+	}{
+		{etag, http.StatusNotModified},
+		{"W/" + etag, http.StatusNotModified},
+		{`"other", ` + etag, http.StatusNotModified},
+		{"*", http.StatusNotModified},
+		{`"other"`, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ifNoneMatch, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/version", nil)
+			req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.expectedStatus)
+			}
+			if tt.expectedStatus == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Errorf("body = %q, want none", rec.Body.String())
+			}
+		})
+	}
+}
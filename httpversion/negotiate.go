@@ -0,0 +1,116 @@
+// Package httpversion provides net/http helpers built on semantic versions:
+// Accept-Version negotiation middleware, a standard /version handler and
+// User-Agent version extraction for minimum client version policies.
+package httpversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// DefaultHeader is the request header holding the version constraint of the client.
+const DefaultHeader = "Accept-Version"
+
+// FromContext returns the version negotiated by Negotiate for the request with this context.
+// The version is stored with semver.NewContext, so semver.FromContext returns it as well.
+func FromContext(ctx context.Context) (semver.SemVer, bool) {
+	return semver.FromContext(ctx)
+}
+
+// NegotiateOption configures Negotiate.
+type NegotiateOption func(*negotiateOptions)
+
+type negotiateOptions struct {
+	header string
+}
+
+// WithHeader reads the constraint from the given request header instead of DefaultHeader.
+func WithHeader(name string) NegotiateOption {
+	return func(o *negotiateOptions) {
+		o.header = name
+	}
+}
+
+// NegotiationError is the JSON body of responses to requests whose version could not be negotiated.
+type NegotiationError struct {
+	Error     string   `json:"error"`
+	Requested string   `json:"requested"`
+	Supported []string `json:"supported"`
+}
+
+// Negotiate returns middleware that parses the request header as a constraint, e.g. "Accept-Version: ^2.1",
+// and serves the request with the highest supported version satisfying it by semver.ComparePrecedence,
+// available via FromContext. Requests without the header are served with the highest supported release,
+// or the highest pre-release if all supported versions are pre-releases.
+// Malformed constraints are answered with 400 Bad Request and constraints no supported version satisfies
+// with 406 Not Acceptable, both with a NegotiationError body.
+func Negotiate(supported []semver.SemVer, opts ...NegotiateOption) func(http.Handler) http.Handler {
+	o := negotiateOptions{header: DefaultHeader}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// Sort a copy once by precedence, so the highest satisfying version is the last match
+	versions := append([]semver.SemVer(nil), supported...)
+	slices.SortStableFunc(versions, semver.ComparePrecedence)
+	names := make([]string, len(versions))
+	for i, v := range versions {
+		names[i] = v.String()
+	}
+
+	// Serve requests without a constraint with the highest release rather than a pre-release
+	fallback := len(versions) - 1
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].PreRelease == "" {
+			fallback = i
+			break
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", o.header)
+
+			requested := r.Header.Get(o.header)
+			if requested == "" {
+				if len(versions) == 0 {
+					writeNegotiationError(w, http.StatusNotAcceptable, NegotiationError{Error: "no supported versions", Supported: names})
+					return
+				}
+				next.ServeHTTP(w, r.WithContext(semver.NewContext(r.Context(), versions[fallback])))
+				return
+			}
+
+			c, err := semver.ParseConstraint(requested)
+			if err != nil {
+				writeNegotiationError(w, http.StatusBadRequest, NegotiationError{Error: err.Error(), Requested: requested, Supported: names})
+				return
+			}
+
+			for i := len(versions) - 1; i >= 0; i-- {
+				if c.Allows(versions[i]) {
+					next.ServeHTTP(w, r.WithContext(semver.NewContext(r.Context(), versions[i])))
+					return
+				}
+			}
+
+			writeNegotiationError(w, http.StatusNotAcceptable, NegotiationError{
+				Error:     fmt.Sprintf("no supported version satisfies %s", requested),
+				Requested: requested,
+				Supported: names,
+			})
+		})
+	}
+}
+
+// writeNegotiationError writes the error as JSON with the given status code.
+func writeNegotiationError(w http.ResponseWriter, status int, body NegotiationError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,86 @@
+package httpversion
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestNegotiate(t *testing.T) {
+	supported := []semver.SemVer{
+		{Major: 2, Minor: 1},
+		{Major: 1, Minor: 4, Patch: 2},
+		{Major: 2},
+		{Major: 3, PreRelease: "beta.1"},
+	}
+
+	tests := []struct {
+		name           string
+		header         string
+		value          string
+		opts           []NegotiateOption
+		expectedStatus int
+		expected       string
+	}{
+		{name: "No header", expectedStatus: http.StatusOK, expected: "2.1.0"},
+		{name: "Caret range", header: DefaultHeader, value: "^1", expectedStatus: http.StatusOK, expected: "1.4.2"},
+		{name: "Exact version", header: DefaultHeader, value: "2.0.0", expectedStatus: http.StatusOK, expected: "2.0.0"},
+		{name: "Pre-release", header: DefaultHeader, value: ">=3.0.0-beta", expectedStatus: http.StatusOK, expected: "3.0.0-beta.1"},
+		{name: "Pre-release above a release", header: DefaultHeader, value: ">=2.1.0 || >=3.0.0-beta", expectedStatus: http.StatusOK, expected: "3.0.0-beta.1"},
+		{name: "Custom header", header: "X-API-Version", value: "~2.0", opts: []NegotiateOption{WithHeader("X-API-Version")}, expectedStatus: http.StatusOK, expected: "2.0.0"},
+		{name: "Default header ignored with custom header", header: DefaultHeader, value: "^1", opts: []NegotiateOption{WithHeader("X-API-Version")}, expectedStatus: http.StatusOK, expected: "2.1.0"},
+		{name: "No match", header: DefaultHeader, value: "^4", expectedStatus: http.StatusNotAcceptable},
+		{name: "Invalid constraint", header: DefaultHeader, value: "=>1", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Negotiate(supported, tt.opts...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				v, ok := FromContext(r.Context())
+				if !ok {
+					t.Errorf("FromContext() found no version")
+				}
+				if shared, _ := semver.FromContext(r.Context()); shared != v {
+					t.Errorf("semver.FromContext() = %v, want %v", shared, v)
+				}
+				w.Write([]byte(v.String()))
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.expectedStatus)
+			}
+			if tt.expectedStatus != http.StatusOK {
+				var body NegotiationError
+				if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+					t.Fatalf("Did not expect error but got: %v", err)
+				}
+				expected := []string{"1.4.2", "2.0.0", "2.1.0", "3.0.0-beta.1"}
+				if body.Requested != tt.value || !reflect.DeepEqual(body.Supported, expected) || body.Error == "" {
+					t.Errorf("body = %+v, want requested %v and supported %v", body, tt.value, expected)
+				}
+				return
+			}
+			if rec.Body.String() != tt.expected {
+				t.Errorf("version = %v, want %v", rec.Body.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromContextWithoutVersion(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if _, ok := FromContext(req.Context()); ok {
+		t.Errorf("FromContext() found a version, want none")
+	}
+}
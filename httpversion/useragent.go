@@ -0,0 +1,103 @@
+package httpversion
+
+import (
+	"net/http"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Product is a product token of a User-Agent header with a semantic version, like "mytool/1.4.2 (linux)".
+type Product struct {
+	Name    string
+	Version semver.SemVer
+	Comment string // without parentheses, empty if the product has no comment
+}
+
+// ParseUserAgent returns the products of a User-Agent header that carry a version, in order.
+// Versions may have a "v" prefix, and partial versions like "5.0" are padded with zeros,
+// as clients commonly report them. Products without or with other versions are skipped, including versions
+// that are a single number like the date in "Gecko/20100101".
+func ParseUserAgent(userAgent string) []Product {
+	var products []Product
+	var last *Product
+
+	rest := strings.TrimSpace(userAgent)
+	for rest != "" {
+		// Comments belong to the preceding product and may be nested
+		if rest[0] == '(' {
+			depth, end := 0, len(rest)
+			for i := 0; i < len(rest); i++ {
+				if rest[i] == '(' {
+					depth++
+				} else if rest[i] == ')' {
+					if depth--; depth == 0 {
+						end = i + 1
+						break
+					}
+				}
+			}
+			if last != nil && last.Comment == "" {
+				last.Comment = strings.TrimSuffix(rest[1:end], ")")
+			}
+			rest = strings.TrimSpace(rest[end:])
+			continue
+		}
+
+		end := strings.IndexAny(rest, " \t(")
+		if end < 0 {
+			end = len(rest)
+		}
+		token := rest[:end]
+		rest = strings.TrimSpace(rest[end:])
+
+		last = nil
+		name, version, found := strings.Cut(token, "/")
+		if !found || name == "" {
+			continue
+		}
+		if v, ok := parseProductVersion(version); ok {
+			products = append(products, Product{Name: name, Version: v})
+			last = &products[len(products)-1]
+		}
+	}
+	return products
+}
+
+// parseProductVersion parses the version of a product token, padding partial versions of at least
+// a major and minor version with zeros.
+func parseProductVersion(version string) (semver.SemVer, bool) {
+	version = strings.TrimPrefix(version, "v")
+
+	// Pad the version core of partial versions
+	core, suffix := version, ""
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		core, suffix = version[:i], version[i:]
+	}
+	switch strings.Count(core, ".") {
+	case 0:
+		return semver.SemVer{}, false
+	case 1:
+		core += ".0"
+	}
+
+	v, err := semver.Parse(core + suffix)
+	return v, err == nil
+}
+
+// ClientVersion returns the version of the named product in the User-Agent header of the request,
+// so handlers can enforce a minimum client version:
+//
+//	if v, ok := httpversion.ClientVersion(r, "mytool"); ok && !minimum.Allows(v) {
+//		http.Error(w, "please upgrade mytool", http.StatusUpgradeRequired)
+//	}
+//
+// Product names are matched case-insensitively. It returns false if the product is not present.
+func ClientVersion(r *http.Request, product string) (semver.SemVer, bool) {
+	for _, p := range ParseUserAgent(r.UserAgent()) {
+		if strings.EqualFold(p.Name, product) {
+			return p.Version, true
+		}
+	}
+	return semver.SemVer{}, false
+}
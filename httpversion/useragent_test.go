@@ -0,0 +1,76 @@
+package httpversion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		expected  []Product
+	}{
+		{
+			name:      "Product with comment",
+			userAgent: "mytool/1.4.2 (linux; amd64)",
+			expected:  []Product{{Name: "mytool", Version: semver.SemVer{Major: 1, Minor: 4, Patch: 2}, Comment: "linux; amd64"}},
+		},
+		{
+			name:      "Several products",
+			userAgent: "mytool/v2.0.0-rc.1 go-client/0.9 curl/8.4.0",
+			expected: []Product{
+				{Name: "mytool", Version: semver.SemVer{Major: 2, PreRelease: "rc.1"}},
+				{Name: "go-client", Version: semver.SemVer{Minor: 9}},
+				{Name: "curl", Version: semver.SemVer{Major: 8, Minor: 4}},
+			},
+		},
+		{
+			name:      "Browser",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/115.0",
+			expected: []Product{
+				{Name: "Mozilla", Version: semver.SemVer{Major: 5}, Comment: "X11; Linux x86_64; rv:109.0"},
+				{Name: "Firefox", Version: semver.SemVer{Major: 115}},
+			},
+		},
+		{
+			name:      "Nested comment",
+			userAgent: "app/1.0.0 (build (debug)) lib/2.0.0",
+			expected: []Product{
+				{Name: "app", Version: semver.SemVer{Major: 1}, Comment: "build (debug)"},
+				{Name: "lib", Version: semver.SemVer{Major: 2}},
+			},
+		},
+		{
+			name:      "Products without versions",
+			userAgent: "bot (+https://example.com) tool/latest tool/1.2.3.4 tool/2 /1.0.0",
+			expected:  nil,
+		},
+		{name: "Empty", userAgent: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := ParseUserAgent(tt.userAgent); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParseUserAgent() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClientVersion(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "MyTool/1.4.2 (linux) go-http-client/1.1")
+
+	v, ok := ClientVersion(req, "mytool")
+	if !ok || v.String() != "1.4.2" {
+		t.Errorf("ClientVersion() = %v, %v, want %v, true", v, ok, "1.4.2")
+	}
+	if _, ok := ClientVersion(req, "othertool"); ok {
+		t.Errorf("ClientVersion() found othertool, want none")
+	}
+}
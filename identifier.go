@@ -0,0 +1,91 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Identifier is a single dot-separated identifier of a pre-release or build metadata, e.g. "rc" or "1" of "rc.1".
+type Identifier string
+
+// PreReleaseIdentifiers returns the identifiers of the pre-release, nil for a release.
+func (s SemVer) PreReleaseIdentifiers() []Identifier {
+	return splitIdentifiers(s.PreRelease)
+}
+
+// BuildIdentifiers returns the identifiers of the build metadata, nil if there is none.
+func (s SemVer) BuildIdentifiers() []Identifier {
+	return splitIdentifiers(s.Build)
+}
+
+// splitIdentifiers splits dot-separated identifiers.
+func splitIdentifiers(s string) []Identifier {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ".")
+	identifiers := make([]Identifier, len(parts))
+	for i, part := range parts {
+		identifiers[i] = Identifier(part)
+	}
+	return identifiers
+}
+
+// IsNumeric reports whether the identifier consists of digits only, as ComparePrecedence treats it.
+func (id Identifier) IsNumeric() bool {
+	return isNumericIdentifier(string(id))
+}
+
+// Num returns the value of a numeric identifier, 0 if the identifier is not numeric.
+func (id Identifier) Num() uint64 {
+	n, _ := strconv.ParseUint(string(id), 10, 64)
+	return n
+}
+
+// Compare compares two pre-release identifiers by the precedence rules of the specification:
+// numeric identifiers numerically (11.4.1), alphanumeric identifiers lexically in ASCII order (11.4.2)
+// and numeric identifiers below alphanumeric ones (11.4.3).
+// It returns -1, 0 or 1 as the identifier has lower, equal or higher precedence than other.
+func (id Identifier) Compare(other Identifier) int {
+	switch numeric, otherNumeric := id.IsNumeric(), other.IsNumeric(); {
+	case numeric && otherNumeric:
+		return cmpUint(id.Num(), other.Num())
+	case numeric:
+		return -1
+	case otherNumeric:
+		return 1
+	}
+	return strings.Compare(string(id), string(other))
+}
+
+// ValidateIdentifier checks a single pre-release or build metadata identifier against the grammar of the
+// specification, so tools composing identifiers can validate them before joining them with dots.
+// An identifier is a non-empty string of ASCII letters, digits and hyphens. The check works on bytes,
+// so Unicode look-alikes like a Cyrillic "а" or a non-breaking hyphen are rejected.
+// In a numeric context, i.e. for pre-release identifiers, identifiers of digits only must not have leading zeros;
+// build metadata identifiers like "007" are valid.
+// It returns an error describing the first violation.
+func ValidateIdentifier(s string, numericContext bool) error {
+	if s == "" {
+		return fmt.Errorf("invalid identifier: empty identifier")
+	}
+
+	// Check every byte, as multi-byte characters are never allowed
+	digits := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-':
+			digits = false
+		default:
+			return fmt.Errorf("invalid identifier: %q, contains invalid byte 0x%02x at offset %d", s, c, i)
+		}
+	}
+
+	if numericContext && digits && len(s) > 1 && s[0] == '0' {
+		return fmt.Errorf("invalid identifier: %s, numeric identifiers must not have leading zeros", s)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValidateIdentifier(t *testing.T) {
+	tests := []struct {
+		name           string
+		identifier     string
+		numericContext bool
+		expectError    bool
+	}{
+		{name: "Alphanumeric", identifier: "rc1", numericContext: true},
+		{name: "Hyphens", identifier: "--x-", numericContext: true},
+		{name: "Numeric", identifier: "42", numericContext: true},
+		{name: "Zero", identifier: "0", numericContext: true},
+		{name: "Leading zero", identifier: "042", numericContext: true, expectError: true},
+		{name: "Leading zero in build metadata", identifier: "042", numericContext: false},
+		{name: "Leading zero with letters", identifier: "0a", numericContext: true},
+		{name: "Empty", identifier: "", numericContext: false, expectError: true},
+		{name: "Dot", identifier: "rc.1", numericContext: false, expectError: true},
+		{name: "Plus", identifier: "a+b", numericContext: false, expectError: true},
+		{name: "Cyrillic look-alike", identifier: "аlpha", numericContext: true, expectError: true},
+		{name: "Non-breaking hyphen", identifier: "pre‑release", numericContext: false, expectError: true},
+		{name: "Fullwidth digit", identifier: "１", numericContext: true, expectError: true},
+		{name: "Space", identifier: "rc 1", numericContext: true, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIdentifier(tt.identifier, tt.numericContext)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	v := mustParse(t, "1.2.3-rc.1.x-y+build.007")
+	if result, expected := v.PreReleaseIdentifiers(), []Identifier{"rc", "1", "x-y"}; !slices.Equal(result, expected) {
+		t.Errorf("PreReleaseIdentifiers() = %v, want %v", result, expected)
+	}
+	if result, expected := v.BuildIdentifiers(), []Identifier{"build", "007"}; !slices.Equal(result, expected) {
+		t.Errorf("BuildIdentifiers() = %v, want %v", result, expected)
+	}
+
+	release := mustParse(t, "1.2.3")
+	if result := release.PreReleaseIdentifiers(); result != nil {
+		t.Errorf("PreReleaseIdentifiers() = %v, want nil", result)
+	}
+	if result := release.BuildIdentifiers(); result != nil {
+		t.Errorf("BuildIdentifiers() = %v, want nil", result)
+	}
+}
+
+func TestIdentifierCompare(t *testing.T) {
+	tests := []struct {
+		a        Identifier
+		b        Identifier
+		numeric  bool
+		num      uint64
+		expected int
+	}{
+		{"2", "11", true, 2, -1},
+		{"11", "2", true, 11, 1},
+		{"7", "7", true, 7, 0},
+		{"alpha", "beta", false, 0, -1},
+		{"beta", "BETA", false, 0, 1},
+		{"rc", "rc", false, 0, 0},
+		{"999", "a", true, 999, -1},
+		{"a", "999", false, 0, 1},
+		{"1a", "1", false, 0, 1},
+		{"18446744073709551615", "18446744073709551614", true, 18446744073709551615, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.a)+" vs "+string(tt.b), func(t *testing.T) {
+			if result := tt.a.IsNumeric(); result != tt.numeric {
+				t.Errorf("IsNumeric() = %v, want %v", result, tt.numeric)
+			}
+			if result := tt.a.Num(); result != tt.num {
+				t.Errorf("Num() = %v, want %v", result, tt.num)
+			}
+			if result := tt.a.Compare(tt.b); result != tt.expected {
+				t.Errorf("Compare() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
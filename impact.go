@@ -0,0 +1,111 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Commit is a commit between two releases, identified by its hash, with its full message.
+type Commit struct {
+	Hash    string
+	Message string
+}
+
+// Subject returns the first line of the commit message.
+func (c Commit) Subject() string {
+	subject, _, _ := strings.Cut(strings.TrimSpace(c.Message), "\n")
+	return subject
+}
+
+// Impact classifies the commits between two releases by the change they require,
+// following the Conventional Commits specification.
+type Impact struct {
+	From SemVer
+	To   SemVer
+	// Breaking, Features and Fixes are the commits requiring a major, minor and patch bump, in the order given
+	Breaking []Commit
+	Features []Commit
+	Fixes    []Commit
+	// Other are the commits not requiring a release, including those not following the specification
+	Other []Commit
+}
+
+// AnalyzeImpact classifies the commits made between the releases from and to, e.g. as listed by "git log from..to".
+func AnalyzeImpact(from, to SemVer, commits []Commit) Impact {
+	impact := Impact{From: from, To: to}
+	for _, commit := range commits {
+		c, ok := ParseConventionalCommit(commit.Message)
+		if !ok {
+			impact.Other = append(impact.Other, commit)
+			continue
+		}
+		switch c.ChangeType() {
+		case ChangeMajor:
+			impact.Breaking = append(impact.Breaking, commit)
+		case ChangeMinor:
+			impact.Features = append(impact.Features, commit)
+		case ChangePatch:
+			impact.Fixes = append(impact.Fixes, commit)
+		default:
+			impact.Other = append(impact.Other, commit)
+		}
+	}
+	return impact
+}
+
+// Required returns the change type the commits require, ChangeNone if there are no releasable commits.
+func (i Impact) Required() ChangeType {
+	switch {
+	case len(i.Breaking) > 0:
+		return ChangeMajor
+	case len(i.Features) > 0:
+		return ChangeMinor
+	case len(i.Fixes) > 0:
+		return ChangePatch
+	}
+	return ChangeNone
+}
+
+// Actual returns the change type of the bump from From to To, e.g. ChangePatch for 1.2.3 to 1.2.4.
+// It is ChangeNone for a pre-release bumped to its release, e.g. 2.0.0-rc.1 to 2.0.0.
+func (i Impact) Actual() ChangeType {
+	return Distance(i.From, i.To).Change()
+}
+
+// Check reports whether the bump from From to To matches the commits, bumping 0.y.z versions by the policy.
+// It returns an error describing the mismatch if To is not ahead of From or the bump is smaller or larger than
+// required, e.g. for breaking changes released with a patch bump.
+func (i Impact) Check(p ZeroPolicy) error {
+	if ComparePrecedence(i.To, i.From) <= 0 {
+		return fmt.Errorf("version bump mismatch: %s is not ahead of %s", i.To, i.From)
+	}
+
+	// Compare the bump with the one the commits require, as the policy may bump 0.y.z versions by less
+	required := i.Required()
+	expected, actual := Distance(i.From, p.Bump(i.From, required)).Change(), i.Actual()
+	switch {
+	case actual < expected:
+		return fmt.Errorf("version bump mismatch: %s but only a %s bump from %s to %s", describeCommits(i, required), actual, i.From, i.To)
+	case actual > expected && required == ChangeNone:
+		return fmt.Errorf("version bump mismatch: %s bump from %s to %s without releasable commits", actual, i.From, i.To)
+	case actual > expected:
+		return fmt.Errorf("version bump mismatch: %s bump from %s to %s but only %s", actual, i.From, i.To, describeCommits(i, required))
+	}
+	return nil
+}
+
+// describeCommits describes the commits requiring the change, e.g. "2 breaking commits".
+func describeCommits(i Impact, change ChangeType) string {
+	count, kind := len(i.Fixes), "fix"
+	switch change {
+	case ChangeMajor:
+		count, kind = len(i.Breaking), "breaking"
+	case ChangeMinor:
+		count, kind = len(i.Features), "feature"
+	}
+	noun := "commits"
+	if count == 1 {
+		noun = "commit"
+	}
+	return fmt.Sprintf("%d %s %s", count, kind, noun)
+}
@@ -0,0 +1,92 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAnalyzeImpact(t *testing.T) {
+	commits := []Commit{
+		{"a1", "feat(api)!: drop v1 endpoints"},
+		{"b2", "fix: crash on empty input\n\nDetails."},
+		{"c3", "docs: typo"},
+		{"d4", "feat: add Short"},
+		{"e5", "Merge branch 'main'"},
+		{"f6", "perf: faster parsing"},
+		{"g7", "refactor: split parser\n\nBREAKING CHANGE: parse errors changed"},
+	}
+
+	impact := AnalyzeImpact(mustParse(t, "1.2.3"), mustParse(t, "2.0.0"), commits)
+	hashes := func(commits []Commit) []string {
+		var result []string
+		for _, c := range commits {
+			result = append(result, c.Hash)
+		}
+		return result
+	}
+	for _, tt := range []struct {
+		name     string
+		commits  []Commit
+		expected []string
+	}{
+		{"Breaking", impact.Breaking, []string{"a1", "g7"}},
+		{"Features", impact.Features, []string{"d4"}},
+		{"Fixes", impact.Fixes, []string{"b2", "f6"}},
+		{"Other", impact.Other, []string{"c3", "e5"}},
+	} {
+		if result := hashes(tt.commits); !slices.Equal(result, tt.expected) {
+			t.Errorf("%s = %v, want %v", tt.name, result, tt.expected)
+		}
+	}
+	if result := impact.Required(); result != ChangeMajor {
+		t.Errorf("Required() = %v, want %v", result, ChangeMajor)
+	}
+	if result := commits[1].Subject(); result != "fix: crash on empty input" {
+		t.Errorf("Subject() = %q, want %q", result, "fix: crash on empty input")
+	}
+}
+
+func TestImpactCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        string
+		to          string
+		messages    []string
+		policy      ZeroPolicy
+		expectError bool
+	}{
+		{name: "Patch bump for fixes", from: "1.2.3", to: "1.2.4", messages: []string{"fix: crash", "docs: typo"}},
+		{name: "Minor bump for a feature", from: "1.2.3", to: "1.3.0", messages: []string{"fix: crash", "feat: new option"}},
+		{name: "Major bump for a breaking change", from: "1.2.3", to: "2.0.0", messages: []string{"feat!: new API"}},
+		{name: "Breaking change with a patch bump", from: "1.2.3", to: "1.2.4", messages: []string{"fix!: reject invalid input"}, expectError: true},
+		{name: "Feature with a patch bump", from: "1.2.3", to: "1.2.4", messages: []string{"feat: new option"}, expectError: true},
+		{name: "Major bump for fixes", from: "1.2.3", to: "2.0.0", messages: []string{"fix: crash"}, expectError: true},
+		{name: "Bump without releasable commits", from: "1.2.3", to: "1.2.4", messages: []string{"chore: update CI"}, expectError: true},
+		{name: "Skipped versions", from: "1.2.3", to: "1.4.0", messages: []string{"feat: new option"}},
+		{name: "Pre-release to its release", from: "2.0.0-rc.1", to: "2.0.0", messages: []string{"fix: crash"}},
+		{name: "Release candidate of a major version", from: "1.2.3", to: "2.0.0-rc.1", messages: []string{"feat!: new API"}},
+		{name: "Breaking change in initial development", from: "0.2.3", to: "0.3.0", messages: []string{"feat!: new API"}, policy: ZeroPolicy{BreakingBumpsMinor: true}},
+		{name: "Breaking change in initial development by default", from: "0.2.3", to: "0.3.0", messages: []string{"feat!: new API"}, expectError: true},
+		{name: "Version not ahead", from: "1.2.3", to: "1.2.3", messages: []string{"fix: crash"}, expectError: true},
+		{name: "Version behind", from: "1.2.3", to: "1.2.2", messages: []string{"fix: crash"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var commits []Commit
+			for _, message := range tt.messages {
+				commits = append(commits, Commit{Message: message})
+			}
+			err := AnalyzeImpact(mustParse(t, tt.from), mustParse(t, tt.to), commits).Check(tt.policy)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package semver
+
+import (
+	"slices"
+	"sort"
+)
+
+// Index is an immutable, sorted corpus of versions that answers which of them satisfy a constraint
+// without checking each one: releases are found by binary search on the bounds of the constraint,
+// pre-releases by binary search on the version cores the constraint names. It is safe for concurrent use.
+type Index struct {
+	releases    []SemVer
+	preReleases []SemVer
+}
+
+// NewIndex returns an index of the versions. The slice is not modified or retained.
+func NewIndex(versions []SemVer) *Index {
+	ix := &Index{}
+	for _, v := range versions {
+		if v.IsRelease() {
+			ix.releases = append(ix.releases, v)
+		} else {
+			ix.preReleases = append(ix.preReleases, v)
+		}
+	}
+	slices.SortStableFunc(ix.releases, ComparePrecedence)
+	slices.SortStableFunc(ix.preReleases, ComparePrecedence)
+	return ix
+}
+
+// Len returns the number of versions in the index.
+func (ix *Index) Len() int {
+	return len(ix.releases) + len(ix.preReleases)
+}
+
+// AllSatisfying returns the versions of the index the constraint allows, sorted by precedence.
+func (ix *Index) AllSatisfying(c Constraint) []SemVer {
+	// Releases are allowed if they lie within an interval of the constraint
+	var releases []SemVer
+	for _, iv := range c.Intervals() {
+		from := sort.Search(len(ix.releases), func(i int) bool { return !belowInterval(ix.releases[i], iv) })
+		to := from + sort.Search(len(ix.releases)-from, func(i int) bool { return aboveInterval(ix.releases[from+i], iv) })
+		releases = append(releases, ix.releases[from:to]...)
+	}
+
+	// Pre-releases can only be allowed if the constraint names their version core
+	var cores []SemVer
+	for _, comparators := range c.ranges {
+		for _, comp := range comparators {
+			if comp.version.PreRelease != "" && (!c.exactPreReleases || comp.op == "=") {
+				cores = append(cores, SemVer{Major: comp.version.Major, Minor: comp.version.Minor, Patch: comp.version.Patch})
+			}
+		}
+	}
+	slices.SortFunc(cores, compareCore)
+	cores = slices.Compact(cores)
+
+	var preReleases []SemVer
+	for _, core := range cores {
+		from := sort.Search(len(ix.preReleases), func(i int) bool { return compareCore(ix.preReleases[i], core) >= 0 })
+		for _, v := range ix.preReleases[from:] {
+			if !sameCore(v, core) {
+				break
+			}
+			if c.Allows(v) {
+				preReleases = append(preReleases, v)
+			}
+		}
+	}
+
+	// Merge both sorted lists
+	result := make([]SemVer, 0, len(releases)+len(preReleases))
+	for len(releases) > 0 && len(preReleases) > 0 {
+		if ComparePrecedence(preReleases[0], releases[0]) < 0 {
+			result, preReleases = append(result, preReleases[0]), preReleases[1:]
+		} else {
+			result, releases = append(result, releases[0]), releases[1:]
+		}
+	}
+	return append(append(result, releases...), preReleases...)
+}
+
+// belowInterval reports whether the version is below the lower bound of the interval.
+func belowInterval(v SemVer, iv Interval) bool {
+	if iv.Lower.Unbounded {
+		return false
+	}
+	result := ComparePrecedence(v, iv.Lower.Version)
+	return result < 0 || result == 0 && !iv.Lower.Inclusive
+}
+
+// aboveInterval reports whether the version is above the upper bound of the interval.
+func aboveInterval(v SemVer, iv Interval) bool {
+	if iv.Upper.Unbounded {
+		return false
+	}
+	result := ComparePrecedence(v, iv.Upper.Version)
+	return result > 0 || result == 0 && !iv.Upper.Inclusive
+}
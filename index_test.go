@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestIndexAllSatisfying(t *testing.T) {
+	var versions []SemVer
+	for _, s := range []string{"2.0.0", "1.0.0", "1.0.0-rc.1", "1.2.0", "1.2.0+b", "2.0.0-rc.1", "2.0.0-rc.2", "0.9.0", "3.0.0-alpha"} {
+		versions = append(versions, mustParse(t, s))
+	}
+	ix := NewIndex(versions)
+
+	tests := []struct {
+		constraint string
+		expected   []string
+	}{
+		{"^1.0.0", []string{"1.0.0", "1.2.0", "1.2.0+b"}},
+		{">=1.0.0-rc.1 <2.0.0", []string{"1.0.0-rc.1", "1.0.0", "1.2.0", "1.2.0+b"}},
+		{">=2.0.0-rc.2", []string{"2.0.0-rc.2", "2.0.0"}},
+		{"<1.0.0 || >=2.0.0", []string{"0.9.0", "2.0.0"}},
+		{"1.2.0", []string{"1.2.0", "1.2.0+b"}},
+		{"3.0.0-alpha", []string{"3.0.0-alpha"}},
+		{"*", []string{"0.9.0", "1.0.0", "1.2.0", "1.2.0+b", "2.0.0"}},
+		{">5.0.0", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			result := versionStrings(ix.AllSatisfying(MustParseConstraint(tt.constraint)))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("AllSatisfying() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	if ix.Len() != len(versions) {
+		t.Errorf("Len() = %v, want %v", ix.Len(), len(versions))
+	}
+}
+
+func TestIndexMatchesLinearScan(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	var versions []SemVer
+	for i := 0; i < 2000; i++ {
+		versions = append(versions, RandomVersion(r, 6))
+	}
+	ix := NewIndex(versions)
+
+	sorted := slices.Clone(versions)
+	slices.SortStableFunc(sorted, ComparePrecedence)
+	for i := 0; i < 300; i++ {
+		c := MustParseConstraint(RandomConstraint(r, 6))
+		var expected []SemVer
+		for _, v := range sorted {
+			if c.Allows(v) {
+				expected = append(expected, v)
+			}
+		}
+
+		result := ix.AllSatisfying(c)
+		if len(result) != len(expected) {
+			t.Fatalf("AllSatisfying(%q) returned %d versions, want %d", c, len(result), len(expected))
+		}
+		for j := range result {
+			if ComparePrecedence(result[j], expected[j]) != 0 {
+				t.Fatalf("AllSatisfying(%q)[%d] = %v, want %v", c, j, result[j], expected[j])
+			}
+		}
+	}
+}
+
+func BenchmarkIndexAllSatisfying(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	var versions []SemVer
+	for i := 0; i < 100000; i++ {
+		versions = append(versions, RandomVersion(r, 50))
+	}
+	ix := NewIndex(versions)
+	c := MustParseConstraint("^12.3")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ix.AllSatisfying(c)
+	}
+}
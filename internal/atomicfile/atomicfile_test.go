@@ -0,0 +1,68 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		existing     bool
+		existingMode os.FileMode
+		expectedMode os.FileMode
+	}{
+		{name: "New file", expectedMode: 0o644},
+		{name: "Existing file keeps its permissions", existing: true, existingMode: 0o600, expectedMode: 0o600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "file.json")
+			if tt.existing {
+				if err := os.WriteFile(path, []byte("old"), tt.existingMode); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Chmod(path, tt.existingMode); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := WriteFile(path, []byte("new\n")); err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != "new\n" {
+				t.Errorf("content = %q, want %q", data, "new\n")
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Mode().Perm() != tt.expectedMode {
+				t.Errorf("mode = %v, want %v", info.Mode().Perm(), tt.expectedMode)
+			}
+
+			// No temporary files are left behind
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 1 {
+				t.Errorf("directory has %d entries, want 1", len(entries))
+			}
+		})
+	}
+}
+
+func TestWriteFileMissingDirectory(t *testing.T) {
+	if err := WriteFile(filepath.Join(t.TempDir(), "missing", "file.json"), nil); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
@@ -0,0 +1,358 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+)
+
+// Bound is an end of an interval of versions. An unbounded lower end is below and an unbounded
+// upper end above every version, the version and inclusive flag are ignored then.
+type Bound struct {
+	Version   SemVer
+	Inclusive bool
+	Unbounded bool
+}
+
+// op returns the operator of the bound given the operator for an exclusive bound, e.g. "<" or "<=" for "<".
+func (b Bound) op(exclusive string) string {
+	if b.Inclusive {
+		return exclusive + "="
+	}
+	return exclusive
+}
+
+// comparator returns the bound as a comparator with the operator for an exclusive bound, e.g. "<1.2.3" or "<=1.2.3" for "<".
+func (b Bound) comparator(op string) string {
+	return b.op(op) + b.Version.String()
+}
+
+// lowerBefore reports whether the lower bound b admits versions below those of the lower bound other.
+func (b Bound) lowerBefore(other Bound) bool {
+	switch {
+	case other.Unbounded:
+		return false
+	case b.Unbounded:
+		return true
+	}
+	result := ComparePrecedence(b.Version, other.Version)
+	return result < 0 || result == 0 && b.Inclusive && !other.Inclusive
+}
+
+// upperBefore reports whether the upper bound b excludes versions admitted by the upper bound other.
+func (b Bound) upperBefore(other Bound) bool {
+	switch {
+	case b.Unbounded:
+		return false
+	case other.Unbounded:
+		return true
+	}
+	result := ComparePrecedence(b.Version, other.Version)
+	return result < 0 || result == 0 && !b.Inclusive && other.Inclusive
+}
+
+// separates reports whether there are versions between the upper bound b and the lower bound lower,
+// i.e. whether an interval ending at b and an interval starting at lower are neither adjacent nor overlapping.
+func (b Bound) separates(lower Bound) bool {
+	if b.Unbounded || lower.Unbounded {
+		return false
+	}
+	result := ComparePrecedence(b.Version, lower.Version)
+	return result < 0 || result == 0 && !b.Inclusive && !lower.Inclusive
+}
+
+// separatesOrTouches reports whether no version is both below the upper bound b and above the lower bound lower.
+func (b Bound) separatesOrTouches(lower Bound) bool {
+	if b.Unbounded || lower.Unbounded {
+		return false
+	}
+	result := ComparePrecedence(b.Version, lower.Version)
+	return result < 0 || result == 0 && !(b.Inclusive && lower.Inclusive)
+}
+
+// Interval is a contiguous set of versions between a lower and an upper bound, ordered by precedence.
+// Unlike a Constraint, an interval has no pre-release rules: it contains every version within its bounds.
+type Interval struct {
+	Lower Bound
+	Upper Bound
+}
+
+// AllVersions returns the interval containing every version.
+func AllVersions() Interval {
+	return Interval{Lower: Bound{Unbounded: true}, Upper: Bound{Unbounded: true}}
+}
+
+// rangeInterval returns the interval of versions fulfilling all comparators of a range, ignoring != comparators.
+func rangeInterval(comparators []comparator) Interval {
+	iv := AllVersions()
+	for _, c := range comparators {
+		var lower, upper *Bound
+		switch c.op {
+		case "=":
+			lower = &Bound{Version: c.version, Inclusive: true}
+			upper = &Bound{Version: c.version, Inclusive: true}
+		case ">", ">=":
+			lower = &Bound{Version: c.version, Inclusive: c.op == ">="}
+		case "<", "<=":
+			upper = &Bound{Version: c.version, Inclusive: c.op == "<="}
+		}
+
+		// Keep the tightest bounds
+		if lower != nil && iv.Lower.lowerBefore(*lower) {
+			iv.Lower = *lower
+		}
+		if upper != nil && upper.upperBefore(iv.Upper) {
+			iv.Upper = *upper
+		}
+	}
+	return iv
+}
+
+// Empty reports whether no version lies within the interval.
+func (iv Interval) Empty() bool {
+	if iv.Lower.Unbounded || iv.Upper.Unbounded {
+		return false
+	}
+	result := ComparePrecedence(iv.Lower.Version, iv.Upper.Version)
+	return result > 0 || result == 0 && !(iv.Lower.Inclusive && iv.Upper.Inclusive)
+}
+
+// Contains reports whether the version lies within the interval, comparing by precedence.
+func (iv Interval) Contains(v SemVer) bool {
+	if !iv.Lower.Unbounded {
+		result := ComparePrecedence(v, iv.Lower.Version)
+		if result < 0 || result == 0 && !iv.Lower.Inclusive {
+			return false
+		}
+	}
+	if !iv.Upper.Unbounded {
+		result := ComparePrecedence(v, iv.Upper.Version)
+		if result > 0 || result == 0 && !iv.Upper.Inclusive {
+			return false
+		}
+	}
+	return true
+}
+
+// Overlaps reports whether a version lies within both intervals.
+func (iv Interval) Overlaps(other Interval) bool {
+	return !iv.Upper.separatesOrTouches(other.Lower) && !other.Upper.separatesOrTouches(iv.Lower)
+}
+
+// Intersect returns the interval of the versions lying within both intervals, which may be empty.
+func (iv Interval) Intersect(other Interval) Interval {
+	result := iv
+	if iv.Lower.lowerBefore(other.Lower) {
+		result.Lower = other.Lower
+	}
+	if other.Upper.upperBefore(iv.Upper) {
+		result.Upper = other.Upper
+	}
+	return result
+}
+
+// Union returns the set of the versions lying within either interval.
+func (iv Interval) Union(other Interval) IntervalSet {
+	return NewIntervalSet(iv, other)
+}
+
+// Complement returns the set of the versions not lying within the interval.
+func (iv Interval) Complement() IntervalSet {
+	if iv.Empty() {
+		return IntervalSet{AllVersions()}
+	}
+
+	// The bounds of the interval become the bounds of the gaps below and above it
+	var intervals []Interval
+	if !iv.Lower.Unbounded {
+		intervals = append(intervals, Interval{
+			Lower: Bound{Unbounded: true},
+			Upper: Bound{Version: iv.Lower.Version, Inclusive: !iv.Lower.Inclusive},
+		})
+	}
+	if !iv.Upper.Unbounded {
+		intervals = append(intervals, Interval{
+			Lower: Bound{Version: iv.Upper.Version, Inclusive: !iv.Upper.Inclusive},
+			Upper: Bound{Unbounded: true},
+		})
+	}
+	return NewIntervalSet(intervals...)
+}
+
+// Subtract returns the set of the versions lying within the interval but not within other.
+func (iv Interval) Subtract(other Interval) IntervalSet {
+	return IntervalSet{iv}.Intersect(other.Complement())
+}
+
+// String returns the interval as a constraint, e.g. ">=1.2.0 <2.0.0", "1.2.3" for a single version and "*" if unbounded.
+// An empty interval returns an empty string.
+func (iv Interval) String() string {
+	return strings.Join(iv.comparators(), " ")
+}
+
+// Constraint returns a constraint matching the versions of the interval.
+// The pre-release rules of constraints apply, so pre-releases only match if a bound names one of the same version core.
+func (iv Interval) Constraint() Constraint {
+	return NewIntervalSet(iv).Constraint()
+}
+
+// single reports whether the interval contains exactly one version.
+func (iv Interval) single() bool {
+	return !iv.Empty() && !iv.Lower.Unbounded && !iv.Upper.Unbounded && ComparePrecedence(iv.Lower.Version, iv.Upper.Version) == 0
+}
+
+// comparators returns the comparators of the interval in their canonical form.
+func (iv Interval) comparators() []string {
+	switch {
+	case iv.Empty():
+		return nil
+	case iv.Lower.Unbounded && iv.Upper.Unbounded:
+		return []string{"*"}
+	case iv.single():
+		return []string{iv.Lower.Version.String()}
+	}
+
+	var comparators []string
+	if !iv.Lower.Unbounded {
+		comparators = append(comparators, iv.Lower.comparator(">"))
+	}
+	if !iv.Upper.Unbounded {
+		comparators = append(comparators, iv.Upper.comparator("<"))
+	}
+	return comparators
+}
+
+// IntervalSet is a union of intervals. Sets returned by this package are normalized:
+// the intervals are non-empty, sorted, and neither overlap nor touch.
+type IntervalSet []Interval
+
+// NewIntervalSet returns the normalized set of the versions lying within any of the intervals.
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	var sorted []Interval
+	for _, iv := range intervals {
+		if !iv.Empty() {
+			sorted = append(sorted, iv)
+		}
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Lower.lowerBefore(sorted[j].Lower)
+	})
+
+	// Merge overlapping and adjacent intervals
+	set := IntervalSet{sorted[0]}
+	for _, next := range sorted[1:] {
+		last := &set[len(set)-1]
+		if last.Upper.separates(next.Lower) {
+			set = append(set, next)
+			continue
+		}
+		if last.Upper.upperBefore(next.Upper) {
+			last.Upper = next.Upper
+		}
+	}
+	return set
+}
+
+// Intervals returns the set of versions the constraint allows by precedence.
+// The pre-release rules of the constraint are not represented, so the set contains pre-releases within its bounds.
+func (c Constraint) Intervals() IntervalSet {
+	var intervals []Interval
+	for _, comparators := range c.ranges {
+		set := IntervalSet{rangeInterval(comparators)}
+
+		// Cut out versions excluded with !=
+		for _, comp := range comparators {
+			if comp.op == "!=" {
+				excluded := Interval{Lower: Bound{Version: comp.version, Inclusive: true}, Upper: Bound{Version: comp.version, Inclusive: true}}
+				set = set.Subtract(IntervalSet{excluded})
+			}
+		}
+		intervals = append(intervals, set...)
+	}
+	return NewIntervalSet(intervals...)
+}
+
+// Empty reports whether no version lies within the set.
+func (s IntervalSet) Empty() bool {
+	for _, iv := range s {
+		if !iv.Empty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains reports whether the version lies within one of the intervals.
+func (s IntervalSet) Contains(v SemVer) bool {
+	for _, iv := range s {
+		if iv.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Union returns the set of the versions lying within either set.
+func (s IntervalSet) Union(other IntervalSet) IntervalSet {
+	return NewIntervalSet(append(append([]Interval(nil), s...), other...)...)
+}
+
+// Intersect returns the set of the versions lying within both sets.
+func (s IntervalSet) Intersect(other IntervalSet) IntervalSet {
+	var intervals []Interval
+	for _, a := range s {
+		for _, b := range other {
+			intervals = append(intervals, a.Intersect(b))
+		}
+	}
+	return NewIntervalSet(intervals...)
+}
+
+// Complement returns the set of the versions not lying within any interval of the set.
+func (s IntervalSet) Complement() IntervalSet {
+	complement := IntervalSet{AllVersions()}
+	for _, iv := range s {
+		complement = complement.Intersect(iv.Complement())
+	}
+	return complement
+}
+
+// Subtract returns the set of the versions lying within the set but not within other,
+// e.g. the versions a new constraint allows that an old one did not.
+func (s IntervalSet) Subtract(other IntervalSet) IntervalSet {
+	return s.Intersect(other.Complement())
+}
+
+// String returns the set as a constraint, e.g. "<1.0.0 || >=2.0.0". An empty set returns an empty string.
+func (s IntervalSet) String() string {
+	var alternatives []string
+	for _, iv := range NewIntervalSet(s...) {
+		alternatives = append(alternatives, iv.String())
+	}
+	return strings.Join(alternatives, " || ")
+}
+
+// Constraint returns a constraint matching the versions of the set, one range per interval.
+// The pre-release rules of constraints apply, so pre-releases only match if a bound names one of the same version core.
+// The constraint of an empty set matches no version.
+func (s IntervalSet) Constraint() Constraint {
+	c := Constraint{raw: s.String()}
+	for _, iv := range NewIntervalSet(s...) {
+		// An unbounded interval has no comparators and matches every release
+		comparators := []comparator{}
+		if iv.single() {
+			comparators = append(comparators, comparator{"=", iv.Lower.Version})
+		} else {
+			if !iv.Lower.Unbounded {
+				comparators = append(comparators, comparator{iv.Lower.op(">"), iv.Lower.Version})
+			}
+			if !iv.Upper.Unbounded {
+				comparators = append(comparators, comparator{iv.Upper.op("<"), iv.Upper.Version})
+			}
+		}
+		c.ranges = append(c.ranges, comparators)
+	}
+	return c
+}
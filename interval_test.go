@@ -0,0 +1,225 @@
+package semver
+
+import "testing"
+
+// mustInterval returns the single interval of a constraint.
+func mustInterval(t *testing.T, constraint string) Interval {
+	t.Helper()
+	set := MustParseConstraint(constraint).Intervals()
+	if len(set) != 1 {
+		t.Fatalf("Intervals(%q) = %v, want a single interval", constraint, set)
+	}
+	return set[0]
+}
+
+func TestConstraintIntervals(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		expected   string
+	}{
+		{name: "Range", constraint: ">=1.2.0 <2.0.0", expected: ">=1.2.0 <2.0.0"},
+		{name: "Caret", constraint: "^1.2", expected: ">=1.2.0 <2.0.0"},
+		{name: "Exact", constraint: "1.2.3", expected: "1.2.3"},
+		{name: "Wildcard", constraint: "*", expected: ">=0.0.0"},
+		{name: "Lower only", constraint: ">1.0.0", expected: ">1.0.0"},
+		{name: "Merged alternatives", constraint: ">=1.0.0 <2.0.0 || >=1.5.0 <3.0.0", expected: ">=1.0.0 <3.0.0"},
+		{name: "Adjacent alternatives", constraint: "1.x || 2.x", expected: ">=1.0.0 <3.0.0"},
+		{name: "Unsorted alternatives", constraint: "3.x || 1.x", expected: ">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0"},
+		{name: "Gap", constraint: "<1.0.0 || >=2.0.0", expected: "<1.0.0 || >=2.0.0"},
+		{name: "Not equal", constraint: ">=1.0.0 <2.0.0 !=1.5.0", expected: ">=1.0.0 <1.5.0 || >1.5.0 <2.0.0"},
+		{name: "Contradiction", constraint: ">2.0.0 <1.0.0", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := MustParseConstraint(tt.constraint).Intervals()
+			if result := set.String(); result != tt.expected {
+				t.Errorf("Intervals() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIntervalContains(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval Interval
+		version  string
+		expected bool
+	}{
+		{name: "Inside", interval: mustInterval(t, ">=1.0.0 <2.0.0"), version: "1.5.0", expected: true},
+		{name: "Inclusive lower", interval: mustInterval(t, ">=1.0.0 <2.0.0"), version: "1.0.0", expected: true},
+		{name: "Exclusive upper", interval: mustInterval(t, ">=1.0.0 <2.0.0"), version: "2.0.0", expected: false},
+		{name: "Pre-release within bounds", interval: mustInterval(t, ">=1.0.0 <2.0.0"), version: "2.0.0-rc.1", expected: true},
+		{name: "Below", interval: mustInterval(t, ">=1.0.0 <2.0.0"), version: "0.9.0", expected: false},
+		{name: "Unbounded", interval: AllVersions(), version: "0.0.0-0", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.interval.Contains(mustParse(t, tt.version)); result != tt.expected {
+				t.Errorf("Contains(%v) = %v, want %v", tt.version, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIntervalSetOperations(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         string
+		b         string
+		union     string
+		intersect string
+	}{
+		{name: "Overlapping", a: ">=1.0.0 <2.0.0", b: ">=1.5.0 <3.0.0", union: ">=1.0.0 <3.0.0", intersect: ">=1.5.0 <2.0.0"},
+		{name: "Nested", a: ">=1.0.0 <3.0.0", b: "2.x", union: ">=1.0.0 <3.0.0", intersect: ">=2.0.0 <3.0.0"},
+		{name: "Touching", a: ">=1.0.0 <=2.0.0", b: ">=2.0.0 <3.0.0", union: ">=1.0.0 <3.0.0", intersect: "2.0.0"},
+		{name: "Adjacent", a: ">=1.0.0 <2.0.0", b: ">=2.0.0 <3.0.0", union: ">=1.0.0 <3.0.0", intersect: ""},
+		{name: "Disjoint", a: "<1.0.0", b: ">2.0.0", union: "<1.0.0 || >2.0.0", intersect: ""},
+		{name: "Unbounded", a: "<1.0.0 || >=2.0.0", b: ">=0.5.0 <2.5.0", union: "*", intersect: ">=0.5.0 <1.0.0 || >=2.0.0 <2.5.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := MustParseConstraint(tt.a).Intervals()
+			b := MustParseConstraint(tt.b).Intervals()
+			if result := a.Union(b).String(); result != tt.union {
+				t.Errorf("Union() = %v, want %v", result, tt.union)
+			}
+			if result := a.Intersect(b).String(); result != tt.intersect {
+				t.Errorf("Intersect() = %v, want %v", result, tt.intersect)
+			}
+		})
+	}
+}
+
+func TestIntervalConstraint(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      IntervalSet
+		expected string
+		allows   []string
+		rejects  []string
+	}{
+		{
+			name:     "Bounded",
+			set:      IntervalSet{mustInterval(t, ">=1.2.0 <2.0.0")},
+			expected: ">=1.2.0 <2.0.0",
+			allows:   []string{"1.2.0", "1.9.9"},
+			rejects:  []string{"1.1.9", "2.0.0", "2.0.0-rc.1"},
+		},
+		{
+			name:     "Single version",
+			set:      IntervalSet{mustInterval(t, "1.0.0-rc.1")},
+			expected: "1.0.0-rc.1",
+			allows:   []string{"1.0.0-rc.1"},
+			rejects:  []string{"1.0.0"},
+		},
+		{
+			name:     "Unbounded",
+			set:      IntervalSet{AllVersions()},
+			expected: "*",
+			allows:   []string{"0.0.0", "99.0.0"},
+			rejects:  []string{"1.0.0-rc.1"},
+		},
+		{
+			name:     "Alternatives",
+			set:      IntervalSet{mustInterval(t, ">3.0.0"), mustInterval(t, "<1.0.0")},
+			expected: "<1.0.0 || >3.0.0",
+			allows:   []string{"0.9.0", "3.0.1"},
+			rejects:  []string{"1.0.0", "3.0.0"},
+		},
+		{
+			name:    "Empty",
+			rejects: []string{"0.0.0", "1.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.set.Constraint()
+			if result := c.String(); result != tt.expected {
+				t.Errorf("Constraint() = %v, want %v", result, tt.expected)
+			}
+			for _, s := range tt.allows {
+				if !c.Allows(mustParse(t, s)) {
+					t.Errorf("Constraint() does not allow %v", s)
+				}
+			}
+			for _, s := range tt.rejects {
+				if c.Allows(mustParse(t, s)) {
+					t.Errorf("Constraint() allows %v", s)
+				}
+			}
+			// A bounded constraint parses back to the same intervals, "*" parses as ">=0.0.0"
+			if tt.expected != "" && tt.expected != "*" {
+				if result := MustParseConstraint(c.String()).Intervals().String(); result != tt.expected {
+					t.Errorf("Intervals() of parsed constraint = %v, want %v", result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestIntervalComplement(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      IntervalSet
+		expected string
+	}{
+		{name: "Bounded", set: IntervalSet{mustInterval(t, ">=1.0.0 <2.0.0")}, expected: "<1.0.0 || >=2.0.0"},
+		{name: "Exclusive bounds", set: IntervalSet{mustInterval(t, ">1.0.0 <=2.0.0")}, expected: "<=1.0.0 || >2.0.0"},
+		{name: "Single version", set: IntervalSet{mustInterval(t, "1.2.3")}, expected: "<1.2.3 || >1.2.3"},
+		{name: "Lower only", set: IntervalSet{mustInterval(t, ">=1.0.0")}, expected: "<1.0.0"},
+		{name: "Several", set: MustParseConstraint("<1.0.0 || 2.x").Intervals(), expected: ">=1.0.0 <2.0.0 || >=3.0.0"},
+		{name: "Everything", set: IntervalSet{AllVersions()}, expected: ""},
+		{name: "Nothing", set: nil, expected: "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			complement := tt.set.Complement()
+			if result := complement.String(); result != tt.expected {
+				t.Errorf("Complement() = %v, want %v", result, tt.expected)
+			}
+			if result := complement.Complement().String(); result != tt.set.String() {
+				t.Errorf("Complement() twice = %v, want %v", result, tt.set)
+			}
+			if len(tt.set) == 1 {
+				if result := tt.set[0].Complement().String(); result != tt.expected {
+					t.Errorf("Interval.Complement() = %v, want %v", result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestIntervalSubtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{name: "Widened upper bound", a: ">=1.2.0 <3.0.0", b: ">=1.2.0 <2.0.0", expected: ">=2.0.0 <3.0.0"},
+		{name: "Upper part", a: ">=1.0.0 <3.0.0", b: "2.x", expected: ">=1.0.0 <2.0.0"},
+		{name: "Inner part", a: ">=1.0.0 <4.0.0", b: "2.x", expected: ">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0"},
+		{name: "Narrowed", a: ">=1.2.0 <2.0.0", b: ">=1.0.0 <3.0.0", expected: ""},
+		{name: "Disjoint", a: "1.x", b: "2.x", expected: ">=1.0.0 <2.0.0"},
+		{name: "Single version", a: "1.x", b: "1.5.0", expected: ">=1.0.0 <1.5.0 || >1.5.0 <2.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustInterval(t, tt.a), mustInterval(t, tt.b)
+			if result := a.Subtract(b).String(); result != tt.expected {
+				t.Errorf("Subtract() = %v, want %v", result, tt.expected)
+			}
+			if result := (IntervalSet{a}).Subtract(IntervalSet{b}).String(); result != tt.expected {
+				t.Errorf("IntervalSet.Subtract() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// CheckRoundTrip checks that the version survives formatting and parsing, i.e. Parse(v.String()) == v.
+// It returns an error describing the difference otherwise, or the parse error.
+func CheckRoundTrip(v SemVer) error {
+	parsed, err := Parse(v.String())
+	if err != nil {
+		return fmt.Errorf("round trip of %s: %w", v, err)
+	}
+	if parsed != v {
+		return fmt.Errorf("round trip of %s: parsed %#v, want %#v", v, parsed, v)
+	}
+	return nil
+}
+
+// CheckTotalOrder checks that Compare and ComparePrecedence are total orders on the versions:
+// each version equals itself, swapping the operands negates the result and the order is transitive.
+// It returns an error naming the versions violating an invariant. It takes cubic time in the number of versions.
+func CheckTotalOrder(versions []SemVer) error {
+	comparisons := []struct {
+		name    string
+		compare func(a, b SemVer) int
+	}{
+		{"Compare", SemVer.Compare},
+		{"ComparePrecedence", ComparePrecedence},
+	}
+
+	for _, c := range comparisons {
+		for _, a := range versions {
+			if result := c.compare(a, a); result != 0 {
+				return fmt.Errorf("%s(%s, %s) = %d, want 0", c.name, a, a, result)
+			}
+			for _, b := range versions {
+				// Antisymmetry
+				ab, ba := c.compare(a, b), c.compare(b, a)
+				if sign(ab) != -sign(ba) {
+					return fmt.Errorf("%s(%s, %s) = %d but %s(%s, %s) = %d", c.name, a, b, ab, c.name, b, a, ba)
+				}
+				if ab > 0 {
+					continue
+				}
+
+				// Transitivity
+				for _, d := range versions {
+					if bd := c.compare(b, d); bd <= 0 {
+						if ad := c.compare(a, d); ad > 0 || ab < 0 && bd < 0 && ad == 0 || ab == 0 && bd == 0 && ad != 0 {
+							return fmt.Errorf("%s is not transitive: %s(%s, %s) = %d, %s(%s, %s) = %d but %s(%s, %s) = %d",
+								c.name, c.name, a, b, ab, c.name, b, d, bd, c.name, a, d, ad)
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// sign returns -1, 0 or 1 for negative, zero or positive numbers.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
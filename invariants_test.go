@@ -0,0 +1,81 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestCheckRoundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     SemVer
+		expectError bool
+	}{
+		{name: "Release", version: SemVer{Major: 1, Minor: 2, Patch: 3}},
+		{name: "Pre-release and build", version: SemVer{Major: 1, PreRelease: "rc.1", Build: "sha.abc"}},
+		{name: "Invalid pre-release", version: SemVer{Major: 1, PreRelease: "01"}, expectError: true},
+		{name: "Pre-release containing build", version: SemVer{Major: 1, PreRelease: "rc+abc"}, expectError: true},
+		{name: "Empty identifier", version: SemVer{Major: 1, Build: "a..b"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRoundTrip(tt.version)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckTotalOrder(t *testing.T) {
+	var versions []SemVer
+	for _, v := range []string{
+		"0.0.0", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11",
+		"1.0.0-rc.1", "1.0.0", "1.0.0+build", "2.0.0-0", "2.0.0-a-b", "2.0.0", "2.1.0", "10.0.0",
+	} {
+		versions = append(versions, mustParse(t, v))
+	}
+	versions = append(versions, NewGenerator(3, WithPreReleaseProbability(0.5), WithBuildProbability(0.2)).Versions(40)...)
+
+	if err := CheckTotalOrder(versions); err != nil {
+		t.Errorf("Did not expect error but got: %v", err)
+	}
+}
+
+func FuzzRoundTrip(f *testing.F) {
+	for _, s := range []string{"0.0.0", "1.2.3", "1.0.0-alpha.1", "1.0.0-x-y-z.--+21AF26D3----117B344092BD", "1.0.0+001"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		v, err := Parse(s)
+		if err != nil {
+			return
+		}
+		if err := CheckRoundTrip(v); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func FuzzTotalOrder(f *testing.F) {
+	f.Add("1.0.0-alpha", "1.0.0", "2.0.0-rc.1")
+	f.Add("1.0.0-beta.11", "1.0.0-beta.2", "1.0.0-beta")
+	f.Add("1.0.0+a", "1.0.0+b", "1.0.0-0")
+	f.Fuzz(func(t *testing.T, a, b, c string) {
+		var versions []SemVer
+		for _, s := range []string{a, b, c} {
+			if v, err := Parse(s); err == nil {
+				versions = append(versions, v)
+			}
+		}
+		if err := CheckTotalOrder(versions); err != nil {
+			t.Error(err)
+		}
+	})
+}
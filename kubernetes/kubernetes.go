@@ -0,0 +1,147 @@
+// Package kubernetes implements tolerant parsing and comparison of Kubernetes-style version strings
+// like "v1.28.3", "v1.27.9-gke.1025000" or "v1.30.0-alpha.2", and version skew checks between
+// components like kubectl or kubelet and the API server.
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// preReleaseStages lists the first pre-release identifiers used by upstream Kubernetes releases.
+// Any other hyphenated suffix is treated as a vendor suffix.
+var preReleaseStages = []string{"alpha", "beta", "rc"}
+
+// Version represents a Kubernetes version. Upstream pre-releases are kept in the embedded
+// SemVer, while vendor suffixes of managed distributions (e.g. "gke.1025000" or "eks-b9c9ed7")
+// are split off into Vendor, as they denote builds of the upstream release rather than pre-releases.
+type Version struct {
+	semver.SemVer
+	Vendor string
+}
+
+// Parse parses a Kubernetes version string. The leading "v" is optional.
+// It returns an error if the remainder is not a valid semantic version.
+func Parse(s string) (Version, error) {
+	v, err := semver.Parse(strings.TrimPrefix(s, "v"))
+	if err != nil {
+		return Version{}, fmt.Errorf("invalid kubernetes version: %s: %w", s, err)
+	}
+
+	result := Version{SemVer: v}
+
+	// Move vendor suffixes out of the pre-release
+	if v.PreRelease != "" && !isPreReleaseStage(v.PreRelease) {
+		result.Vendor = v.PreRelease
+		result.PreRelease = ""
+	}
+
+	return result, nil
+}
+
+// MustParse is like Parse but panics if the version cannot be parsed.
+// It simplifies the initialization of global variables holding well-known versions.
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// isPreReleaseStage reports whether the pre-release starts with an upstream stage identifier.
+func isPreReleaseStage(preRelease string) bool {
+	first, _, _ := strings.Cut(preRelease, ".")
+	for _, stage := range preReleaseStages {
+		if first == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the version in the canonical Kubernetes form with a leading "v".
+func (v Version) String() string {
+	s := v.SemVer
+	if v.Vendor != "" {
+		s.PreRelease = v.Vendor
+	}
+	return "v" + s.String()
+}
+
+// IsVendor reports whether the version carries a vendor suffix.
+func (v Version) IsVendor() bool {
+	return v.Vendor != ""
+}
+
+// Compare compares this version with another Kubernetes version.
+// Versions are compared by semantic versioning precedence first. If they are equal, a version
+// without vendor suffix has lower precedence than a vendor build of it, and vendor suffixes are
+// compared like pre-release identifiers, so "gke.2" < "gke.10".
+// It returns -1, 0 or 1 like semver.ComparePrecedence.
+func (v Version) Compare(other Version) int {
+	if result := semver.ComparePrecedence(v.SemVer, other.SemVer); result != 0 {
+		return result
+	}
+
+	// Compare vendor suffixes
+	if v.Vendor == other.Vendor {
+		return 0
+	}
+	if v.Vendor == "" {
+		return -1
+	}
+	if other.Vendor == "" {
+		return 1
+	}
+	a := semver.SemVer{PreRelease: v.Vendor}
+	b := semver.SemVer{PreRelease: other.Vendor}
+	return semver.ComparePrecedence(a, b)
+}
+
+// MinorSkew returns how many minor versions this version is ahead of the other one,
+// negative if it is behind. It returns an error if the major versions differ,
+// as skew is only defined within a major version.
+func (v Version) MinorSkew(other Version) (int, error) {
+	if v.Major != other.Major {
+		return 0, fmt.Errorf("cannot compute skew between %s and %s: major versions differ", v, other)
+	}
+	return int(v.Minor) - int(other.Minor), nil
+}
+
+// SkewPolicy describes how many minor versions a component may be older or newer than the API server.
+type SkewPolicy struct {
+	MaxOlder uint
+	MaxNewer uint
+}
+
+// Skew policies of the upstream version skew policy, see https://kubernetes.io/releases/version-skew-policy/.
+var (
+	// KubectlSkew allows kubectl to be one minor version older or newer than the API server.
+	KubectlSkew = SkewPolicy{MaxOlder: 1, MaxNewer: 1}
+	// KubeletSkew allows kubelet and kube-proxy to be up to three minor versions older than the API server, but not newer.
+	KubeletSkew = SkewPolicy{MaxOlder: 3, MaxNewer: 0}
+	// ControlPlaneSkew allows kube-controller-manager, kube-scheduler and cloud-controller-manager
+	// to be one minor version older than the API server, but not newer.
+	ControlPlaneSkew = SkewPolicy{MaxOlder: 1, MaxNewer: 0}
+)
+
+// Check validates that the component version is within the allowed skew of the server version.
+// It returns an error describing the violation if it is not.
+func (p SkewPolicy) Check(component, server Version) error {
+	skew, err := component.MinorSkew(server)
+	if err != nil {
+		return err
+	}
+
+	if skew < 0 && uint(-skew) > p.MaxOlder {
+		return fmt.Errorf("version %s is %d minor versions older than server %s, at most %d allowed", component, -skew, server, p.MaxOlder)
+	}
+	if skew > 0 && uint(skew) > p.MaxNewer {
+		return fmt.Errorf("version %s is %d minor versions newer than server %s, at most %d allowed", component, skew, server, p.MaxNewer)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package kubernetes
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedSemVer string
+		expectedVendor string
+		expectedString string
+		expectError    bool
+	}{
+		{
+			name:           "Upstream release",
+			input:          "v1.28.3",
+			expectedSemVer: "1.28.3",
+			expectedString: "v1.28.3",
+		},
+		{
+			name:           "Without prefix",
+			input:          "1.28.3",
+			expectedSemVer: "1.28.3",
+			expectedString: "v1.28.3",
+		},
+		{
+			name:           "GKE vendor suffix",
+			input:          "v1.27.9-gke.1025000",
+			expectedSemVer: "1.27.9",
+			expectedVendor: "gke.1025000",
+			expectedString: "v1.27.9-gke.1025000",
+		},
+		{
+			name:           "EKS vendor suffix",
+			input:          "v1.29.1-eks-b9c9ed7",
+			expectedSemVer: "1.29.1",
+			expectedVendor: "eks-b9c9ed7",
+			expectedString: "v1.29.1-eks-b9c9ed7",
+		},
+		{
+			name:           "K3s build metadata",
+			input:          "v1.28.3+k3s1",
+			expectedSemVer: "1.28.3+k3s1",
+			expectedString: "v1.28.3+k3s1",
+		},
+		{
+			name:           "Upstream pre-release",
+			input:          "v1.30.0-alpha.2",
+			expectedSemVer: "1.30.0-alpha.2",
+			expectedString: "v1.30.0-alpha.2",
+		},
+		{
+			name:           "Development build",
+			input:          "v1.30.0-beta.0.123+0d7ea1f1e2a4b8",
+			expectedSemVer: "1.30.0-beta.0.123+0d7ea1f1e2a4b8",
+			expectedString: "v1.30.0-beta.0.123+0d7ea1f1e2a4b8",
+		},
+		{
+			name:        "Missing patch",
+			input:       "v1.28",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Parse(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if v.SemVer.String() != tt.expectedSemVer {
+				t.Errorf("SemVer = %v, want %v", v.SemVer, tt.expectedSemVer)
+			}
+			if v.Vendor != tt.expectedVendor {
+				t.Errorf("Vendor = %v, want %v", v.Vendor, tt.expectedVendor)
+			}
+			if v.String() != tt.expectedString {
+				t.Errorf("String() = %v, want %v", v.String(), tt.expectedString)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v1.28.3", "v1.28.3", 0},
+		{"v1.28.3", "v1.28.10", -1},
+		{"v1.30.0-alpha.2", "v1.30.0-beta.0", -1},
+		{"v1.30.0-rc.1", "v1.30.0", -1},
+		{"v1.30.0-alpha.2", "v1.27.9", 1},
+		{"v1.29.0-rc.1", "v1.30.0-alpha.1", -1},
+		{"v1.27.9", "v1.27.9-gke.1025000", -1},
+		{"v1.27.9-gke.2", "v1.27.9-gke.10", -1},
+		{"v1.27.9-gke.1025000", "v1.27.10", -1},
+		{"v1.28.3+k3s1", "v1.28.3", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, b := MustParse(tt.a), MustParse(tt.b)
+			if result := a.Compare(b); result != tt.expected {
+				t.Errorf("Compare() = %v, want %v", result, tt.expected)
+			}
+			if result := b.Compare(a); result != -tt.expected {
+				t.Errorf("Compare() = %v, want %v", result, -tt.expected)
+			}
+		})
+	}
+}
+
+func TestSkewPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      SkewPolicy
+		component   string
+		server      string
+		expectError bool
+	}{
+		{name: "Kubectl same minor", policy: KubectlSkew, component: "v1.28.0", server: "v1.28.3"},
+		{name: "Kubectl one newer", policy: KubectlSkew, component: "v1.29.0", server: "v1.28.3"},
+		{name: "Kubectl two older", policy: KubectlSkew, component: "v1.26.5", server: "v1.28.3", expectError: true},
+		{name: "Kubelet three older", policy: KubeletSkew, component: "v1.25.0", server: "v1.28.3-gke.100"},
+		{name: "Kubelet four older", policy: KubeletSkew, component: "v1.24.0", server: "v1.28.3", expectError: true},
+		{name: "Kubelet newer", policy: KubeletSkew, component: "v1.29.0", server: "v1.28.3", expectError: true},
+		{name: "Different major", policy: KubectlSkew, component: "v2.0.0", server: "v1.28.3", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check(MustParse(tt.component), MustParse(tt.server))
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// VersionLine is a version read by ParseLines with the number of its line, starting at 1.
+type VersionLine struct {
+	Line    int
+	Version SemVer
+}
+
+// LineError is a line ParseLines could not parse, with its number starting at 1 and its trimmed text.
+type LineError struct {
+	Line int
+	Text string
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ParseLines parses one version per line, e.g. from a manifest listing supported versions.
+// Surrounding whitespace is trimmed, blank lines and lines starting with "#" are skipped.
+// The lines are parsed with ParseWith and the options.
+//
+// It returns the versions and an error for each line that is not a valid version, in the order of the lines,
+// and a non-nil error only if reading fails.
+func ParseLines(r io.Reader, opts ...ParseOption) ([]VersionLine, []*LineError, error) {
+	var versions []VersionLine
+	var lineErrors []*LineError
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		v, err := ParseWith(text, opts...)
+		if err != nil {
+			lineErrors = append(lineErrors, &LineError{Line: line, Text: text, Err: err})
+			continue
+		}
+		versions = append(versions, VersionLine{Line: line, Version: v})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("cannot read versions: %w", err)
+	}
+
+	return versions, lineErrors, nil
+}
@@ -0,0 +1,92 @@
+package semver
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestParseLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		opts     []ParseOption
+		versions map[int]string
+		errors   []string
+	}{
+		{
+			name:     "Valid",
+			input:    "1.0.0\n1.1.0\r\n2.0.0-rc.1",
+			versions: map[int]string{1: "1.0.0", 2: "1.1.0", 3: "2.0.0-rc.1"},
+		},
+		{
+			name:     "Comments and blank lines",
+			input:    "# supported versions\n\n  1.0.0  \n\t\n# 0.9.0\n1.1.0\n",
+			versions: map[int]string{3: "1.0.0", 6: "1.1.0"},
+		},
+		{
+			name:     "Invalid lines",
+			input:    "1.0.0\nlatest\n1.2\n2.0.0",
+			versions: map[int]string{1: "1.0.0", 4: "2.0.0"},
+			errors: []string{
+				"line 2: invalid version format: latest, expected major.minor.patch",
+				"line 3: invalid version format: 1.2, expected major.minor.patch",
+			},
+		},
+		{
+			name:     "Options",
+			input:    "v1.0.0\n1.1.0",
+			opts:     []ParseOption{WithVPrefix(VPrefixRequire)},
+			versions: map[int]string{1: "1.0.0"},
+			errors:   []string{"line 2: invalid version: 1.1.0, missing v prefix"},
+		},
+		{
+			name: "Empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, lineErrors, err := ParseLines(strings.NewReader(tt.input), tt.opts...)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+
+			result := map[int]string{}
+			for _, v := range versions {
+				result[v.Line] = v.Version.String()
+			}
+			if len(result) != len(tt.versions) || len(tt.versions) > 0 && !reflect.DeepEqual(result, tt.versions) {
+				t.Errorf("ParseLines() versions = %v, want %v", result, tt.versions)
+			}
+
+			var messages []string
+			for _, e := range lineErrors {
+				messages = append(messages, e.Error())
+			}
+			if !reflect.DeepEqual(messages, tt.errors) {
+				t.Errorf("ParseLines() errors = %v, want %v", messages, tt.errors)
+			}
+		})
+	}
+}
+
+func TestParseLinesReadError(t *testing.T) {
+	failure := errors.New("disk on fire")
+	_, _, err := ParseLines(iotest.ErrReader(failure))
+	if !errors.Is(err, failure) {
+		t.Errorf("ParseLines() error = %v, want %v", err, failure)
+	}
+}
+
+func TestLineErrorUnwrap(t *testing.T) {
+	_, lineErrors, _ := ParseLines(strings.NewReader("x"))
+	if len(lineErrors) != 1 {
+		t.Fatalf("ParseLines() returned %d errors, want 1", len(lineErrors))
+	}
+	if e := lineErrors[0]; e.Text != "x" || errors.Unwrap(e) == nil {
+		t.Errorf("LineError = %+v, want the text and the parse error", e)
+	}
+}
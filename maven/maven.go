@@ -0,0 +1,408 @@
+// Package maven implements Maven's version ordering as defined by ComparableVersion,
+// including qualifiers like "alpha", "beta", "rc", "SNAPSHOT" and "sp",
+// together with conversion helpers to and from semver.SemVer.
+package maven
+
+import (
+	"fmt"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// qualifiers lists the well-known qualifiers in ascending order, the empty qualifier is the release itself.
+var qualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// aliases maps alternative spellings to their well-known qualifier.
+var aliases = map[string]string{
+	"ga":      "",
+	"final":   "",
+	"release": "",
+	"cr":      "rc",
+}
+
+// releaseIndex is the position of the release (empty) qualifier in qualifiers.
+const releaseIndex = 5
+
+// Version represents a Maven artifact version. Every string is a valid Maven version,
+// so a Version keeps the original string for display and the parsed items for ordering.
+type Version struct {
+	raw   string
+	items listItem
+}
+
+// Parse parses a Maven version string. Maven accepts any string as a version,
+// so Parse never fails; unknown qualifiers sort after all known ones.
+func Parse(s string) Version {
+	return Version{raw: s, items: parseItems(strings.ToLower(s))}
+}
+
+// Compare compares two Maven version strings according to Maven's ordering rules.
+// It returns -1, 0 or 1 like SemVer.Compare.
+func Compare(a, b string) int {
+	return Parse(a).Compare(Parse(b))
+}
+
+// String returns the original version string.
+func (v Version) String() string {
+	return v.raw
+}
+
+// Canonical returns the canonical form of the version, two versions are equal in Maven ordering
+// exactly when their canonical forms are equal.
+func (v Version) Canonical() string {
+	return v.items.String()
+}
+
+// Compare compares this version with another version according to Maven's ordering rules.
+// It returns -1, 0 or 1 like SemVer.Compare.
+func (v Version) Compare(other Version) int {
+	return v.items.compare(other.items)
+}
+
+// ToSemVer converts the Maven version into a SemVer.
+// Up to three leading numeric components become major, minor and patch. Remaining items are
+// flattened into dot-separated identifiers: if they start with a qualifier that sorts before the
+// release (alpha, beta, milestone, rc, snapshot) they become the pre-release, otherwise
+// (sp or unknown qualifiers like "jre") they are carried as build metadata, because semver has no
+// notion of versions sorting after a release.
+// It returns an error if the version has more than three leading numeric components.
+func (v Version) ToSemVer() (semver.SemVer, error) {
+	var result semver.SemVer
+
+	// Take the leading numeric components
+	numbers := make([]string, 0, 3)
+	rest := v.items
+	for len(rest) > 0 {
+		n, ok := rest[0].(intItem)
+		if !ok {
+			break
+		}
+		numbers = append(numbers, string(n))
+		rest = rest[1:]
+	}
+	if len(numbers) > 3 {
+		return semver.SemVer{}, fmt.Errorf("cannot convert %s: more than three numeric components", v.raw)
+	}
+	for len(numbers) < 3 {
+		numbers = append(numbers, "0")
+	}
+
+	// Flatten the remaining items into identifiers
+	identifiers := rest.identifiers(nil)
+	tail := strings.Join(identifiers, ".")
+	if len(identifiers) > 0 {
+		if index := qualifierIndex(identifiers[0]); index >= 0 && index < releaseIndex {
+			result.PreRelease = tail
+		} else {
+			result.Build = tail
+		}
+	}
+
+	// Let the semver parser validate the numeric components and identifiers
+	core := strings.Join(numbers, ".")
+	parsed, err := semver.Parse(core + formatSuffix(result))
+	if err != nil {
+		return semver.SemVer{}, fmt.Errorf("cannot convert %s: %w", v.raw, err)
+	}
+	return parsed, nil
+}
+
+// FromSemVer converts a SemVer into a Maven version.
+// The pre-release is appended after a hyphen, which keeps pre-releases with well-known
+// qualifiers ordered consistently in both systems. Build metadata is dropped,
+// as it has no meaning for Maven.
+func FromSemVer(v semver.SemVer) Version {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	return Parse(s)
+}
+
+// formatSuffix returns the pre-release and build suffix of a semver string.
+func formatSuffix(v semver.SemVer) string {
+	var suffix string
+	if v.PreRelease != "" {
+		suffix += "-" + v.PreRelease
+	}
+	if v.Build != "" {
+		suffix += "+" + v.Build
+	}
+	return suffix
+}
+
+// item is a single element of a parsed Maven version: a number, a qualifier or a nested list.
+// A nil item stands for a missing element, which compares like 0, the release qualifier or an empty list.
+type item interface {
+	compare(other item) int
+	isNull() bool
+	String() string
+}
+
+// intItem is a numeric item stored as a decimal string without leading zeros, so arbitrarily large numbers compare correctly.
+type intItem string
+
+func (i intItem) isNull() bool {
+	return i == "0"
+}
+
+func (i intItem) String() string {
+	return string(i)
+}
+
+func (i intItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case intItem:
+		// Longer numbers are larger, equal lengths compare lexically
+		if len(i) != len(o) {
+			if len(i) < len(o) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(string(i), string(o))
+	default:
+		// Numbers are newer than qualifiers and nested lists
+		return 1
+	}
+}
+
+// stringItem is a qualifier item, stored with aliases already resolved.
+type stringItem string
+
+func newStringItem(value string, followedByDigit bool) stringItem {
+	// Single letters directly followed by a digit are shorthands
+	if followedByDigit && len(value) == 1 {
+		switch value {
+		case "a":
+			value = "alpha"
+		case "b":
+			value = "beta"
+		case "m":
+			value = "milestone"
+		}
+	}
+	if alias, ok := aliases[value]; ok {
+		value = alias
+	}
+	return stringItem(value)
+}
+
+func (s stringItem) isNull() bool {
+	return s == ""
+}
+
+func (s stringItem) String() string {
+	return string(s)
+}
+
+func (s stringItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		return strings.Compare(comparableQualifier(string(s)), comparableQualifier(""))
+	case stringItem:
+		return strings.Compare(comparableQualifier(string(s)), comparableQualifier(string(o)))
+	default:
+		// Qualifiers are older than numbers and nested lists
+		return -1
+	}
+}
+
+// listItem is a nested list of items, introduced by a hyphen or a transition between digits and letters.
+type listItem []item
+
+func (l listItem) isNull() bool {
+	return len(l) == 0
+}
+
+func (l listItem) String() string {
+	var b strings.Builder
+	for i, it := range l {
+		if i > 0 {
+			if _, ok := it.(listItem); ok {
+				b.WriteString("-")
+			} else {
+				b.WriteString(".")
+			}
+		}
+		b.WriteString(it.String())
+	}
+	return b.String()
+}
+
+func (l listItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		if len(l) == 0 {
+			return 0
+		}
+		return l[0].compare(nil)
+	case intItem:
+		return -1
+	case stringItem:
+		return 1
+	case listItem:
+		for i := 0; i < len(l) || i < len(o); i++ {
+			var left, right item
+			if i < len(l) {
+				left = l[i]
+			}
+			if i < len(o) {
+				right = o[i]
+			}
+
+			// A missing element on the left is compared by inverting the right side
+			var result int
+			if left == nil {
+				if right != nil {
+					result = -right.compare(nil)
+				}
+			} else {
+				result = left.compare(right)
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+	return 0
+}
+
+// identifiers flattens the list into semver-style identifiers.
+func (l listItem) identifiers(result []string) []string {
+	for _, it := range l {
+		if nested, ok := it.(listItem); ok {
+			result = nested.identifiers(result)
+			continue
+		}
+		if it.String() != "" {
+			result = append(result, it.String())
+		}
+	}
+	return result
+}
+
+// normalize removes trailing null items, stopping at the first non-null item that is not a list.
+func (l listItem) normalize() listItem {
+	for i := len(l) - 1; i >= 0; i-- {
+		if l[i].isNull() {
+			l = append(l[:i], l[i+1:]...)
+		} else if _, ok := l[i].(listItem); !ok {
+			break
+		}
+	}
+	return l
+}
+
+// listNode is a list under construction while parsing, its elements are items or nested *listNode values.
+type listNode struct {
+	elems []any
+}
+
+// build converts the node into a normalized listItem, normalizing nested lists first like Maven does.
+func (n *listNode) build() listItem {
+	result := make(listItem, 0, len(n.elems))
+	for _, elem := range n.elems {
+		if nested, ok := elem.(*listNode); ok {
+			result = append(result, nested.build())
+			continue
+		}
+		result = append(result, elem.(item))
+	}
+	return result.normalize()
+}
+
+// parseItems splits a lowercased version into items the way Maven's ComparableVersion does.
+func parseItems(version string) listItem {
+	root := &listNode{}
+	current := root
+
+	// openList starts a nested list, which receives all following items
+	openList := func() {
+		nested := &listNode{}
+		current.elems = append(current.elems, nested)
+		current = nested
+	}
+	push := func(it item) {
+		current.elems = append(current.elems, it)
+	}
+
+	isDigit := false
+	start := 0
+	for i := 0; i < len(version); i++ {
+		c := version[i]
+		switch {
+		case c == '.' || c == '-':
+			if i == start {
+				push(intItem("0"))
+			} else {
+				push(parseItem(isDigit, version[start:i]))
+			}
+			start = i + 1
+			if c == '-' {
+				openList()
+			}
+		case c >= '0' && c <= '9':
+			if !isDigit && i > start {
+				// Transition from letters to digits
+				push(newStringItem(version[start:i], true))
+				start = i
+				openList()
+			}
+			isDigit = true
+		default:
+			if isDigit && i > start {
+				// Transition from digits to letters
+				push(parseItem(true, version[start:i]))
+				start = i
+				openList()
+			}
+			isDigit = false
+		}
+	}
+	if len(version) > start {
+		push(parseItem(isDigit, version[start:]))
+	}
+
+	return root.build()
+}
+
+func parseItem(isDigit bool, s string) item {
+	if isDigit {
+		s = strings.TrimLeft(s, "0")
+		if s == "" {
+			s = "0"
+		}
+		return intItem(s)
+	}
+	return newStringItem(s, false)
+}
+
+// qualifierIndex returns the position of a well-known qualifier, or -1 for unknown qualifiers.
+func qualifierIndex(q string) int {
+	if alias, ok := aliases[q]; ok {
+		q = alias
+	}
+	for i, known := range qualifiers {
+		if q == known {
+			return i
+		}
+	}
+	return -1
+}
+
+// comparableQualifier returns a string whose lexical order matches qualifier precedence:
+// well-known qualifiers map to their index, unknown ones sort after all of them, lexically among themselves.
+func comparableQualifier(q string) string {
+	if index := qualifierIndex(q); index >= 0 {
+		return fmt.Sprint(index)
+	}
+	return fmt.Sprintf("%d-%s", len(qualifiers), q)
+}
@@ -0,0 +1,175 @@
+package maven
+
+import (
+	"sort"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestCompare(t *testing.T) {
+	// Cases taken from Maven's ComparableVersionTest
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1", "1.0", 0},
+		{"1", "1.0.0", 0},
+		{"1.0", "1-0", 0},
+		{"1-SNAPSHOT", "1-snapshot", 0},
+		{"1ga", "1", 0},
+		{"1final", "1", 0},
+		{"1-release", "1", 0},
+		{"1cr", "1rc", 0},
+		{"1a1", "1-alpha-1", 0},
+		{"1b2", "1-beta-2", 0},
+		{"1m3", "1-milestone-3", 0},
+		{"1-alpha", "1-beta", -1},
+		{"1-beta", "1-milestone", -1},
+		{"1-milestone", "1-rc", -1},
+		{"1-rc", "1-snapshot", -1},
+		{"1-snapshot", "1", -1},
+		{"1", "1-sp", -1},
+		{"1-sp", "1-abc", -1},
+		{"1-abc", "1-def", -1},
+		{"1-alpha2", "1-alpha10", -1},
+		{"1.0-alpha-1", "1.0", -1},
+		{"1.0-SNAPSHOT", "1.0", -1},
+		{"1.0", "1.0-1", -1},
+		{"1.0-1", "1.0.1", -1},
+		{"1.0.1", "1.1", -1},
+		{"2.0.0", "10.0.0", -1},
+		{"1.0.0-jre", "1.0.0", 1},
+		{"1.2.3-rc-2", "1.2.3-rc-10", -1},
+		{"18446744073709551616", "18446744073709551615", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			if result := Compare(tt.a, tt.b); result != tt.expected {
+				t.Errorf("Compare(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+			if result := Compare(tt.b, tt.a); result != -tt.expected {
+				t.Errorf("Compare(%q, %q) = %v, want %v", tt.b, tt.a, result, -tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.0.0", "1"},
+		{"1.0-SNAPSHOT", "1-snapshot"},
+		{"1.2.3-alpha-1", "1.2.3-alpha-1"},
+		{"1.2.3.Final", "1.2.3"},
+		{"1a1", "1-alpha-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := Parse(tt.input).Canonical(); result != tt.expected {
+				t.Errorf("Canonical() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToSemVer(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    semver.SemVer
+		expectError bool
+	}{
+		{
+			name:     "Release",
+			input:    "1.2.3",
+			expected: semver.SemVer{Major: 1, Minor: 2, Patch: 3},
+		},
+		{
+			name:     "Short release",
+			input:    "1.2",
+			expected: semver.SemVer{Major: 1, Minor: 2},
+		},
+		{
+			name:     "Snapshot",
+			input:    "1.4.0-SNAPSHOT",
+			expected: semver.SemVer{Major: 1, Minor: 4, PreRelease: "snapshot"},
+		},
+		{
+			name:     "Shorthand qualifier",
+			input:    "2.0.0-RC1",
+			expected: semver.SemVer{Major: 2, PreRelease: "rc.1"},
+		},
+		{
+			name:     "Unknown qualifier",
+			input:    "31.1-jre",
+			expected: semver.SemVer{Major: 31, Minor: 1, Build: "jre"},
+		},
+		{
+			name:     "Service pack",
+			input:    "1.0-sp-2",
+			expected: semver.SemVer{Major: 1, Build: "sp.2"},
+		},
+		{
+			name:        "Four numeric components",
+			input:       "1.2.3.4",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Parse(tt.input).ToSemVer()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if v != tt.expected {
+				t.Errorf("ToSemVer() = %v, want %v", v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromSemVerOrdering(t *testing.T) {
+	// Versions in ascending semver order must stay in the same order under Maven rules
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0-snapshot",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+	}
+
+	versions := make([]Version, len(ordered))
+	for i, s := range ordered {
+		v, err := semver.Parse(s)
+		if err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+		versions[len(ordered)-1-i] = FromSemVer(v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Compare(versions[j]) < 0
+	})
+
+	for i := range versions {
+		if versions[i].String() != ordered[i] {
+			t.Errorf("Sort at index %d = %v, want %v", i, versions[i], ordered[i])
+		}
+	}
+}
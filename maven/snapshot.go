@@ -0,0 +1,105 @@
+package maven
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// snapshotTimeLayout is the timestamp layout of a semver snapshot pre-release, UTC with second precision.
+// It is a single identifier, as a time like "093015" on its own would be a numeric identifier with a leading zero.
+const snapshotTimeLayout = "20060102150405"
+
+// uniqueSnapshotTimeLayout is the timestamp layout of a deployed snapshot, e.g. "20240102.150405".
+const uniqueSnapshotTimeLayout = "20060102.150405"
+
+// uniqueSnapshotPattern matches a deployed snapshot version, e.g. "1.4.0-20240102.150405-3".
+var uniqueSnapshotPattern = regexp.MustCompile(`^(.+)-(\d{8}\.\d{6})-(\d+)$`)
+
+// FromSnapshot converts a Maven snapshot version into a semver pre-release ordered by time:
+// "1.4.0-SNAPSHOT" becomes 1.4.0-snapshot.<yyyymmddhhmmss> with the timestamp in UTC, and a deployed snapshot
+// like "1.4.0-20240102.150405-3" becomes 1.4.0-snapshot.20240102150405.3, using its own timestamp and build number.
+// It returns an error if the version is not a snapshot of a release version with up to three numeric components.
+func FromSnapshot(version string, timestamp time.Time) (semver.SemVer, error) {
+	var base, stamp string
+	if cut := len(version) - len("-SNAPSHOT"); cut > 0 && strings.EqualFold(version[cut:], "-SNAPSHOT") {
+		base, stamp = version[:cut], timestamp.UTC().Format(snapshotTimeLayout)
+	} else if m := uniqueSnapshotPattern.FindStringSubmatch(version); m != nil {
+		t, err := time.Parse(uniqueSnapshotTimeLayout, m[2])
+		if err != nil {
+			return semver.SemVer{}, fmt.Errorf("cannot convert %s: malformed timestamp %s", version, m[2])
+		}
+		buildNumber, err := strconv.ParseUint(m[3], 10, 64)
+		if err != nil {
+			return semver.SemVer{}, fmt.Errorf("cannot convert %s: malformed build number %s", version, m[3])
+		}
+		base, stamp = m[1], t.Format(snapshotTimeLayout)+"."+strconv.FormatUint(buildNumber, 10)
+	} else {
+		return semver.SemVer{}, fmt.Errorf("cannot convert %s: not a snapshot version", version)
+	}
+
+	// The base must be a plain release, qualifiers before the snapshot marker have no semver equivalent
+	v, err := Parse(base).ToSemVer()
+	if err != nil {
+		return semver.SemVer{}, err
+	}
+	if !v.IsRelease() || v.Build != "" {
+		return semver.SemVer{}, fmt.Errorf("cannot convert %s: %s is not a release version", version, base)
+	}
+	v.PreRelease = "snapshot." + stamp
+	return v, nil
+}
+
+// IsSnapshot reports whether the version is a snapshot pre-release as returned by FromSnapshot.
+func IsSnapshot(v semver.SemVer) bool {
+	_, _, err := parseSnapshot(v)
+	return err == nil
+}
+
+// ToSnapshot converts a snapshot pre-release as returned by FromSnapshot back into the Maven snapshot version,
+// e.g. "1.4.0-SNAPSHOT" for 1.4.0-snapshot.20240102150405, and returns its timestamp.
+// It returns an error if the version is not such a snapshot pre-release.
+func ToSnapshot(v semver.SemVer) (string, time.Time, error) {
+	t, _, err := parseSnapshot(v)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return fmt.Sprintf("%d.%d.%d-SNAPSHOT", v.Major, v.Minor, v.Patch), t, nil
+}
+
+// ToUniqueSnapshot converts a snapshot pre-release of a deployed snapshot as returned by FromSnapshot back into
+// the deployed snapshot version, e.g. "1.4.0-20240102.150405-3" for 1.4.0-snapshot.20240102150405.3.
+// It returns an error if the version is not such a snapshot pre-release or has no build number.
+func ToUniqueSnapshot(v semver.SemVer) (string, error) {
+	t, buildNumber, err := parseSnapshot(v)
+	if err != nil {
+		return "", err
+	}
+	if buildNumber == "" {
+		return "", fmt.Errorf("cannot convert %s: snapshot without build number", v)
+	}
+	return fmt.Sprintf("%d.%d.%d-%s-%s", v.Major, v.Minor, v.Patch, t.Format(uniqueSnapshotTimeLayout), buildNumber), nil
+}
+
+// parseSnapshot returns the timestamp and build number, if any, of a snapshot pre-release.
+func parseSnapshot(v semver.SemVer) (time.Time, string, error) {
+	parts := strings.Split(v.PreRelease, ".")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] != "snapshot" {
+		return time.Time{}, "", fmt.Errorf("cannot convert %s: not a snapshot pre-release", v)
+	}
+	t, err := time.Parse(snapshotTimeLayout, parts[1])
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("cannot convert %s: malformed timestamp %s", v, parts[1])
+	}
+	if len(parts) == 2 {
+		return t, "", nil
+	}
+	if _, err := strconv.ParseUint(parts[2], 10, 64); err != nil {
+		return time.Time{}, "", fmt.Errorf("cannot convert %s: malformed build number %s", v, parts[2])
+	}
+	return t, parts[2], nil
+}
@@ -0,0 +1,124 @@
+package maven
+
+import (
+	"testing"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestFromSnapshot(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 16, 4, 5, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name        string
+		version     string
+		expected    string
+		expectError bool
+	}{
+		{name: "Snapshot", version: "1.4.0-SNAPSHOT", expected: "1.4.0-snapshot.20240102150405"},
+		{name: "Lower case snapshot", version: "1.4.0-snapshot", expected: "1.4.0-snapshot.20240102150405"},
+		{name: "Two components", version: "1.4-SNAPSHOT", expected: "1.4.0-snapshot.20240102150405"},
+		{name: "Deployed snapshot", version: "1.4.0-20231231.093015-3", expected: "1.4.0-snapshot.20231231093015.3"},
+		{name: "Deployed snapshot with padded build number", version: "1.4.0-20231231.093015-007", expected: "1.4.0-snapshot.20231231093015.7"},
+		{name: "Release", version: "1.4.0", expectError: true},
+		{name: "Qualified base", version: "1.4.0-rc1-SNAPSHOT", expectError: true},
+		{name: "Four components", version: "1.4.0.1-SNAPSHOT", expectError: true},
+		{name: "Malformed timestamp", version: "1.4.0-20231399.093015-3", expectError: true},
+		{name: "Marker only", version: "-SNAPSHOT", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := FromSnapshot(tt.version, timestamp)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if v.String() != tt.expected {
+				t.Errorf("FromSnapshot() = %v, want %v", v, tt.expected)
+			}
+			if !IsSnapshot(v) {
+				t.Errorf("IsSnapshot(%v) = false, want true", v)
+			}
+		})
+	}
+}
+
+func TestToSnapshot(t *testing.T) {
+	tests := []struct {
+		name           string
+		version        string
+		expected       string
+		expectedTime   time.Time
+		expectedUnique string
+		expectError    bool
+	}{
+		{
+			name:         "Snapshot",
+			version:      "1.4.0-snapshot.20240102150405",
+			expected:     "1.4.0-SNAPSHOT",
+			expectedTime: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name:           "Deployed snapshot",
+			version:        "1.4.0-snapshot.20231231093015.3",
+			expected:       "1.4.0-SNAPSHOT",
+			expectedTime:   time.Date(2023, 12, 31, 9, 30, 15, 0, time.UTC),
+			expectedUnique: "1.4.0-20231231.093015-3",
+		},
+		{name: "Release", version: "1.4.0", expectError: true},
+		{name: "Other pre-release", version: "1.4.0-rc.1", expectError: true},
+		{name: "Missing timestamp", version: "1.4.0-snapshot", expectError: true},
+		{name: "Malformed build number", version: "1.4.0-snapshot.20231231093015.x", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := semver.Parse(tt.version)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.version, err)
+			}
+			snapshot, timestamp, err := ToSnapshot(v)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				if IsSnapshot(v) {
+					t.Errorf("IsSnapshot(%v) = true, want false", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if snapshot != tt.expected || !timestamp.Equal(tt.expectedTime) {
+				t.Errorf("ToSnapshot() = %q, %v, want %q, %v", snapshot, timestamp, tt.expected, tt.expectedTime)
+			}
+
+			unique, err := ToUniqueSnapshot(v)
+			if tt.expectedUnique == "" {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if unique != tt.expectedUnique {
+				t.Errorf("ToUniqueSnapshot() = %q, want %q", unique, tt.expectedUnique)
+			}
+
+			// Deployed snapshots convert back to the same version
+			if back, err := FromSnapshot(unique, time.Time{}); err != nil || back != v {
+				t.Errorf("FromSnapshot(%q) = %v, %v, want %v", unique, back, err, v)
+			}
+		})
+	}
+}
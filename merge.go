@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"container/heap"
+	"iter"
+	"slices"
+)
+
+// Merge combines version sequences each sorted in ascending order by ComparePrecedence, e.g. the tag lists
+// of several registries, into one sorted sequence without sorting again. Versions of equal precedence are
+// yielded once, the one from the first source that has it wins. Sources are consumed lazily.
+func Merge(sources ...iter.Seq[SemVer]) iter.Seq[SemVer] {
+	return func(yield func(SemVer) bool) {
+		var h mergeHeap
+		for i, source := range sources {
+			next, stop := iter.Pull(source)
+			defer stop()
+			if v, ok := next(); ok {
+				h = append(h, &mergeCursor{version: v, source: i, next: next})
+			}
+		}
+		heap.Init(&h)
+
+		var last SemVer
+		first := true
+		for len(h) > 0 {
+			c := h[0]
+			if first || ComparePrecedence(c.version, last) != 0 {
+				if !yield(c.version) {
+					return
+				}
+				last, first = c.version, false
+			}
+
+			// Advance the source of the yielded version
+			if v, ok := c.next(); ok {
+				c.version = v
+				heap.Fix(&h, 0)
+			} else {
+				heap.Pop(&h)
+			}
+		}
+	}
+}
+
+// MergeSorted is like Merge for slices, returning the merged versions as a slice.
+func MergeSorted(lists ...[]SemVer) []SemVer {
+	sources := make([]iter.Seq[SemVer], len(lists))
+	for i, list := range lists {
+		sources[i] = slices.Values(list)
+	}
+	return slices.Collect(Merge(sources...))
+}
+
+// mergeCursor is the current version of a source being merged.
+type mergeCursor struct {
+	version SemVer
+	source  int
+	next    func() (SemVer, bool)
+}
+
+// mergeHeap orders the cursors by precedence, then by source.
+type mergeHeap []*mergeCursor
+
+func (h mergeHeap) Len() int { return len(h) }
+
+func (h mergeHeap) Less(i, j int) bool {
+	if result := ComparePrecedence(h[i].version, h[j].version); result != 0 {
+		return result < 0
+	}
+	return h[i].source < h[j].source
+}
+
+func (h mergeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *mergeHeap) Push(x any) { *h = append(*h, x.(*mergeCursor)) }
+
+func (h *mergeHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
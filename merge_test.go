@@ -0,0 +1,96 @@
+package semver
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		lists    [][]string
+		expected []string
+	}{
+		{
+			name:     "Interleaved",
+			lists:    [][]string{{"1.0.0", "1.2.0", "2.0.0"}, {"0.9.0", "1.1.0", "2.0.0-rc.1"}},
+			expected: []string{"0.9.0", "1.0.0", "1.1.0", "1.2.0", "2.0.0-rc.1", "2.0.0"},
+		},
+		{
+			name:     "Duplicates across sources",
+			lists:    [][]string{{"1.0.0+mirror-a", "1.1.0"}, {"1.0.0+mirror-b", "1.1.0", "1.2.0"}},
+			expected: []string{"1.0.0+mirror-a", "1.1.0", "1.2.0"},
+		},
+		{
+			name:     "Duplicates within a source",
+			lists:    [][]string{{"1.0.0", "1.0.0", "1.1.0"}},
+			expected: []string{"1.0.0", "1.1.0"},
+		},
+		{
+			name:     "Empty sources",
+			lists:    [][]string{{}, {"1.0.0"}, nil},
+			expected: []string{"1.0.0"},
+		},
+		{
+			name: "No sources",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lists [][]SemVer
+			for _, list := range tt.lists {
+				lists = append(lists, sortedVersions(t, list...))
+			}
+			if result := versionStrings(MergeSorted(lists...)); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("MergeSorted() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMergeStopsEarly(t *testing.T) {
+	// A source that would fail the test if read past the versions needed
+	pulled := 0
+	source := func(yield func(SemVer) bool) {
+		for i := uint(0); i < 100; i++ {
+			pulled++
+			if !yield(SemVer{Major: i}) {
+				return
+			}
+		}
+	}
+
+	var result []SemVer
+	for v := range Merge(iter.Seq[SemVer](source), slices.Values(sortedVersions(t, "0.5.0"))) {
+		result = append(result, v)
+		if len(result) == 3 {
+			break
+		}
+	}
+	if expected := []string{"0.0.0", "0.5.0", "1.0.0"}; !reflect.DeepEqual(versionStrings(result), expected) {
+		t.Errorf("Merge() = %v, want %v", versionStrings(result), expected)
+	}
+	if pulled > 4 {
+		t.Errorf("Merge() pulled %d versions, want at most 4", pulled)
+	}
+}
+
+func TestMergeMatchesSort(t *testing.T) {
+	g := NewGenerator(3)
+	a, b, c := g.Versions(40), g.Versions(40), g.Versions(40)
+
+	expected := sortedForPaging(slices.Concat(a, b, c))
+	expected = slices.CompactFunc(expected, func(x, y SemVer) bool { return ComparePrecedence(x, y) == 0 })
+	result := MergeSorted(a, b, c)
+	if len(result) != len(expected) {
+		t.Fatalf("MergeSorted() returned %d versions, want %d", len(result), len(expected))
+	}
+	for i := range result {
+		if ComparePrecedence(result[i], expected[i]) != 0 {
+			t.Errorf("MergeSorted()[%d] = %v, want %v", i, result[i], expected[i])
+		}
+	}
+}
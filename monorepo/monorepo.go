@@ -0,0 +1,208 @@
+// Package monorepo tracks the versions of several components released from one repository,
+// read from prefixed git tags or from a manifest file, and bumps them independently.
+package monorepo
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/mkyc/go-semver/internal/atomicfile"
+)
+
+// Root is the name of the component at the root of the repository.
+const Root = "."
+
+// TagFormat is the way component versions are written as git tags.
+type TagFormat int
+
+const (
+	// TagSlash writes tags as "<component>/v<version>", like Go modules in subdirectories,
+	// and the root component as "v<version>".
+	TagSlash TagFormat = iota
+	// TagAt writes tags as "<component>@<version>", like npm workspaces,
+	// and the root component as "<version>".
+	TagAt
+)
+
+// Tag returns the tag of a component version.
+func (f TagFormat) Tag(component string, v semver.SemVer) string {
+	switch {
+	case f == TagAt && component == Root:
+		return v.String()
+	case f == TagAt:
+		return component + "@" + v.String()
+	case component == Root:
+		return "v" + v.String()
+	}
+	return component + "/v" + v.String()
+}
+
+// ParseTag splits a tag into component and version. It returns false if the tag does not name a version in the format.
+func (f TagFormat) ParseTag(tag string) (string, semver.SemVer, bool) {
+	component, version := Root, tag
+	switch f {
+	case TagAt:
+		// The component may be a scoped npm package like "@scope/pkg"
+		if at := strings.LastIndex(tag, "@"); at > 0 {
+			component, version = tag[:at], tag[at+1:]
+		}
+	default:
+		if slash := strings.LastIndex(tag, "/"); slash >= 0 {
+			component, version = tag[:slash], tag[slash+1:]
+		}
+		if !strings.HasPrefix(version, "v") {
+			return "", semver.SemVer{}, false
+		}
+		version = version[1:]
+	}
+
+	v, err := semver.Parse(version)
+	if err != nil || component == "" {
+		return "", semver.SemVer{}, false
+	}
+	return component, v, true
+}
+
+// Manager holds the current versions of the components of a repository. It is safe for concurrent use.
+type Manager struct {
+	format TagFormat
+
+	mu       sync.RWMutex
+	versions map[string]semver.SemVer
+}
+
+// New returns a manager without components, writing tags in the format.
+func New(format TagFormat) *Manager {
+	return &Manager{format: format, versions: map[string]semver.SemVer{}}
+}
+
+// FromTags returns a manager with the components named by the tags in the format, each at its highest version
+// by semver.ComparePrecedence, so a component tagged api/v1.10.0 and api/v1.11.0-rc.1 is at 1.11.0-rc.1.
+// Tags of other formats are ignored.
+func FromTags(format TagFormat, tags []string) *Manager {
+	m := New(format)
+	for _, tag := range tags {
+		component, v, ok := format.ParseTag(tag)
+		if !ok {
+			continue
+		}
+		if current, ok := m.versions[component]; !ok || semver.ComparePrecedence(v, current) > 0 {
+			m.versions[component] = v
+		}
+	}
+	return m
+}
+
+// LoadManifest returns a manager with the components of a manifest file, a JSON object mapping component
+// names to versions, e.g. {".": "1.2.0", "packages/api": "0.4.1"}.
+// It returns an error if the file cannot be read or contains an invalid version.
+func LoadManifest(format TagFormat, path string) (*Manager, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %v", path, err)
+	}
+
+	m := New(format)
+	for component, version := range raw {
+		v, err := semver.Parse(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest %s: component %s: %v", path, component, err)
+		}
+		m.versions[component] = v
+	}
+	return m, nil
+}
+
+// SaveManifest atomically writes the versions of all components to a manifest file read by LoadManifest.
+func (m *Manager) SaveManifest(path string) error {
+	raw := map[string]string{}
+	for component, v := range m.Snapshot() {
+		raw[component] = v.String()
+	}
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := atomicfile.WriteFile(path, data); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	return nil
+}
+
+// Components returns the names of all components, sorted.
+func (m *Manager) Components() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	names := make([]string, 0, len(m.versions))
+	for name := range m.versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Version returns the current version of the component, or false if it is unknown.
+func (m *Manager) Version(component string) (semver.SemVer, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	v, ok := m.versions[component]
+	return v, ok
+}
+
+// Snapshot returns a copy of the versions of all components, consistent at the time of the call.
+func (m *Manager) Snapshot() map[string]semver.SemVer {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	snapshot := make(map[string]semver.SemVer, len(m.versions))
+	for name, v := range m.versions {
+		snapshot[name] = v
+	}
+	return snapshot
+}
+
+// Set sets the version of a component, adding the component if it is unknown.
+func (m *Manager) Set(component string, v semver.SemVer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.versions[component] = v
+}
+
+// Bump bumps the version of the component for a change of the given type and returns the new version.
+// It returns an error if the component is unknown.
+func (m *Manager) Bump(component string, change semver.ChangeType) (semver.SemVer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.versions[component]
+	if !ok {
+		return semver.SemVer{}, fmt.Errorf("unknown component %s", component)
+	}
+	v = v.Bump(change)
+	m.versions[component] = v
+	return v, nil
+}
+
+// Tag returns the tag of the current version of the component, or false if it is unknown.
+func (m *Manager) Tag(component string) (string, bool) {
+	v, ok := m.Version(component)
+	if !ok {
+		return "", false
+	}
+	return m.format.Tag(component, v), true
+}
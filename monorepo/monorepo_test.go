@@ -0,0 +1,160 @@
+package monorepo
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func mustParse(t *testing.T, s string) semver.SemVer {
+	t.Helper()
+	v, err := semver.Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed: %v", s, err)
+	}
+	return v
+}
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		name              string
+		format            TagFormat
+		tag               string
+		expectedComponent string
+		expectedVersion   string
+		expectError       bool
+	}{
+		{name: "Slash", format: TagSlash, tag: "api/v1.2.3", expectedComponent: "api", expectedVersion: "1.2.3"},
+		{name: "Slash nested", format: TagSlash, tag: "services/api/v0.4.0-rc.1", expectedComponent: "services/api", expectedVersion: "0.4.0-rc.1"},
+		{name: "Slash root", format: TagSlash, tag: "v2.0.0", expectedComponent: Root, expectedVersion: "2.0.0"},
+		{name: "Slash without v", format: TagSlash, tag: "api/1.2.3", expectError: true},
+		{name: "Slash empty component", format: TagSlash, tag: "/v1.2.3", expectError: true},
+		{name: "At", format: TagAt, tag: "api@1.2.3", expectedComponent: "api", expectedVersion: "1.2.3"},
+		{name: "At scoped package", format: TagAt, tag: "@acme/api@1.2.3", expectedComponent: "@acme/api", expectedVersion: "1.2.3"},
+		{name: "At root", format: TagAt, tag: "1.2.3", expectedComponent: Root, expectedVersion: "1.2.3"},
+		{name: "At with v", format: TagAt, tag: "api@v1.2.3", expectError: true},
+		{name: "Not a version", format: TagSlash, tag: "api/latest", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			component, v, ok := tt.format.ParseTag(tt.tag)
+			if tt.expectError {
+				if ok {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if !ok {
+				t.Errorf("Did not expect error but got one")
+				return
+			}
+			if component != tt.expectedComponent || v.String() != tt.expectedVersion {
+				t.Errorf("ParseTag() = %v, %v, want %v, %v", component, v, tt.expectedComponent, tt.expectedVersion)
+			}
+			if tag := tt.format.Tag(component, v); tag != tt.tag {
+				t.Errorf("Tag() = %v, want %v", tag, tt.tag)
+			}
+		})
+	}
+}
+
+func TestFromTags(t *testing.T) {
+	m := FromTags(TagSlash, []string{"v1.0.0", "api/v1.2.0", "api/v1.10.0", "api/v1.11.0-rc.1", "web/v0.3.0", "web/v0.3.0-rc.2", "web@0.9.0", "nightly"})
+
+	expected := map[string]semver.SemVer{
+		Root:  mustParse(t, "1.0.0"),
+		"api": mustParse(t, "1.11.0-rc.1"),
+		"web": mustParse(t, "0.3.0"),
+	}
+	if result := m.Snapshot(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Snapshot() = %v, want %v", result, expected)
+	}
+	if result := m.Components(); !reflect.DeepEqual(result, []string{Root, "api", "web"}) {
+		t.Errorf("Components() = %v, want %v", result, []string{Root, "api", "web"})
+	}
+}
+
+func TestManagerBump(t *testing.T) {
+	m := New(TagSlash)
+	m.Set("api", mustParse(t, "1.2.3"))
+	m.Set("web", mustParse(t, "0.3.0"))
+
+	v, err := m.Bump("api", semver.ChangeMinor)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if v.String() != "1.3.0" {
+		t.Errorf("Bump() = %v, want 1.3.0", v)
+	}
+	if tag, _ := m.Tag("api"); tag != "api/v1.3.0" {
+		t.Errorf("Tag() = %v, want api/v1.3.0", tag)
+	}
+	if v, _ := m.Version("web"); v.String() != "0.3.0" {
+		t.Errorf("Version() = %v, want 0.3.0", v)
+	}
+
+	if _, err := m.Bump("docs", semver.ChangePatch); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+	if _, ok := m.Tag("docs"); ok {
+		t.Errorf("Tag() of unknown component returned true")
+	}
+}
+
+func TestManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".versions.json")
+
+	m := New(TagAt)
+	m.Set(Root, mustParse(t, "1.2.0"))
+	m.Set("packages/api", mustParse(t, "0.4.1"))
+	if err := m.SaveManifest(path); err != nil {
+		t.Fatalf("SaveManifest() failed: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "{\n  \".\": \"1.2.0\",\n  \"packages/api\": \"0.4.1\"\n}\n"
+	if string(data) != expected {
+		t.Errorf("SaveManifest() wrote %q, want %q", data, expected)
+	}
+
+	loaded, err := LoadManifest(TagAt, path)
+	if err != nil {
+		t.Fatalf("LoadManifest() failed: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Snapshot(), m.Snapshot()) {
+		t.Errorf("LoadManifest() = %v, want %v", loaded.Snapshot(), m.Snapshot())
+	}
+}
+
+func TestLoadManifestErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "Invalid JSON", content: `{`},
+		{name: "Not an object of strings", content: `{"api": 1}`},
+		{name: "Invalid version", content: `{"api": "1.2"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "manifest.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadManifest(TagSlash, path); err == nil {
+				t.Errorf("Expected error but got none")
+			}
+		})
+	}
+
+	if _, err := LoadManifest(TagSlash, filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
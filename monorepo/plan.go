@@ -0,0 +1,146 @@
+package monorepo
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Propagation returns the change type a component requires when one of its dependencies is released
+// with a change of the given type.
+type Propagation func(dependency semver.ChangeType) semver.ChangeType
+
+// PatchPropagation releases a patch version of every dependent of a released component,
+// so the dependents ship with the new dependency.
+func PatchPropagation(dependency semver.ChangeType) semver.ChangeType {
+	if dependency == semver.ChangeNone {
+		return semver.ChangeNone
+	}
+	return semver.ChangePatch
+}
+
+// Step is a release of a component in a plan.
+type Step struct {
+	Component string
+	Change    semver.ChangeType
+	From      semver.SemVer
+	To        semver.SemVer
+
+	// Causes are the dependencies whose releases required this one, sorted.
+	Causes []string
+}
+
+// Plan computes the releases required by changes to components, including the cascade of releases of their
+// dependents. The graph maps each component to the components it depends on, and propagate decides the change
+// a dependent requires for a released dependency, PatchPropagation if nil. A component is released with the
+// largest of its own change and the propagated changes.
+//
+// The steps are ordered so that every component is released after its dependencies, components without
+// an order between them sorted by name. The versions of the manager are not modified, see Apply.
+//
+// It returns an error if a component of the graph or the changes is unknown or the graph has a cycle.
+func (m *Manager) Plan(graph map[string][]string, changes map[string]semver.ChangeType, propagate Propagation) ([]Step, error) {
+	if propagate == nil {
+		propagate = PatchPropagation
+	}
+	versions := m.Snapshot()
+
+	for component, deps := range graph {
+		for _, c := range append([]string{component}, deps...) {
+			if _, ok := versions[c]; !ok {
+				return nil, fmt.Errorf("unknown component %s", c)
+			}
+		}
+	}
+	for c := range changes {
+		if _, ok := versions[c]; !ok {
+			return nil, fmt.Errorf("unknown component %s", c)
+		}
+	}
+
+	order, err := topologicalOrder(versions, graph)
+	if err != nil {
+		return nil, err
+	}
+
+	// Propagate the changes along the order, dependencies being decided before their dependents
+	released := map[string]semver.ChangeType{}
+	var plan []Step
+	for _, c := range order {
+		step := Step{Component: c, Change: changes[c], From: versions[c]}
+		for _, dep := range graph[c] {
+			if change, ok := released[dep]; ok {
+				step.Change = max(step.Change, propagate(change))
+				step.Causes = append(step.Causes, dep)
+			}
+		}
+		if step.Change == semver.ChangeNone {
+			continue
+		}
+		sort.Strings(step.Causes)
+		step.Causes = slices.Compact(step.Causes)
+		step.To = step.From.Bump(step.Change)
+		released[c] = step.Change
+		plan = append(plan, step)
+	}
+	return plan, nil
+}
+
+// Apply sets the versions of the components released by the plan.
+func (m *Manager) Apply(plan []Step) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, step := range plan {
+		m.versions[step.Component] = step.To
+	}
+}
+
+// topologicalOrder returns the components ordered so that each follows its dependencies,
+// choosing the smallest name among the components ready at each point.
+// It returns an error if the graph has a cycle.
+func topologicalOrder(versions map[string]semver.SemVer, graph map[string][]string) ([]string, error) {
+	pending := map[string]int{}
+	dependents := map[string][]string{}
+	for c := range versions {
+		pending[c] = 0
+	}
+	for c, deps := range graph {
+		for _, dep := range slices.Compact(slices.Sorted(slices.Values(deps))) {
+			pending[c]++
+			dependents[dep] = append(dependents[dep], c)
+		}
+	}
+
+	var ready, order []string
+	for c, n := range pending {
+		if n == 0 {
+			ready = append(ready, c)
+		}
+	}
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		c := ready[0]
+		ready = ready[1:]
+		order = append(order, c)
+		for _, d := range dependents[c] {
+			if pending[d]--; pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+
+	if len(order) < len(pending) {
+		var cycle []string
+		for c, n := range pending {
+			if n > 0 {
+				cycle = append(cycle, c)
+			}
+		}
+		sort.Strings(cycle)
+		return nil, fmt.Errorf("dependency cycle between components %v", cycle)
+	}
+	return order, nil
+}
@@ -0,0 +1,131 @@
+package monorepo
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestPlan(t *testing.T) {
+	// core <- util <- api <- web, core <- web, docs is independent
+	graph := map[string][]string{
+		"util": {"core"},
+		"api":  {"util", "core"},
+		"web":  {"api", "core", "core"},
+	}
+	newManager := func() *Manager {
+		m := New(TagSlash)
+		for c, v := range map[string]string{"core": "1.4.2", "util": "0.3.0", "api": "2.0.1", "web": "1.0.0", "docs": "1.0.0"} {
+			m.Set(c, mustParse(t, v))
+		}
+		return m
+	}
+	majorPropagation := func(dependency semver.ChangeType) semver.ChangeType {
+		if dependency == semver.ChangeMajor {
+			return semver.ChangeMajor
+		}
+		return PatchPropagation(dependency)
+	}
+
+	tests := []struct {
+		name        string
+		changes     map[string]semver.ChangeType
+		propagate   Propagation
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:     "No changes",
+			expected: nil,
+		},
+		{
+			name:     "Leaf change",
+			changes:  map[string]semver.ChangeType{"web": semver.ChangeMinor},
+			expected: []string{"web 1.0.0 -> 1.1.0 []"},
+		},
+		{
+			name:    "Cascade",
+			changes: map[string]semver.ChangeType{"core": semver.ChangeMinor},
+			expected: []string{
+				"core 1.4.2 -> 1.5.0 []",
+				"util 0.3.0 -> 0.3.1 [core]",
+				"api 2.0.1 -> 2.0.2 [core util]",
+				"web 1.0.0 -> 1.0.1 [api core]",
+			},
+		},
+		{
+			name:    "Own change larger than propagated",
+			changes: map[string]semver.ChangeType{"util": semver.ChangePatch, "api": semver.ChangeMajor, "docs": semver.ChangePatch},
+			expected: []string{
+				"docs 1.0.0 -> 1.0.1 []",
+				"util 0.3.0 -> 0.3.1 []",
+				"api 2.0.1 -> 3.0.0 [util]",
+				"web 1.0.0 -> 1.0.1 [api]",
+			},
+		},
+		{
+			name:      "Custom propagation",
+			changes:   map[string]semver.ChangeType{"util": semver.ChangeMajor},
+			propagate: majorPropagation,
+			expected: []string{
+				"util 0.3.0 -> 1.0.0 []",
+				"api 2.0.1 -> 3.0.0 [util]",
+				"web 1.0.0 -> 2.0.0 [api]",
+			},
+		},
+		{
+			name:        "Unknown component",
+			changes:     map[string]semver.ChangeType{"cli": semver.ChangePatch},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newManager()
+			plan, err := m.Plan(graph, tt.changes, tt.propagate)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+
+			var result []string
+			for _, step := range plan {
+				result = append(result, step.Component+" "+step.From.String()+" -> "+step.To.String()+" "+fmt.Sprint(step.Causes))
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Plan() = %q, want %q", result, tt.expected)
+			}
+
+			// The plan is applied separately
+			if v, _ := m.Version("web"); v.String() != "1.0.0" {
+				t.Errorf("Plan() modified the versions")
+			}
+			m.Apply(plan)
+			for _, step := range plan {
+				if v, _ := m.Version(step.Component); v != step.To {
+					t.Errorf("Apply() set %s to %v, want %v", step.Component, v, step.To)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanCycle(t *testing.T) {
+	m := New(TagSlash)
+	for _, c := range []string{"a", "b", "c"} {
+		m.Set(c, semver.SemVer{Major: 1})
+	}
+	graph := map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}}
+	if _, err := m.Plan(graph, map[string]semver.ChangeType{"a": semver.ChangePatch}, nil); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
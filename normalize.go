@@ -0,0 +1,25 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StripBuild returns the version without build metadata, e.g. 1.2.3-rc.1 for 1.2.3-rc.1+linux.amd64.
+func (s SemVer) StripBuild() SemVer {
+	s.Build = ""
+	return s
+}
+
+// NormalizeForComparison parses a version as found on an artifact, e.g. in a file name or manifest, into the
+// canonical form used to compare artifacts for reproducibility: surrounding whitespace and a "v" prefix are removed
+// and build metadata is dropped, as builds of the same version may differ in it.
+// Two normalized versions name the same version if and only if they are equal with ==.
+// It returns an error if the string is not a valid version.
+func NormalizeForComparison(s string) (SemVer, error) {
+	v, err := ParseWith(strings.TrimSpace(s), WithVPrefix(VPrefixAllow))
+	if err != nil {
+		return SemVer{}, fmt.Errorf("invalid version for comparison: %w", err)
+	}
+	return v.StripBuild(), nil
+}
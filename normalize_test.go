@@ -0,0 +1,65 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestStripBuild(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"1.2.3+build.5", "1.2.3"},
+		{"1.2.3-rc.1+linux.amd64", "1.2.3-rc.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if result := mustParse(t, tt.version).StripBuild(); result.String() != tt.expected {
+				t.Errorf("StripBuild() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeForComparison(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           string
+		b           string
+		equal       bool
+		expectError bool
+	}{
+		{name: "Different build metadata", a: "1.2.3+sha.abc", b: "1.2.3+sha.def", equal: true},
+		{name: "Prefix and whitespace", a: " v1.2.3-rc.1\n", b: "1.2.3-rc.1+ci.42", equal: true},
+		{name: "Different pre-releases", a: "1.2.3-rc.1", b: "1.2.3-rc.2", equal: false},
+		{name: "Pre-release and release", a: "1.2.3-rc.1", b: "1.2.3", equal: false},
+		{name: "Invalid version", a: "1.2", b: "1.2.0", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NormalizeForComparison(tt.a)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			b, err := NormalizeForComparison(tt.b)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if (a == b) != tt.equal {
+				t.Errorf("NormalizeForComparison(%q) == NormalizeForComparison(%q) is %v, want %v", tt.a, tt.b, a == b, tt.equal)
+			}
+			if a.Build != "" {
+				t.Errorf("NormalizeForComparison(%q) kept build metadata %q", tt.a, a.Build)
+			}
+		})
+	}
+}
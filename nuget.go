@@ -0,0 +1,124 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseNuGetRange parses a version range in NuGet interval notation into a Constraint.
+//
+// Square brackets include and parentheses exclude a bound, a missing bound is unbounded:
+// "[1.0.0,2.0.0)" is >=1.0.0 <2.0.0, "(1.2,]" is >1.2.0, "(,2.0]" is <=2.0.0 and "[1.2.3]" is exactly 1.2.3.
+// A bare version like "1.2" is a minimum, >=1.2.0. Missing version components are zero.
+// Pre-releases are matched as by ParseConstraint. Versions with four components are not supported.
+//
+// It returns an error if the range is empty or malformed.
+func ParseNuGetRange(s string) (Constraint, error) {
+	c := Constraint{raw: s}
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return Constraint{}, fmt.Errorf("invalid nuget range: %q, empty range", s)
+	}
+
+	// A bare version is a minimum
+	if !strings.ContainsAny(trimmed[:1], "[(") {
+		v, err := parseNuGetVersion(trimmed)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid nuget range: %q: %w", s, err)
+		}
+		c.ranges = [][]comparator{{{">=", v}}}
+		return c, nil
+	}
+
+	// Split the interval into its bounds
+	last := trimmed[len(trimmed)-1]
+	if len(trimmed) < 2 || last != ']' && last != ')' {
+		return Constraint{}, fmt.Errorf("invalid nuget range: %q, missing closing bracket", s)
+	}
+	lowerInclusive, upperInclusive := trimmed[0] == '[', last == ']'
+	inner := trimmed[1 : len(trimmed)-1]
+
+	lower, upper, hasComma := strings.Cut(inner, ",")
+	if !hasComma {
+		// An exact version must be enclosed in square brackets
+		if !lowerInclusive || !upperInclusive {
+			return Constraint{}, fmt.Errorf("invalid nuget range: %q, an exact version requires square brackets", s)
+		}
+		v, err := parseNuGetVersion(inner)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid nuget range: %q: %w", s, err)
+		}
+		c.ranges = [][]comparator{{{"=", v}}}
+		return c, nil
+	}
+
+	lower, upper = strings.TrimSpace(lower), strings.TrimSpace(upper)
+	if lower == "" && upper == "" {
+		return Constraint{}, fmt.Errorf("invalid nuget range: %q, missing bounds", s)
+	}
+	comparators := []comparator{}
+	if lower != "" {
+		v, err := parseNuGetVersion(lower)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid nuget range: %q: %w", s, err)
+		}
+		comparators = append(comparators, comparator{Bound{Inclusive: lowerInclusive}.op(">"), v})
+	}
+	if upper != "" {
+		v, err := parseNuGetVersion(upper)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid nuget range: %q: %w", s, err)
+		}
+		comparators = append(comparators, comparator{Bound{Inclusive: upperInclusive}.op("<"), v})
+	}
+	c.ranges = [][]comparator{comparators}
+
+	return c, nil
+}
+
+// parseNuGetVersion parses a version with one to three components, padding missing ones with zeros.
+func parseNuGetVersion(s string) (SemVer, error) {
+	p, err := parsePartialVersion(s)
+	versionCore, _, _ := strings.Cut(strings.SplitN(s, "+", 2)[0], "-")
+	if err != nil || p.parts == 0 || p.parts != strings.Count(versionCore, ".")+1 {
+		return SemVer{}, fmt.Errorf("invalid version %s", s)
+	}
+	return p.version, nil
+}
+
+// FormatNuGetRange returns the constraint in NuGet interval notation, e.g. "[1.0.0,2.0.0)" for ">=1.0.0 <2.0.0".
+// It returns an error if the versions allowed by the constraint do not form a single interval with at least one bound.
+func FormatNuGetRange(c Constraint) (string, error) {
+	intervals := c.Intervals()
+	if len(intervals) != 1 {
+		return "", fmt.Errorf("cannot format %q as a nuget range, it is not a single interval", c)
+	}
+	iv := intervals[0]
+
+	switch {
+	case iv.Lower.Unbounded && iv.Upper.Unbounded:
+		return "", fmt.Errorf("cannot format %q as a nuget range, it has no bounds", c)
+	case iv.single():
+		return "[" + iv.Lower.Version.String() + "]", nil
+	}
+
+	var b strings.Builder
+	if iv.Lower.Inclusive && !iv.Lower.Unbounded {
+		b.WriteString("[" + iv.Lower.Version.String())
+	} else {
+		b.WriteString("(")
+		if !iv.Lower.Unbounded {
+			b.WriteString(iv.Lower.Version.String())
+		}
+	}
+	b.WriteString(",")
+	if iv.Upper.Inclusive && !iv.Upper.Unbounded {
+		b.WriteString(iv.Upper.Version.String() + "]")
+	} else {
+		if !iv.Upper.Unbounded {
+			b.WriteString(iv.Upper.Version.String())
+		}
+		b.WriteString(")")
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,120 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseNuGetRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraint  string
+		expected    string
+		expectError bool
+	}{
+		{name: "Closed open", constraint: "[1.0.0,2.0.0)", expected: "[1.0.0,2.0.0)"},
+		{name: "Open lower", constraint: "(1.2,]", expected: "(1.2.0,)"},
+		{name: "Unbounded lower", constraint: "(,2.0]", expected: "(,2.0.0]"},
+		{name: "Exact", constraint: "[1.2.3]", expected: "[1.2.3]"},
+		{name: "Minimum", constraint: "1.2", expected: "[1.2.0,)"},
+		{name: "Whitespace", constraint: " [1.0 , 2.0] ", expected: "[1.0.0,2.0.0]"},
+		{name: "Pre-release", constraint: "[1.0.0-rc.1,1.0.0]", expected: "[1.0.0-rc.1,1.0.0]"},
+		{name: "Empty", constraint: "", expectError: true},
+		{name: "Missing bracket", constraint: "[1.0.0,2.0.0", expectError: true},
+		{name: "Exact without brackets", constraint: "(1.0.0)", expectError: true},
+		{name: "No bounds", constraint: "(,)", expectError: true},
+		{name: "Four components", constraint: "[1.0.0.0,)", expectError: true},
+		{name: "Wildcard", constraint: "[1.x,)", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseNuGetRange(tt.constraint)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if c.String() != tt.constraint {
+				t.Errorf("String() = %v, want %v", c.String(), tt.constraint)
+			}
+			formatted, err := FormatNuGetRange(c)
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if formatted != tt.expected {
+				t.Errorf("FormatNuGetRange() = %v, want %v", formatted, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNuGetRangeAllows(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"[1.0.0,2.0.0)", "1.0.0", true},
+		{"[1.0.0,2.0.0)", "2.0.0", false},
+		{"(1.0.0,2.0.0]", "1.0.0", false},
+		{"(1.0.0,2.0.0]", "2.0.0", true},
+		{"(1.2,]", "1.2.1", true},
+		{"(,2.0]", "0.1.0", true},
+		{"[1.2.3]", "1.2.4", false},
+		{"1.2", "5.0.0", true},
+		{"1.2", "1.1.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" allows "+tt.version, func(t *testing.T) {
+			c, err := ParseNuGetRange(tt.constraint)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := c.Allows(mustParse(t, tt.version)); result != tt.expected {
+				t.Errorf("Allows() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatNuGetRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraint  string
+		expected    string
+		expectError bool
+	}{
+		{name: "Caret", constraint: "^1.2", expected: "[1.2.0,2.0.0)"},
+		{name: "Hyphen range", constraint: "1.0.0 - 1.5.0", expected: "[1.0.0,1.5.0]"},
+		{name: "Upper only", constraint: "<3.0.0", expected: "(,3.0.0)"},
+		{name: "Alternatives", constraint: "1.x || 3.x", expectError: true},
+		{name: "Not equal", constraint: ">=1.0.0 !=1.5.0", expectError: true},
+		{name: "Contradiction", constraint: ">2.0.0 <1.0.0", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FormatNuGetRange(MustParseConstraint(tt.constraint))
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("FormatNuGetRange() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
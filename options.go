@@ -0,0 +1,134 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FourthSegmentStrategy controls how ParseWith handles versions with a fourth numeric component,
+// like "1.2.3.4" as used by Windows and some vendors.
+type FourthSegmentStrategy int
+
+const (
+	// FourthSegmentReject rejects four-part versions with an error, like Parse does.
+	FourthSegmentReject FourthSegmentStrategy = iota
+	// FourthSegmentDrop drops the fourth component, "1.2.3.4" becomes "1.2.3".
+	FourthSegmentDrop
+	// FourthSegmentBuild folds the fourth component into the build metadata as its first identifier,
+	// "1.2.3.4+abc" becomes "1.2.3+4.abc".
+	FourthSegmentBuild
+)
+
+// VPrefixPolicy controls how ParseWith handles a leading "v" as used by git tags and Go modules, e.g. "v1.2.3".
+type VPrefixPolicy int
+
+const (
+	// VPrefixReject rejects a "v" prefix with an error, like Parse does, as the specification forbids it.
+	VPrefixReject VPrefixPolicy = iota
+	// VPrefixAllow accepts versions with and without a "v" prefix.
+	VPrefixAllow
+	// VPrefixRequire requires a "v" prefix, so versions produced by StringV are accepted and only those.
+	VPrefixRequire
+)
+
+// parseOptions holds the configuration of ParseWith.
+type parseOptions struct {
+	fourthSegment FourthSegmentStrategy
+	vPrefix       VPrefixPolicy
+}
+
+// ParseOption configures the behavior of ParseWith.
+type ParseOption func(*parseOptions)
+
+// WithFourthSegment returns a ParseOption selecting how a fourth numeric version component is handled.
+func WithFourthSegment(strategy FourthSegmentStrategy) ParseOption {
+	return func(o *parseOptions) {
+		o.fourthSegment = strategy
+	}
+}
+
+// WithVPrefix returns a ParseOption selecting how a leading "v" is handled.
+func WithVPrefix(policy VPrefixPolicy) ParseOption {
+	return func(o *parseOptions) {
+		o.vPrefix = policy
+	}
+}
+
+// ParseWith parses a string tag into a SemVer struct like Parse, with its behavior adjusted by options.
+// Without options it is equivalent to Parse. It returns an error if an option has an unknown value.
+func ParseWith(tag string, opts ...ParseOption) (SemVer, error) {
+	var options parseOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Strip the "v" prefix as allowed by the policy
+	switch options.vPrefix {
+	case VPrefixReject:
+	case VPrefixAllow:
+		tag = strings.TrimPrefix(tag, "v")
+	case VPrefixRequire:
+		if !strings.HasPrefix(tag, "v") {
+			return SemVer{}, fmt.Errorf("invalid version: %s, missing v prefix", tag)
+		}
+		tag = tag[1:]
+	default:
+		return SemVer{}, fmt.Errorf("invalid v prefix policy: %d", options.vPrefix)
+	}
+
+	// Coerce four-part versions before handing the tag to the strict parser
+	switch options.fourthSegment {
+	case FourthSegmentReject:
+	case FourthSegmentDrop, FourthSegmentBuild:
+		tag = coerceFourthSegment(tag, options.fourthSegment)
+	default:
+		return SemVer{}, fmt.Errorf("invalid fourth segment strategy: %d", options.fourthSegment)
+	}
+
+	return Parse(tag)
+}
+
+// coerceFourthSegment rewrites a four-part version core according to the strategy, FourthSegmentDrop or FourthSegmentBuild.
+// Tags that do not have a numeric fourth component are returned unchanged, so Parse reports the problem.
+func coerceFourthSegment(tag string, strategy FourthSegmentStrategy) string {
+	// Split the tag the same way Parse does
+	versionPart, build, hasBuild := strings.Cut(tag, "+")
+	versionCore, preRelease, hasPreRelease := strings.Cut(versionPart, "-")
+
+	versionParts := strings.Split(versionCore, ".")
+	if len(versionParts) != 4 || !isDigits(versionParts[3]) {
+		return tag
+	}
+
+	// Rebuild the tag with the coerced fourth component
+	result := strings.Join(versionParts[:3], ".")
+	if hasPreRelease {
+		result += "-" + preRelease
+	}
+	switch strategy {
+	case FourthSegmentDrop:
+		if hasBuild {
+			result += "+" + build
+		}
+	case FourthSegmentBuild:
+		result += "+" + versionParts[3]
+		if hasBuild {
+			result += "." + build
+		}
+	}
+
+	return result
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseWithFourthSegment(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         string
+		strategy    FourthSegmentStrategy
+		expected    string
+		expectError bool
+	}{
+		{
+			name:        "Reject four-part version",
+			tag:         "1.2.3.4",
+			strategy:    FourthSegmentReject,
+			expectError: true,
+		},
+		{
+			name:     "Drop fourth segment",
+			tag:      "1.2.3.4",
+			strategy: FourthSegmentDrop,
+			expected: "1.2.3",
+		},
+		{
+			name:     "Drop fourth segment keeps pre-release and build",
+			tag:      "1.2.3.4-rc.1+abc",
+			strategy: FourthSegmentDrop,
+			expected: "1.2.3-rc.1+abc",
+		},
+		{
+			name:     "Fold fourth segment into build",
+			tag:      "1.2.3.4",
+			strategy: FourthSegmentBuild,
+			expected: "1.2.3+4",
+		},
+		{
+			name:     "Fold fourth segment into existing build",
+			tag:      "1.2.3.0400-beta+abc.def",
+			strategy: FourthSegmentBuild,
+			expected: "1.2.3-beta+0400.abc.def",
+		},
+		{
+			name:     "Three-part version is unaffected",
+			tag:      "1.2.3+abc",
+			strategy: FourthSegmentBuild,
+			expected: "1.2.3+abc",
+		},
+		{
+			name:        "Non-numeric fourth segment",
+			tag:         "1.2.3.a",
+			strategy:    FourthSegmentDrop,
+			expectError: true,
+		},
+		{
+			name:        "Unknown strategy",
+			tag:         "1.2.3",
+			strategy:    FourthSegmentStrategy(42),
+			expectError: true,
+		},
+		{
+			name:        "Five-part version",
+			tag:         "1.2.3.4.5",
+			strategy:    FourthSegmentDrop,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			semver, err := ParseWith(tt.tag, WithFourthSegment(tt.strategy))
+
+			// Check error expectation
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+				return
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+
+			if !tt.expectError && semver.String() != tt.expected {
+				t.Errorf("ParseWith() = %v, want %v", semver.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseWithVPrefix(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         string
+		policy      VPrefixPolicy
+		expected    string
+		expectError bool
+	}{
+		{name: "Reject prefix", tag: "v1.2.3", policy: VPrefixReject, expectError: true},
+		{name: "Reject without prefix", tag: "1.2.3", policy: VPrefixReject, expected: "1.2.3"},
+		{name: "Allow prefix", tag: "v1.2.3-rc.1", policy: VPrefixAllow, expected: "1.2.3-rc.1"},
+		{name: "Allow without prefix", tag: "1.2.3", policy: VPrefixAllow, expected: "1.2.3"},
+		{name: "Allow double prefix", tag: "vv1.2.3", policy: VPrefixAllow, expectError: true},
+		{name: "Require prefix", tag: "v1.2.3+abc", policy: VPrefixRequire, expected: "1.2.3+abc"},
+		{name: "Require without prefix", tag: "1.2.3", policy: VPrefixRequire, expectError: true},
+		{name: "Uppercase prefix", tag: "V1.2.3", policy: VPrefixAllow, expectError: true},
+		{name: "Unknown policy", tag: "1.2.3", policy: VPrefixPolicy(42), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseWith(tt.tag, WithVPrefix(tt.policy))
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result.String() != tt.expected {
+				t.Errorf("ParseWith() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStringVRoundTrip(t *testing.T) {
+	for _, tag := range []string{"0.0.0", "1.2.3-rc.1+build.5"} {
+		v := mustParse(t, tag)
+		if result := v.StringV(); result != "v"+tag {
+			t.Errorf("StringV() = %v, want v%v", result, tag)
+		}
+		parsed, err := ParseWith(v.StringV(), WithVPrefix(VPrefixRequire))
+		if err != nil || parsed != v {
+			t.Errorf("ParseWith(%q) = %v, %v, want %v", v.StringV(), parsed, err, v)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package osv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// DefaultQueryURL is the query endpoint of the public OSV API.
+const DefaultQueryURL = "https://api.osv.dev/v1/query"
+
+// Client queries the OSV API for the vulnerabilities of a package.
+// The zero value uses http.DefaultClient and DefaultQueryURL.
+type Client struct {
+	HTTPClient *http.Client
+	QueryURL   string
+}
+
+// query is the request body of the query endpoint.
+type query struct {
+	Package   Package `json:"package"`
+	PageToken string  `json:"page_token,omitempty"`
+}
+
+// queryResponse is the response body of the query endpoint.
+type queryResponse struct {
+	Vulns         []Vulnerability `json:"vulns"`
+	NextPageToken string          `json:"next_page_token"`
+}
+
+// Vulnerabilities returns all vulnerabilities of the package known to OSV, following pagination.
+func (c *Client) Vulnerabilities(ctx context.Context, ecosystem, name string) ([]Vulnerability, error) {
+	httpClient, url := c.HTTPClient, c.QueryURL
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if url == "" {
+		url = DefaultQueryURL
+	}
+
+	var vulns []Vulnerability
+	q := query{Package: Package{Ecosystem: ecosystem, Name: name}}
+	for {
+		body, err := json.Marshal(q)
+		if err != nil {
+			return nil, fmt.Errorf("osv query: %w", err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("osv query: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("osv query: %w", err)
+		}
+		var result queryResponse
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("osv query: unexpected status %s", resp.Status)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("osv query: %w", err)
+		}
+
+		vulns = append(vulns, result.Vulns...)
+		if result.NextPageToken == "" {
+			return vulns, nil
+		}
+		q.PageToken = result.NextPageToken
+	}
+}
+
+// Check returns the vulnerabilities of the package affecting the version. The affected ranges
+// are evaluated locally with Range.Affects rather than by the OSV API.
+func (c *Client) Check(ctx context.Context, ecosystem, name string, v semver.SemVer) ([]Vulnerability, error) {
+	vulns, err := c.Vulnerabilities(ctx, ecosystem, name)
+	if err != nil {
+		return nil, err
+	}
+	return Filter(vulns, ecosystem, name, v), nil
+}
+
+// Filter returns the vulnerabilities affecting the version of the package, e.g. from OSV JSON files.
+func Filter(vulns []Vulnerability, ecosystem, name string, v semver.SemVer) []Vulnerability {
+	var affecting []Vulnerability
+	for _, vuln := range vulns {
+		if vuln.Affects(ecosystem, name, v) {
+			affecting = append(affecting, vuln)
+		}
+	}
+	return affecting
+}
@@ -0,0 +1,57 @@
+package osv
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientCheck(t *testing.T) {
+	var queries []query
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var q query
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			t.Errorf("Did not expect error but got: %v", err)
+		}
+		queries = append(queries, q)
+
+		// Serve the record on the first page and an unrelated one on the second
+		var vuln Vulnerability
+		json.Unmarshal([]byte(record), &vuln)
+		if q.PageToken == "" {
+			json.NewEncoder(w).Encode(queryResponse{Vulns: []Vulnerability{vuln}, NextPageToken: "next"})
+			return
+		}
+		vuln.ID = "GO-2024-0002"
+		vuln.Affected[0].Ranges = []Range{{Type: RangeSemVer, Events: []Event{{Introduced: "5.0.0"}}}}
+		vuln.Affected[0].Versions = nil
+		json.NewEncoder(w).Encode(queryResponse{Vulns: []Vulnerability{vuln}})
+	}))
+	defer server.Close()
+
+	client := &Client{QueryURL: server.URL}
+	vulns, err := client.Check(context.Background(), "Go", "example.com/mod", mustParse(t, "1.2.3"))
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if len(vulns) != 1 || vulns[0].ID != "GO-2024-0001" {
+		t.Errorf("Check() = %v, want GO-2024-0001", vulns)
+	}
+	if len(queries) != 2 || queries[0].Package.Name != "example.com/mod" || queries[1].PageToken != "next" {
+		t.Errorf("queries = %+v, want two pages for example.com/mod", queries)
+	}
+}
+
+func TestClientError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &Client{QueryURL: server.URL}
+	if _, err := client.Check(context.Background(), "Go", "example.com/mod", mustParse(t, "1.2.3")); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
@@ -0,0 +1,174 @@
+// Package osv evaluates vulnerability records in the Open Source Vulnerability (OSV) format
+// against semantic versions, either from OSV JSON at hand or queried from the OSV API.
+//
+// See https://ossf.github.io/osv-schema/ for the format.
+package osv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Range types of the OSV schema.
+const (
+	RangeSemVer    = "SEMVER"
+	RangeEcosystem = "ECOSYSTEM"
+	RangeGit       = "GIT"
+)
+
+// Vulnerability is an OSV record, reduced to the fields needed to evaluate it.
+type Vulnerability struct {
+	ID       string     `json:"id"`
+	Summary  string     `json:"summary,omitempty"`
+	Details  string     `json:"details,omitempty"`
+	Aliases  []string   `json:"aliases,omitempty"`
+	Modified string     `json:"modified,omitempty"`
+	Affected []Affected `json:"affected,omitempty"`
+}
+
+// Affected lists the affected versions of a single package.
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+// Package identifies a package within an ecosystem, e.g. "Go" or "npm".
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Purl      string `json:"purl,omitempty"`
+}
+
+// Range is a range of affected versions, described by events in version order.
+type Range struct {
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+// Event is a single event of a range. Exactly one of the fields is set.
+// An Introduced version of "0" means the range starts before any version.
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// Affects returns true if the vulnerability affects the version of the package.
+// The ecosystem and name are matched exactly, as OSV records use the ecosystem's canonical names.
+func (vuln Vulnerability) Affects(ecosystem, name string, v semver.SemVer) bool {
+	for _, a := range vuln.Affected {
+		if a.Package.Ecosystem == ecosystem && a.Package.Name == name && a.Affects(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Affects returns true if the version is listed explicitly or falls into one of the ranges.
+// Ranges that cannot be evaluated with semantic versions, like GIT ranges, are ignored.
+func (a Affected) Affects(v semver.SemVer) bool {
+	for _, listed := range a.Versions {
+		if l, err := parseVersion(listed); err == nil && semver.ComparePrecedence(l, v) == 0 {
+			return true
+		}
+	}
+	for _, r := range a.Ranges {
+		if affected, err := r.Affects(v); err == nil && affected {
+			return true
+		}
+	}
+	return false
+}
+
+// event is an Event with its parsed version. A nil version is the "0" introduced event.
+type event struct {
+	kind    string
+	version *semver.SemVer
+}
+
+// Affects evaluates the range for the version following the OSV evaluation algorithm:
+// the events are sorted, and the version is affected if the last introduced event at or below it
+// is not followed by a fixed event at or below it or a last_affected event below it.
+// A limit event caps the range. SEMVER ranges are always evaluated, ECOSYSTEM ranges only
+// if all their versions are semantic versions.
+//
+// It returns an error for GIT ranges and ranges with versions that are not semantic versions.
+func (r Range) Affects(v semver.SemVer) (bool, error) {
+	if r.Type != RangeSemVer && r.Type != RangeEcosystem {
+		return false, fmt.Errorf("invalid osv range: %s ranges cannot be evaluated", r.Type)
+	}
+
+	events := make([]event, 0, len(r.Events))
+	for _, e := range r.Events {
+		var kind, value string
+		switch {
+		case e.Introduced != "":
+			kind, value = "introduced", e.Introduced
+		case e.Fixed != "":
+			kind, value = "fixed", e.Fixed
+		case e.LastAffected != "":
+			kind, value = "last_affected", e.LastAffected
+		case e.Limit != "":
+			kind, value = "limit", e.Limit
+		default:
+			return false, fmt.Errorf("invalid osv range: empty event")
+		}
+
+		if kind == "introduced" && value == "0" {
+			events = append(events, event{kind: kind})
+			continue
+		}
+		parsed, err := parseVersion(value)
+		if err != nil {
+			return false, fmt.Errorf("invalid osv range: %s event: %w", kind, err)
+		}
+		events = append(events, event{kind: kind, version: &parsed})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].version == nil || events[j].version == nil {
+			return events[i].version == nil && events[j].version != nil
+		}
+		return semver.ComparePrecedence(*events[i].version, *events[j].version) < 0
+	})
+
+	affected := false
+	for _, e := range events {
+		var cmp int
+		if e.version == nil {
+			cmp = 1
+		} else {
+			cmp = semver.ComparePrecedence(v, *e.version)
+		}
+
+		switch e.kind {
+		case "introduced":
+			if cmp >= 0 {
+				affected = true
+			}
+		case "fixed":
+			if cmp >= 0 {
+				affected = false
+			}
+		case "last_affected":
+			if cmp > 0 {
+				affected = false
+			}
+		case "limit":
+			if cmp >= 0 {
+				return false, nil
+			}
+		}
+	}
+	return affected, nil
+}
+
+// parseVersion parses a version of an OSV record, which has a "v" prefix in some ecosystems.
+func parseVersion(s string) (semver.SemVer, error) {
+	return semver.Parse(strings.TrimPrefix(s, "v"))
+}
@@ -0,0 +1,138 @@
+package osv
+
+import (
+	"encoding/json"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// record is an OSV record in the shape published for Go modules.
+const record = `{
+	"id": "GO-2024-0001",
+	"summary": "Example vulnerability",
+	"affected": [
+		{
+			"package": {"ecosystem": "Go", "name": "example.com/mod"},
+			"ranges": [
+				{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.2.4"}, {"introduced": "1.3.0"}, {"fixed": "1.3.2"}]},
+				{"type": "SEMVER", "events": [{"introduced": "2.0.0-rc.1"}, {"last_affected": "2.0.1"}]},
+				{"type": "GIT", "events": [{"introduced": "abc"}, {"fixed": "def"}]}
+			],
+			"versions": ["3.0.0-beta"]
+		}
+	]
+}`
+
+func mustParse(t *testing.T, tag string) semver.SemVer {
+	t.Helper()
+	v, err := semver.Parse(tag)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed: %v", tag, err)
+	}
+	return v
+}
+
+func TestVulnerabilityAffects(t *testing.T) {
+	var vuln Vulnerability
+	if err := json.Unmarshal([]byte(record), &vuln); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{"0.1.0", true},
+		{"1.2.3", true},
+		{"1.2.4-rc.1", true},
+		{"1.2.4", false},
+		{"1.2.9", false},
+		{"1.3.0", true},
+		{"1.3.2", false},
+		{"2.0.0-beta", false},
+		{"2.0.0-rc.1", true},
+		{"2.0.1", true},
+		{"2.0.2", false},
+		{"3.0.0-beta", true},
+		{"3.0.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if result := vuln.Affects("Go", "example.com/mod", mustParse(t, tt.version)); result != tt.expected {
+				t.Errorf("Affects() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	if vuln.Affects("Go", "example.com/other", mustParse(t, "1.0.0")) {
+		t.Errorf("Affects() = true for another package, want false")
+	}
+}
+
+func TestRangeAffects(t *testing.T) {
+	tests := []struct {
+		name        string
+		r           Range
+		version     string
+		expected    bool
+		expectError bool
+	}{
+		{
+			name:     "Unsorted events",
+			r:        Range{Type: RangeSemVer, Events: []Event{{Fixed: "1.5.0"}, {Introduced: "1.0.0"}}},
+			version:  "1.4.0",
+			expected: true,
+		},
+		{
+			name:     "Limit",
+			r:        Range{Type: RangeSemVer, Events: []Event{{Introduced: "0"}, {Limit: "2.0.0"}}},
+			version:  "2.1.0",
+			expected: false,
+		},
+		{
+			name:     "Ecosystem range with v prefix",
+			r:        Range{Type: RangeEcosystem, Events: []Event{{Introduced: "v1.0.0"}, {Fixed: "v1.1.0"}}},
+			version:  "1.0.5",
+			expected: true,
+		},
+		{
+			name:        "Ecosystem range with other versions",
+			r:           Range{Type: RangeEcosystem, Events: []Event{{Introduced: "1.0"}}},
+			version:     "1.0.5",
+			expectError: true,
+		},
+		{
+			name:        "Git range",
+			r:           Range{Type: RangeGit, Events: []Event{{Introduced: "abc"}}},
+			version:     "1.0.0",
+			expectError: true,
+		},
+		{
+			name:        "Empty event",
+			r:           Range{Type: RangeSemVer, Events: []Event{{}}},
+			version:     "1.0.0",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.r.Affects(mustParse(t, tt.version))
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("Affects() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
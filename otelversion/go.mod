@@ -0,0 +1,10 @@
+module github.com/mkyc/go-semver/otelversion
+
+go 1.25.0
+
+require (
+	github.com/mkyc/go-semver v0.1.0
+	go.opentelemetry.io/otel v1.44.0
+)
+
+require github.com/cespare/xxhash/v2 v2.3.0 // indirect
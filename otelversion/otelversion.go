@@ -0,0 +1,39 @@
+// Package otelversion returns OpenTelemetry resource attributes for a semantic version,
+// so traces and metrics are tagged with version data consistently:
+//
+//	res := resource.NewWithAttributes(semconv.SchemaURL, otelversion.Attributes(v)...)
+package otelversion
+
+import (
+	semver "github.com/mkyc/go-semver"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+)
+
+// Attribute keys of the version components, next to the semantic convention service.version.
+const (
+	MajorKey      = attribute.Key("service.version.major")
+	MinorKey      = attribute.Key("service.version.minor")
+	PatchKey      = attribute.Key("service.version.patch")
+	PreReleaseKey = attribute.Key("service.version.prerelease")
+	BuildKey      = attribute.Key("service.version.build")
+)
+
+// Attributes returns the service.version attribute with the full version and the major, minor and
+// patch components as integer attributes, which allow range queries like service.version.major >= 2.
+// The pre-release and build metadata attributes are only included if the version has them.
+func Attributes(v semver.SemVer) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		semconv.ServiceVersion(v.String()),
+		MajorKey.Int64(int64(v.Major)),
+		MinorKey.Int64(int64(v.Minor)),
+		PatchKey.Int64(int64(v.Patch)),
+	}
+	if v.PreRelease != "" {
+		attrs = append(attrs, PreReleaseKey.String(v.PreRelease))
+	}
+	if v.Build != "" {
+		attrs = append(attrs, BuildKey.String(v.Build))
+	}
+	return attrs
+}
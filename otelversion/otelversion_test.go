@@ -0,0 +1,48 @@
+package otelversion
+
+import (
+	"reflect"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  semver.SemVer
+		expected []attribute.KeyValue
+	}{
+		{
+			name:    "Release",
+			version: semver.SemVer{Major: 1, Minor: 2, Patch: 3},
+			expected: []attribute.KeyValue{
+				attribute.String("service.version", "1.2.3"),
+				attribute.Int64("service.version.major", 1),
+				attribute.Int64("service.version.minor", 2),
+				attribute.Int64("service.version.patch", 3),
+			},
+		},
+		{
+			name:    "Pre-release with build metadata",
+			version: semver.SemVer{Major: 2, PreRelease: "rc.1", Build: "abc"},
+			expected: []attribute.KeyValue{
+				attribute.String("service.version", "2.0.0-rc.1+abc"),
+				attribute.Int64("service.version.major", 2),
+				attribute.Int64("service.version.minor", 0),
+				attribute.Int64("service.version.patch", 0),
+				attribute.String("service.version.prerelease", "rc.1"),
+				attribute.String("service.version.build", "abc"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Attributes(tt.version); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Attributes() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
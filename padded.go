@@ -0,0 +1,130 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// paddedAlphanumericPrefix marks alphanumeric identifiers starting with a digit in padded names.
+// It sorts after the digits and before the letters, so such identifiers sort after numeric ones
+// and among other alphanumeric ones as by precedence.
+const paddedAlphanumericPrefix = "@"
+
+// Padded returns the version with its numbers zero-padded to a fixed width, so that sorting the names
+// byte-wise orders them by precedence, e.g. for directory layouts and object store prefixes.
+// With width 4, 1.2.3-rc.1 is "0001.0002.0003-rc.0001" and 1.2.3 is "0001.0002.0003~", the tilde sorting
+// releases after their pre-releases. Alphanumeric identifiers starting with a digit are prefixed with "@",
+// e.g. "1a" becomes "@1a", so they sort after all numeric identifiers like 1.0.0-2000 does before 1.0.0-1a.
+// Build metadata is appended after "+" and does not affect the order.
+//
+// It returns an error if the width is not positive, a number has more digits than the width, or a pre-release
+// identifier contains a hyphen, as these would break the order.
+func (s SemVer) Padded(width int) (string, error) {
+	if width <= 0 {
+		return "", fmt.Errorf("invalid width: %d", width)
+	}
+	pad := func(n uint64) (string, error) {
+		digits := strconv.FormatUint(n, 10)
+		if len(digits) > width {
+			return "", fmt.Errorf("cannot pad %s: %d has more than %d digits", s, n, width)
+		}
+		return strings.Repeat("0", width-len(digits)) + digits, nil
+	}
+
+	// Pad the version core
+	parts := make([]string, 3)
+	for i, n := range []uint{s.Major, s.Minor, s.Patch} {
+		p, err := pad(uint64(n))
+		if err != nil {
+			return "", err
+		}
+		parts[i] = p
+	}
+	name := strings.Join(parts, ".")
+
+	// Pad numeric pre-release identifiers
+	if s.PreRelease == "" {
+		name += "~"
+	} else {
+		identifiers := strings.Split(s.PreRelease, ".")
+		for i, id := range identifiers {
+			if isDigits(id) {
+				n, err := strconv.ParseUint(id, 10, 64)
+				if err != nil {
+					return "", fmt.Errorf("cannot pad %s: %w", s, err)
+				}
+				if identifiers[i], err = pad(n); err != nil {
+					return "", err
+				}
+				continue
+			}
+			if strings.Contains(id, "-") {
+				return "", fmt.Errorf("cannot pad %s: pre-release identifier %s contains a hyphen", s, id)
+			}
+			if id[0] >= '0' && id[0] <= '9' {
+				identifiers[i] = paddedAlphanumericPrefix + id
+			}
+		}
+		name += "-" + strings.Join(identifiers, ".")
+	}
+
+	if s.Build != "" {
+		name += "+" + s.Build
+	}
+	return name, nil
+}
+
+// ParsePadded parses a name produced by Padded, of any width.
+// It returns an error if the name does not decode to a valid version.
+func ParsePadded(name string) (SemVer, error) {
+	versionPart, build, hasBuild := strings.Cut(name, "+")
+
+	// Releases end with a tilde, pre-releases have a hyphen after the core
+	var core, preRelease string
+	var hasPreRelease bool
+	if strings.HasSuffix(versionPart, "~") {
+		core = strings.TrimSuffix(versionPart, "~")
+	} else {
+		core, preRelease, hasPreRelease = strings.Cut(versionPart, "-")
+		if !hasPreRelease {
+			return SemVer{}, fmt.Errorf("invalid padded version: %s, expected a pre-release or a tilde after the core", name)
+		}
+	}
+
+	// Strip the padding of all numbers
+	unpad := func(s string) string {
+		if !isDigits(s) {
+			return s
+		}
+		if trimmed := strings.TrimLeft(s, "0"); trimmed != "" {
+			return trimmed
+		}
+		return "0"
+	}
+	parts := strings.Split(core, ".")
+	for i := range parts {
+		parts[i] = unpad(parts[i])
+	}
+	version := strings.Join(parts, ".")
+	if hasPreRelease {
+		identifiers := strings.Split(preRelease, ".")
+		for i, id := range identifiers {
+			if alphanumeric, ok := strings.CutPrefix(id, paddedAlphanumericPrefix); ok {
+				identifiers[i] = alphanumeric
+				continue
+			}
+			identifiers[i] = unpad(id)
+		}
+		version += "-" + strings.Join(identifiers, ".")
+	}
+	if hasBuild {
+		version += "+" + build
+	}
+
+	v, err := Parse(version)
+	if err != nil {
+		return SemVer{}, fmt.Errorf("invalid padded version: %s: %w", name, err)
+	}
+	return v, nil
+}
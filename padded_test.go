@@ -0,0 +1,87 @@
+package semver
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestPadded(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		width       int
+		expected    string
+		expectError bool
+	}{
+		{name: "Release", version: "1.2.3", width: 4, expected: "0001.0002.0003~"},
+		{name: "Pre-release", version: "1.2.3-rc.1", width: 4, expected: "0001.0002.0003-rc.0001"},
+		{name: "Build", version: "1.2.3-beta+sha.0abc", width: 2, expected: "01.02.03-beta+sha.0abc"},
+		{name: "Zero", version: "0.0.0-0", width: 3, expected: "000.000.000-000"},
+		{name: "Exact width", version: "9999.0.0", width: 4, expected: "9999.0000.0000~"},
+		{name: "Alphanumeric starting with digits", version: "1.0.0-1a", width: 4, expected: "0001.0000.0000-@1a"},
+		{name: "Alphanumeric starting with width digits", version: "1.0.0-1234a", width: 4, expected: "0001.0000.0000-@1234a"},
+		{name: "Number too wide", version: "10000.0.0", width: 4, expectError: true},
+		{name: "Pre-release number too wide", version: "1.0.0-rc.12345", width: 4, expectError: true},
+		{name: "Hyphen in identifier", version: "1.0.0-x-y", width: 4, expectError: true},
+		{name: "Invalid width", version: "1.0.0", width: 0, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := mustParse(t, tt.version)
+			result, err := v.Padded(tt.width)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("Padded() = %v, want %v", result, tt.expected)
+			}
+			if parsed, err := ParsePadded(result); err != nil || parsed != v {
+				t.Errorf("ParsePadded(%q) = %v, %v, want %v", result, parsed, err, v)
+			}
+		})
+	}
+}
+
+func TestPaddedSortOrder(t *testing.T) {
+	// The precedence examples of the specification and more
+	ordered := []string{
+		"0.9.0", "1.0.0-0", "1.0.0-2", "1.0.0-9", "1.0.0-10", "1.0.0-2000", "1.0.0-12345a", "1.0.0-1a", "1.0.0-1b",
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.2.0", "1.10.0", "2.0.0-rc.1", "2.0.0", "10.0.0",
+	}
+
+	var names []string
+	for i, s := range ordered {
+		if i > 0 && ComparePrecedence(mustParse(t, ordered[i-1]), mustParse(t, s)) >= 0 {
+			t.Fatalf("%v does not precede %v", ordered[i-1], s)
+		}
+		name, err := mustParse(t, s).Padded(4)
+		if err != nil {
+			t.Fatalf("Padded(%v) failed: %v", s, err)
+		}
+		names = append(names, name)
+	}
+
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	for i := range names {
+		if sorted[i] != names[i] {
+			t.Errorf("byte order at %d = %v, want %v", i, sorted[i], names[i])
+		}
+	}
+}
+
+func TestParsePaddedInvalid(t *testing.T) {
+	for _, name := range []string{"0001.0002.0003", "0001.0002~x", "0001.0002.0003-rc..1", "a.b.c~"} {
+		if _, err := ParsePadded(name); err == nil {
+			t.Errorf("ParsePadded(%q) expected error but got none", name)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+)
+
+// After returns up to n versions of the list that follow the cursor version, in ascending precedence order.
+// Use the last version of a page as the cursor of the next one; the list does not need to be sorted.
+// Versions of equal precedence are ordered by their build metadata, so each version appears on exactly one page.
+func After(list []SemVer, cursor SemVer, n int) []SemVer {
+	sorted := sortedForPaging(list)
+	i := sort.Search(len(sorted), func(i int) bool { return comparePaging(sorted[i], cursor) > 0 })
+	return sorted[i:min(i+max(n, 0), len(sorted))]
+}
+
+// Before returns up to n versions of the list that precede the cursor version, in ascending precedence order.
+// Use the first version of a page as the cursor of the previous one; the list does not need to be sorted.
+// Versions of equal precedence are ordered by their build metadata, so each version appears on exactly one page.
+func Before(list []SemVer, cursor SemVer, n int) []SemVer {
+	sorted := sortedForPaging(list)
+	i := sort.Search(len(sorted), func(i int) bool { return comparePaging(sorted[i], cursor) >= 0 })
+	return sorted[max(i-max(n, 0), 0):i]
+}
+
+// sortedForPaging returns a sorted copy of the versions without duplicates.
+func sortedForPaging(list []SemVer) []SemVer {
+	sorted := append([]SemVer(nil), list...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return comparePaging(sorted[i], sorted[j]) < 0
+	})
+
+	// Drop duplicates, which would break the cursor
+	unique := sorted[:0]
+	for i, v := range sorted {
+		if i == 0 || v != sorted[i-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+// comparePaging compares versions by precedence and then by build metadata, a total order over distinct versions.
+func comparePaging(a, b SemVer) int {
+	if result := ComparePrecedence(a, b); result != 0 {
+		return result
+	}
+	return strings.Compare(a.Build, b.Build)
+}
@@ -0,0 +1,62 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAfterBefore(t *testing.T) {
+	var list []SemVer
+	for _, s := range []string{"1.10.0", "1.2.0", "2.0.0", "1.2.0+b", "2.0.0-rc.1", "1.9.0", "1.2.0", "0.1.0"} {
+		list = append(list, mustParse(t, s))
+	}
+
+	tests := []struct {
+		name     string
+		after    bool
+		cursor   string
+		n        int
+		expected []string
+	}{
+		{name: "After first", after: true, cursor: "0.1.0", n: 2, expected: []string{"1.2.0", "1.2.0+b"}},
+		{name: "After build", after: true, cursor: "1.2.0+b", n: 3, expected: []string{"1.9.0", "1.10.0", "2.0.0-rc.1"}},
+		{name: "After missing cursor", after: true, cursor: "1.5.0", n: 1, expected: []string{"1.9.0"}},
+		{name: "After last", after: true, cursor: "2.0.0", n: 5},
+		{name: "After below all", after: true, cursor: "0.0.1", n: 10, expected: []string{"0.1.0", "1.2.0", "1.2.0+b", "1.9.0", "1.10.0", "2.0.0-rc.1", "2.0.0"}},
+		{name: "Before", after: false, cursor: "1.10.0", n: 2, expected: []string{"1.2.0+b", "1.9.0"}},
+		{name: "Before pre-release", after: false, cursor: "2.0.0", n: 1, expected: []string{"2.0.0-rc.1"}},
+		{name: "Before first", after: false, cursor: "0.1.0", n: 3},
+		{name: "Before near start", after: false, cursor: "1.2.0+b", n: 5, expected: []string{"0.1.0", "1.2.0"}},
+		{name: "Zero page size", after: true, cursor: "0.1.0", n: 0},
+		{name: "Negative page size", after: false, cursor: "2.0.0", n: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var page []SemVer
+			if tt.after {
+				page = After(list, mustParse(t, tt.cursor), tt.n)
+			} else {
+				page = Before(list, mustParse(t, tt.cursor), tt.n)
+			}
+			if result := versionStrings(page); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("page = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAfterPagesThroughAll(t *testing.T) {
+	g := NewGenerator(7)
+	list := append(g.Versions(50), g.Versions(50)...)
+
+	var seen []SemVer
+	page := After(list, SemVer{}, 7)
+	for len(page) > 0 {
+		seen = append(seen, page...)
+		page = After(list, page[len(page)-1], 7)
+	}
+	if expected := sortedForPaging(list); !reflect.DeepEqual(seen, expected) {
+		t.Errorf("paging returned %d versions, want %d", len(seen), len(expected))
+	}
+}
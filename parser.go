@@ -0,0 +1,54 @@
+package semver
+
+import (
+	"sync"
+)
+
+// parserPool holds released parsers for reuse.
+var parserPool = sync.Pool{
+	New: func() any { return new(Parser) },
+}
+
+// Parser parses versions like Parse, reusing its buffers across calls, so bulk jobs parsing millions of
+// versions do not allocate for each of them. Valid versions are parsed without allocating; the pre-release
+// and build metadata of the result share the memory of the parsed string.
+//
+// A Parser is not safe for concurrent use. The zero value is ready to use, NewParser takes one from a pool
+// and Release puts it back.
+type Parser struct {
+	scratch []string
+}
+
+// NewParser returns a parser from a package-wide pool. Call Release when done with it.
+func NewParser() *Parser {
+	return parserPool.Get().(*Parser)
+}
+
+// Parse parses a string tag into a SemVer struct like Parse.
+func (p *Parser) Parse(tag string) (SemVer, error) {
+	v, scratch, err := parse(tag, p.scratch)
+	p.scratch = scratch[:0]
+	return v, err
+}
+
+// ParseAll parses the tags and appends the versions to dst, returning the extended slice like append.
+// Passing the slice of a previous call truncated to zero length reuses its memory.
+// It stops at the first invalid tag and returns the versions parsed so far with the error.
+func (p *Parser) ParseAll(dst []SemVer, tags []string) ([]SemVer, error) {
+	for _, tag := range tags {
+		v, err := p.Parse(tag)
+		if err != nil {
+			return dst, err
+		}
+		dst = append(dst, v)
+	}
+	return dst, nil
+}
+
+// Release returns the parser to the pool of NewParser. The parser must not be used afterwards.
+func (p *Parser) Release() {
+	// Drop references into parsed strings, so the pool does not keep them alive
+	clear(p.scratch[:cap(p.scratch)])
+	p.scratch = p.scratch[:0]
+	parserPool.Put(p)
+}
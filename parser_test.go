@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParserParse(t *testing.T) {
+	tests := []string{
+		"1.2.3",
+		"1.0.0-alpha.1+build.5",
+		"0.0.0-0.3.7",
+		"1.0.0-x-y-z.--",
+		"1.2",
+		"1.2.3.4",
+		"01.2.3",
+		"1.2.3-01",
+		"1.2.3-a..b",
+		"1.2.3+b@d",
+		"",
+	}
+
+	p := NewParser()
+	defer p.Release()
+	for _, tag := range tests {
+		t.Run(tag, func(t *testing.T) {
+			expected, expectedErr := Parse(tag)
+			result, err := p.Parse(tag)
+			if result != expected || fmt.Sprint(err) != fmt.Sprint(expectedErr) {
+				t.Errorf("Parser.Parse() = %v, %v, want %v, %v", result, err, expected, expectedErr)
+			}
+		})
+	}
+}
+
+func TestParserParseAll(t *testing.T) {
+	var p Parser
+	versions, err := p.ParseAll(nil, []string{"1.0.0", "2.0.0-rc.1"})
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if len(versions) != 2 || versions[1].PreRelease != "rc.1" {
+		t.Errorf("ParseAll() = %v, want [1.0.0 2.0.0-rc.1]", versions)
+	}
+
+	versions, err = p.ParseAll(versions[:0], []string{"3.0.0", "latest", "4.0.0"})
+	if err == nil {
+		t.Errorf("Expected error but got none")
+	}
+	if len(versions) != 1 || versions[0].Major != 3 {
+		t.Errorf("ParseAll() = %v, want the versions before the invalid tag", versions)
+	}
+}
+
+func TestParserAllocations(t *testing.T) {
+	p := NewParser()
+	defer p.Release()
+	tags := []string{"1.2.3", "1.0.0-alpha.1+build.5", "10.20.30-rc.1"}
+	dst := make([]SemVer, 0, len(tags))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		var err error
+		if dst, err = p.ParseAll(dst[:0], tags); err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseAll() allocated %v times, want 0", allocs)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse("1.0.0-alpha.1+build.5")
+	}
+}
+
+func BenchmarkParser(b *testing.B) {
+	p := NewParser()
+	defer p.Release()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Parse("1.0.0-alpha.1+build.5")
+	}
+}
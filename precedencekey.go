@@ -0,0 +1,44 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PrecedenceKey returns a binary string whose byte-wise order is the precedence order of the versions:
+// for versions a and b, strings.Compare(a.PrecedenceKey(), b.PrecedenceKey()) equals ComparePrecedence(a, b).
+// Versions differing in build metadata only have the same key. Keys can be used in ordered indexes,
+// key-value stores and comparisons outside this package. Unlike Padded, keys have no width limit,
+// but they are not human-readable and cannot be parsed back.
+func (s SemVer) PrecedenceKey() string {
+	var b strings.Builder
+
+	// Numbers are prefixed with their length, so longer numbers sort higher
+	writeNumber := func(digits string) {
+		b.WriteByte(byte(len(digits)))
+		b.WriteString(digits)
+	}
+	writeNumber(strconv.FormatUint(uint64(s.Major), 10))
+	writeNumber(strconv.FormatUint(uint64(s.Minor), 10))
+	writeNumber(strconv.FormatUint(uint64(s.Patch), 10))
+
+	// A release sorts after its pre-releases
+	if s.PreRelease == "" {
+		b.WriteByte(2)
+		return b.String()
+	}
+	b.WriteByte(1)
+
+	// Numeric identifiers sort before alphanumeric ones, an identifier less sorts before an identifier more
+	for _, part := range strings.Split(s.PreRelease, ".") {
+		if isNumericIdentifier(part) {
+			b.WriteByte(1)
+			writeNumber(strings.TrimLeft(part, "0"))
+			continue
+		}
+		b.WriteByte(2)
+		b.WriteString(part)
+		b.WriteByte(0)
+	}
+	return b.String()
+}
@@ -0,0 +1,28 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrecedenceKey(t *testing.T) {
+	g := NewGenerator(5, WithMaxComponents(3, 12, 12), WithPreReleaseProbability(0.6), WithBuildProbability(0.3))
+	var versions []SemVer
+	for i := 0; i < 200; i++ {
+		versions = append(versions, g.Next())
+	}
+	for _, s := range []string{
+		"0.0.0", "1.0.0-0", "1.0.0-00a", "1.0.0-1", "1.0.0-10", "1.0.0-a", "1.0.0-a.0", "1.0.0-a-b", "1.0.0-ab",
+		"1.0.0-99999999999999999999", "1.0.0-18446744073709551615", "18446744073709551615.0.0", "1.0.0+build",
+	} {
+		versions = append(versions, mustParse(t, s))
+	}
+
+	for _, a := range versions {
+		for _, b := range versions {
+			if result, expected := strings.Compare(a.PrecedenceKey(), b.PrecedenceKey()), ComparePrecedence(a, b); result != expected {
+				t.Fatalf("key order of %v and %v = %v, want %v", a, b, result, expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PreReleasePattern numbers the pre-releases leading to a version, e.g. 1.4.0-rc.1, 1.4.0-rc.2, ...
+// for the pattern "rc.%d" and the base version 1.4.0.
+type PreReleasePattern struct {
+	base   SemVer
+	prefix string
+	suffix string
+}
+
+// NewPreReleasePattern returns the pattern of pre-releases of the base version, e.g. "rc.%d" or "beta.%d.internal".
+// The counter "%d" must be an identifier of its own, so the pre-releases sort by their counter.
+// The pre-release and build metadata of the base version are ignored.
+// It returns an error if the pattern does not have exactly one counter or does not form valid pre-releases.
+func NewPreReleasePattern(base SemVer, pattern string) (PreReleasePattern, error) {
+	prefix, suffix, found := strings.Cut(pattern, "%d")
+	if !found || strings.Contains(suffix, "%d") {
+		return PreReleasePattern{}, fmt.Errorf("invalid pre-release pattern: %s, expected exactly one %%d", pattern)
+	}
+
+	// The counter must be separated by dots, as "rc10" sorts before "rc2"
+	if prefix != "" && !strings.HasSuffix(prefix, ".") || suffix != "" && !strings.HasPrefix(suffix, ".") {
+		return PreReleasePattern{}, fmt.Errorf("invalid pre-release pattern: %s, %%d must be an identifier of its own", pattern)
+	}
+	for _, identifier := range strings.Split(prefix+"0"+suffix, ".") {
+		if err := ValidateIdentifier(identifier, true); err != nil {
+			return PreReleasePattern{}, fmt.Errorf("invalid pre-release pattern: %s: %w", pattern, err)
+		}
+	}
+
+	return PreReleasePattern{
+		base:   SemVer{Major: base.Major, Minor: base.Minor, Patch: base.Patch},
+		prefix: prefix,
+		suffix: suffix,
+	}, nil
+}
+
+// Next returns the pre-release following the highest existing pre-release of the base version
+// matching the pattern, or the first one numbered 1 if there is none.
+func (p PreReleasePattern) Next(existing []SemVer) SemVer {
+	var highest uint64
+	for _, v := range existing {
+		if n, ok := p.counter(v); ok {
+			highest = max(highest, n)
+		}
+	}
+	return p.Version(highest + 1)
+}
+
+// Version returns the pre-release of the base version with the given counter.
+func (p PreReleasePattern) Version(n uint64) SemVer {
+	v := p.base
+	v.PreRelease = p.prefix + strconv.FormatUint(n, 10) + p.suffix
+	return v
+}
+
+// counter returns the counter of a pre-release of the base version matching the pattern.
+func (p PreReleasePattern) counter(v SemVer) (uint64, bool) {
+	if v.Major != p.base.Major || v.Minor != p.base.Minor || v.Patch != p.base.Patch {
+		return 0, false
+	}
+	rest, ok := strings.CutPrefix(v.PreRelease, p.prefix)
+	if !ok {
+		return 0, false
+	}
+	digits, ok := strings.CutSuffix(rest, p.suffix)
+	if !ok || !isNumericIdentifier(digits) {
+		return 0, false
+	}
+	n, _ := strconv.ParseUint(digits, 10, 64)
+	return n, true
+}
+
+// String returns the pattern, e.g. "rc.%d".
+func (p PreReleasePattern) String() string {
+	return p.prefix + "%d" + p.suffix
+}
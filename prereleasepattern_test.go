@@ -0,0 +1,75 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestNewPreReleasePattern(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		expectError bool
+	}{
+		{pattern: "rc.%d"},
+		{pattern: "beta.%d.internal"},
+		{pattern: "%d"},
+		{pattern: "rc", expectError: true},
+		{pattern: "rc.%d.%d", expectError: true},
+		{pattern: "rc%d", expectError: true},
+		{pattern: "rc.%d-x", expectError: true},
+		{pattern: "r_c.%d", expectError: true},
+		{pattern: "rc..%d", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			p, err := NewPreReleasePattern(mustParse(t, "1.4.0"), tt.pattern)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := p.String(); result != tt.pattern {
+				t.Errorf("String() = %q, want %q", result, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestPreReleasePatternNext(t *testing.T) {
+	var existing []SemVer
+	for _, s := range []string{"1.3.0", "1.4.0-rc.1", "1.4.0-rc.10", "1.4.0-rc.2", "1.4.0-beta.3", "1.4.0-rc.11.hotfix",
+		"1.5.0-rc.20", "1.4.0-beta.4.internal", "1.4.0-rc.x"} {
+		existing = append(existing, mustParse(t, s))
+	}
+
+	tests := []struct {
+		name     string
+		base     string
+		pattern  string
+		existing []SemVer
+		expected string
+	}{
+		{name: "Highest counter", base: "1.4.0", pattern: "rc.%d", existing: existing, expected: "1.4.0-rc.11"},
+		{name: "Other identifier", base: "1.4.0", pattern: "beta.%d", existing: existing, expected: "1.4.0-beta.4"},
+		{name: "Suffix", base: "1.4.0", pattern: "beta.%d.internal", existing: existing, expected: "1.4.0-beta.5.internal"},
+		{name: "First pre-release", base: "1.6.0", pattern: "rc.%d", existing: existing, expected: "1.6.0-rc.1"},
+		{name: "No existing versions", base: "2.0.0", pattern: "alpha.%d", expected: "2.0.0-alpha.1"},
+		{name: "Base pre-release and build ignored", base: "1.5.0-rc.3+build", pattern: "rc.%d", existing: existing, expected: "1.5.0-rc.21"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewPreReleasePattern(mustParse(t, tt.base), tt.pattern)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := p.Next(tt.existing); result.String() != tt.expected {
+				t.Errorf("Next() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
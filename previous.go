@@ -0,0 +1,31 @@
+package semver
+
+// Previous returns the version to roll back to from current: the highest version of the list below current
+// by precedence. A pre-release is only a candidate if current is a pre-release of the same version core,
+// so 1.3.0 rolls back to 1.2.5 rather than to 1.3.0-rc.2, while 1.3.0-rc.2 rolls back to 1.3.0-rc.1.
+// Versions differing from current in build metadata only are skipped. The list may be in any order,
+// e.g. with maintenance releases of older series published after newer ones.
+// It returns false if there is no such version.
+func Previous(versions []SemVer, current SemVer) (SemVer, bool) {
+	return previous(versions, current, func(v SemVer) bool {
+		return v.IsRelease() || !current.IsRelease() && sameCore(v, current)
+	})
+}
+
+// PreviousStable returns the highest release of the list below current by precedence,
+// e.g. 1.2.5 for 1.3.0 or 1.3.0-rc.2. It returns false if there is no such release.
+func PreviousStable(versions []SemVer, current SemVer) (SemVer, bool) {
+	return previous(versions, current, SemVer.IsRelease)
+}
+
+// previous returns the highest version below current among the candidates.
+func previous(versions []SemVer, current SemVer, candidate func(SemVer) bool) (SemVer, bool) {
+	var result SemVer
+	found := false
+	for _, v := range versions {
+		if candidate(v) && ComparePrecedence(v, current) < 0 && (!found || ComparePrecedence(v, result) > 0) {
+			result, found = v, true
+		}
+	}
+	return result, found
+}
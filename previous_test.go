@@ -0,0 +1,43 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestPrevious(t *testing.T) {
+	// Published in this order, with a maintenance release of 1.2 after 1.3.0
+	var versions []SemVer
+	for _, s := range []string{"1.2.4", "1.3.0-rc.1", "1.3.0-rc.2", "1.2.6-rc.1", "1.3.0", "1.2.5", "1.3.1+build.1", "1.3.1+build.2", "1.4.0-beta.1"} {
+		versions = append(versions, mustParse(t, s))
+	}
+
+	tests := []struct {
+		name           string
+		current        string
+		expected       string
+		expectedStable string
+	}{
+		{name: "Release after pre-releases", current: "1.3.0", expected: "1.2.5", expectedStable: "1.2.5"},
+		{name: "Pre-release after pre-release", current: "1.3.0-rc.2", expected: "1.3.0-rc.1", expectedStable: "1.2.5"},
+		{name: "First pre-release", current: "1.3.0-rc.1", expected: "1.2.5", expectedStable: "1.2.5"},
+		{name: "Rebuild skipped", current: "1.3.1+build.2", expected: "1.3.0", expectedStable: "1.3.0"},
+		{name: "Pre-release of a newer series", current: "1.4.0-beta.1", expected: "1.3.1+build.1", expectedStable: "1.3.1+build.1"},
+		{name: "Version not in the list", current: "1.2.7", expected: "1.2.5", expectedStable: "1.2.5"},
+		{name: "Maintenance release", current: "1.2.5", expected: "1.2.4", expectedStable: "1.2.4"},
+		{name: "Oldest version", current: "1.2.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := mustParse(t, tt.current)
+			v, ok := Previous(versions, current)
+			if ok != (tt.expected != "") || ok && v.String() != tt.expected {
+				t.Errorf("Previous() = %v, %v, want %q", v, ok, tt.expected)
+			}
+			v, ok = PreviousStable(versions, current)
+			if ok != (tt.expectedStable != "") || ok && v.String() != tt.expectedStable {
+				t.Errorf("PreviousStable() = %v, %v, want %q", v, ok, tt.expectedStable)
+			}
+		})
+	}
+}
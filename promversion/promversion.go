@@ -0,0 +1,47 @@
+// Package promversion exposes a semantic version as a Prometheus info metric, e.g.
+//
+//	app_info{build="",major="1",minor="2",patch="3",prerelease="",version="1.2.3"} 1
+//
+// so fleet dashboards can break down deployments by version with queries like
+// "count by (minor) (app_info{major="1"})".
+package promversion
+
+import (
+	"strconv"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultNamespace is the namespace of the info metric if none is given.
+const DefaultNamespace = "app"
+
+// NewCollector returns a collector of the <namespace>_info gauge, which is always 1
+// and carries the version and its components as labels. An empty namespace means DefaultNamespace.
+func NewCollector(namespace string, v semver.SemVer) prometheus.Collector {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "info",
+		Help:      "Version information of the running application, always 1.",
+		ConstLabels: prometheus.Labels{
+			"version":    v.String(),
+			"major":      strconv.FormatUint(uint64(v.Major), 10),
+			"minor":      strconv.FormatUint(uint64(v.Minor), 10),
+			"patch":      strconv.FormatUint(uint64(v.Patch), 10),
+			"prerelease": v.PreRelease,
+			"build":      v.Build,
+		},
+	})
+	gauge.Set(1)
+	return gauge
+}
+
+// Register registers the info metric of the version with the registerer, usually prometheus.DefaultRegisterer.
+// It returns an error if a metric of the same name is already registered.
+func Register(reg prometheus.Registerer, namespace string, v semver.SemVer) error {
+	return reg.Register(NewCollector(namespace, v))
+}
@@ -0,0 +1,55 @@
+package promversion
+
+import (
+	"strings"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		version   semver.SemVer
+		expected  string
+	}{
+		{
+			name:     "Default namespace",
+			version:  semver.SemVer{Major: 1, Minor: 2, Patch: 3},
+			expected: `app_info{build="",major="1",minor="2",patch="3",prerelease="",version="1.2.3"} 1`,
+		},
+		{
+			name:      "Custom namespace",
+			namespace: "billing",
+			version:   semver.SemVer{Major: 2, PreRelease: "rc.1", Build: "abc"},
+			expected:  `billing_info{build="abc",major="2",minor="0",patch="0",prerelease="rc.1",version="2.0.0-rc.1+abc"} 1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := prometheus.NewPedanticRegistry()
+			if err := Register(reg, tt.namespace, tt.version); err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+
+			namespace := tt.namespace
+			if namespace == "" {
+				namespace = DefaultNamespace
+			}
+			expected := "# HELP " + namespace + "_info Version information of the running application, always 1.\n" +
+				"# TYPE " + namespace + "_info gauge\n" +
+				tt.expected + "\n"
+			if err := testutil.GatherAndCompare(reg, strings.NewReader(expected)); err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+
+			if err := Register(reg, tt.namespace, tt.version); err == nil {
+				t.Errorf("Expected error registering twice but got none")
+			}
+		})
+	}
+}
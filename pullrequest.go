@@ -0,0 +1,63 @@
+package semver
+
+import (
+	"strings"
+)
+
+// PullRequest is the metadata of a merged pull request used to determine the change it makes.
+type PullRequest struct {
+	Title  string
+	Body   string
+	Labels []string
+}
+
+// DefaultPullRequestLabels are the labels PullRequestResolver uses without labels of its own.
+var DefaultPullRequestLabels = map[string]ChangeType{
+	"semver:major": ChangeMajor,
+	"semver:minor": ChangeMinor,
+	"semver:patch": ChangePatch,
+	"semver:none":  ChangeNone,
+}
+
+// PullRequestResolver determines the change type of merged pull requests from their labels and titles,
+// for teams relying on pull request metadata instead of the messages of individual commits.
+// The zero value uses DefaultPullRequestLabels.
+type PullRequestResolver struct {
+	// Labels maps label names to change types, compared case-insensitively.
+	Labels map[string]ChangeType
+}
+
+// ChangeType returns the change type of a pull request. If the pull request has labels mapped to a change type,
+// the largest of them wins, so "semver:none" marks a pull request as not releasable regardless of its title.
+// Otherwise, the title and body are analyzed as a commit message following the Conventional Commits
+// specification, as squash merges use them, e.g. "feat(parser)!: drop v prefix (#42)".
+func (r PullRequestResolver) ChangeType(pr PullRequest) ChangeType {
+	labels := r.Labels
+	if labels == nil {
+		labels = DefaultPullRequestLabels
+	}
+
+	// Labels take precedence over the title
+	change, labeled := ChangeNone, false
+	for _, label := range pr.Labels {
+		for name, labelChange := range labels {
+			if strings.EqualFold(strings.TrimSpace(label), name) {
+				change, labeled = max(change, labelChange), true
+			}
+		}
+	}
+	if labeled {
+		return change
+	}
+
+	return AnalyzeCommits([]string{pr.Title + "\n\n" + pr.Body})
+}
+
+// Analyze returns the change type required by a list of merged pull requests, the largest change type among them.
+func (r PullRequestResolver) Analyze(prs []PullRequest) ChangeType {
+	change := ChangeNone
+	for _, pr := range prs {
+		change = max(change, r.ChangeType(pr))
+	}
+	return change
+}
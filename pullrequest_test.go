@@ -0,0 +1,55 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestPullRequestResolverChangeType(t *testing.T) {
+	custom := PullRequestResolver{Labels: map[string]ChangeType{
+		"breaking-change": ChangeMajor,
+		"enhancement":     ChangeMinor,
+		"bug":             ChangePatch,
+	}}
+
+	tests := []struct {
+		name     string
+		resolver PullRequestResolver
+		pr       PullRequest
+		expected ChangeType
+	}{
+		{name: "Major label", pr: PullRequest{Title: "Update parser", Labels: []string{"semver:major"}}, expected: ChangeMajor},
+		{name: "Label case ignored", pr: PullRequest{Title: "Update parser", Labels: []string{"SemVer:Minor"}}, expected: ChangeMinor},
+		{name: "Largest label wins", pr: PullRequest{Labels: []string{"semver:patch", "documentation", "semver:minor"}}, expected: ChangeMinor},
+		{name: "Label overrides title", pr: PullRequest{Title: "feat!: new API", Labels: []string{"semver:patch"}}, expected: ChangePatch},
+		{name: "None label overrides title", pr: PullRequest{Title: "feat: new API", Labels: []string{"semver:none"}}, expected: ChangeNone},
+		{name: "Squash merge title", pr: PullRequest{Title: "feat(parser): support v prefix (#42)", Labels: []string{"documentation"}}, expected: ChangeMinor},
+		{name: "Breaking title", pr: PullRequest{Title: "fix!: reject empty identifiers (#43)"}, expected: ChangeMajor},
+		{name: "Breaking change footer in body", pr: PullRequest{Title: "fix: reject empty identifiers", Body: "Details.\nBREAKING CHANGE: empty identifiers fail"}, expected: ChangeMajor},
+		{name: "Unconventional title", pr: PullRequest{Title: "Update README"}, expected: ChangeNone},
+		{name: "Custom labels", resolver: custom, pr: PullRequest{Title: "Fix crash", Labels: []string{"bug"}}, expected: ChangePatch},
+		{name: "Default labels unknown to custom resolver", resolver: custom, pr: PullRequest{Title: "Fix crash", Labels: []string{"semver:major"}}, expected: ChangeNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.resolver.ChangeType(tt.pr); result != tt.expected {
+				t.Errorf("ChangeType() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPullRequestResolverAnalyze(t *testing.T) {
+	var r PullRequestResolver
+	prs := []PullRequest{
+		{Title: "fix: crash (#1)"},
+		{Title: "feat: new option (#2)"},
+		{Title: "chore: update CI (#3)", Labels: []string{"semver:none"}},
+	}
+	if result := r.Analyze(prs); result != ChangeMinor {
+		t.Errorf("Analyze() = %v, want %v", result, ChangeMinor)
+	}
+	if result := r.Analyze(nil); result != ChangeNone {
+		t.Errorf("Analyze(nil) = %v, want %v", result, ChangeNone)
+	}
+}
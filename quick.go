@@ -0,0 +1,173 @@
+package semver
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Generate implements the testing/quick Generator interface, so SemVer values can be arguments of
+// property-based tests with quick.Check. The size bounds the version components and the number of identifiers.
+func (SemVer) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(RandomVersion(r, size))
+}
+
+// RandomVersion returns a random valid version. Components are mostly below size, with zero and large values
+// mixed in, and pre-release and build identifiers cover numeric, alphanumeric and hyphenated forms.
+func RandomVersion(r *rand.Rand, size int) SemVer {
+	v := SemVer{
+		Major: randomNumber(r, size),
+		Minor: randomNumber(r, size),
+		Patch: randomNumber(r, size),
+	}
+	if r.Intn(2) == 0 {
+		v.PreRelease = randomIdentifiers(r, size, true)
+	}
+	if r.Intn(3) == 0 {
+		v.Build = randomIdentifiers(r, size, false)
+	}
+	return v
+}
+
+// RandomConstraint returns a random constraint accepted by ParseConstraint, combining alternatives, comparators
+// with every operator, partial and wildcard versions, pre-releases and hyphen ranges.
+func RandomConstraint(r *rand.Rand, size int) string {
+	alternatives := make([]string, 1+r.Intn(3))
+	for i := range alternatives {
+		comparators := make([]string, 1+r.Intn(2))
+		for j := range comparators {
+			comparators[j] = randomComparator(r, size)
+		}
+		alternatives[i] = strings.Join(comparators, " ")
+	}
+	return strings.Join(alternatives, " || ")
+}
+
+// RandomNearValid returns a random string that resembles a valid version but is rejected by Parse,
+// e.g. with a leading zero, a missing component, an empty identifier or an invalid character.
+func RandomNearValid(r *rand.Rand, size int) string {
+	v := RandomVersion(r, size)
+	core := strconv.FormatUint(uint64(v.Major), 10) + "." + strconv.FormatUint(uint64(v.Minor), 10) + "." + strconv.FormatUint(uint64(v.Patch), 10)
+	suffix := strings.TrimPrefix(v.String(), core)
+
+	switch r.Intn(8) {
+	case 0:
+		// Leading zero in a component
+		parts := strings.Split(core, ".")
+		i := r.Intn(3)
+		parts[i] = "0" + strconv.Itoa(1+r.Intn(9)) + parts[i][1:]
+		return strings.Join(parts, ".") + suffix
+	case 1:
+		// Missing component
+		return core[:strings.LastIndex(core, ".")] + suffix
+	case 2:
+		// Extra component
+		return core + "." + strconv.Itoa(r.Intn(10)) + suffix
+	case 3:
+		// Empty pre-release identifier
+		return core + "-" + randomIdentifier(r, size, true) + ".."
+	case 4:
+		// Leading zero in a numeric pre-release identifier
+		return core + "-0" + strconv.Itoa(1+r.Intn(9))
+	case 5:
+		// Invalid character in an identifier
+		invalid := []string{"_", "!", " ", "é", "/", "~"}
+		return core + "-" + randomIdentifier(r, size, true) + invalid[r.Intn(len(invalid))] + "x"
+	case 6:
+		// Surrounding whitespace
+		return " " + v.String()
+	}
+	// Negative component
+	return "-" + v.String()
+}
+
+// randomNumber returns a number mostly below size, sometimes zero or large.
+func randomNumber(r *rand.Rand, size int) uint {
+	switch r.Intn(10) {
+	case 0:
+		return 0
+	case 1:
+		return uint(r.Int31())
+	}
+	return uint(r.Intn(max(size, 1) + 1))
+}
+
+// randomIdentifiers returns one to three dot separated identifiers.
+func randomIdentifiers(r *rand.Rand, size int, preRelease bool) string {
+	identifiers := make([]string, 1+r.Intn(3))
+	for i := range identifiers {
+		identifiers[i] = randomIdentifier(r, size, preRelease)
+	}
+	return strings.Join(identifiers, ".")
+}
+
+// identifierChars are the characters allowed in identifiers.
+const identifierChars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-"
+
+// randomIdentifier returns a numeric or alphanumeric identifier. Numeric pre-release identifiers have no leading zeros.
+func randomIdentifier(r *rand.Rand, size int, preRelease bool) string {
+	if r.Intn(2) == 0 {
+		n := strconv.FormatUint(uint64(randomNumber(r, size)), 10)
+		if !preRelease && r.Intn(4) == 0 {
+			// Build metadata allows leading zeros
+			n = "0" + n
+		}
+		return n
+	}
+
+	common := []string{"alpha", "beta", "rc", "dev", "SNAPSHOT", "x-y-z", "--"}
+	if r.Intn(2) == 0 {
+		return common[r.Intn(len(common))]
+	}
+	var b strings.Builder
+	for i := 0; i < 1+r.Intn(max(size, 1)%8+1); i++ {
+		b.WriteByte(identifierChars[r.Intn(len(identifierChars))])
+	}
+	// Make sure the identifier is not numeric, which could have leading zeros
+	b.WriteByte(identifierChars[10+r.Intn(len(identifierChars)-10)])
+	return b.String()
+}
+
+// randomComparator returns a comparator of a constraint: an operator with a version that may be partial,
+// or a hyphen range.
+func randomComparator(r *rand.Rand, size int) string {
+	if r.Intn(8) == 0 {
+		return randomPartial(r, size) + " - " + randomPartial(r, size)
+	}
+
+	operators := []string{"", "=", "!=", ">", ">=", "<", "<=", "~", "^"}
+	op := operators[r.Intn(len(operators))]
+	switch op {
+	case "!=":
+		// Only full versions can be excluded
+		return op + randomCore(r, size)
+	case ">", "<":
+		// Wildcards only work with inclusive operators
+		if r.Intn(2) == 0 {
+			return op + strconv.FormatUint(uint64(randomNumber(r, size)), 10)
+		}
+		return op + randomCore(r, size)
+	}
+	return op + randomPartial(r, size)
+}
+
+// randomPartial returns a full version, possibly with pre-release, or a partial version with wildcards.
+func randomPartial(r *rand.Rand, size int) string {
+	switch r.Intn(6) {
+	case 0:
+		return "*"
+	case 1:
+		return strconv.FormatUint(uint64(randomNumber(r, size)), 10)
+	case 2:
+		return strconv.FormatUint(uint64(randomNumber(r, size)), 10) + "." + strconv.FormatUint(uint64(randomNumber(r, size)), 10) + ".x"
+	case 3:
+		return randomCore(r, size) + "-" + randomIdentifiers(r, size, true)
+	}
+	return randomCore(r, size)
+}
+
+// randomCore returns a random major.minor.patch version.
+func randomCore(r *rand.Rand, size int) string {
+	return SemVer{Major: randomNumber(r, size), Minor: randomNumber(r, size), Patch: randomNumber(r, size)}.String()
+}
@@ -0,0 +1,45 @@
+package semver
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestGenerateRoundTrip(t *testing.T) {
+	if err := quick.Check(roundTrips, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRandomGenerators(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		size := i % 50
+
+		if v := RandomVersion(r, size); !roundTrips(v) {
+			t.Errorf("RandomVersion() = %q, which does not round-trip through Parse", v)
+		}
+		if c := RandomConstraint(r, size); !parsesConstraint(c) {
+			t.Errorf("RandomConstraint() = %q, which ParseConstraint rejects", c)
+		}
+		if s := RandomNearValid(r, size); !rejected(s) {
+			t.Errorf("RandomNearValid() = %q, which Parse accepts", s)
+		}
+	}
+}
+
+func roundTrips(v SemVer) bool {
+	parsed, err := Parse(v.String())
+	return err == nil && parsed == v
+}
+
+func parsesConstraint(s string) bool {
+	_, err := ParseConstraint(s)
+	return err == nil
+}
+
+func rejected(s string) bool {
+	_, err := Parse(s)
+	return err != nil
+}
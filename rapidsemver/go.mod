@@ -0,0 +1,8 @@
+module github.com/mkyc/go-semver/rapidsemver
+
+go 1.25.0
+
+require (
+	github.com/mkyc/go-semver v0.1.0
+	pgregory.net/rapid v1.2.0
+)
@@ -0,0 +1,62 @@
+// Package rapidsemver provides generators of versions, constraints and near-valid strings for
+// property-based tests with pgregory.net/rapid.
+package rapidsemver
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+	"pgregory.net/rapid"
+)
+
+// Version returns a generator of valid versions, shrinking towards 0.0.0 without pre-release and build metadata.
+func Version() *rapid.Generator[semver.SemVer] {
+	number := rapid.UintRange(0, math.MaxInt32)
+	preRelease := identifiers(`0|[1-9][0-9]{0,8}|[0-9A-Za-z-]{0,8}[A-Za-z-][0-9A-Za-z-]{0,8}`)
+	build := identifiers(`[0-9A-Za-z-]{1,12}`)
+
+	return rapid.Custom(func(t *rapid.T) semver.SemVer {
+		v := semver.SemVer{
+			Major: number.Draw(t, "major"),
+			Minor: number.Draw(t, "minor"),
+			Patch: number.Draw(t, "patch"),
+		}
+		if rapid.Bool().Draw(t, "hasPreRelease") {
+			v.PreRelease = preRelease.Draw(t, "preRelease")
+		}
+		if rapid.Bool().Draw(t, "hasBuild") {
+			v.Build = build.Draw(t, "build")
+		}
+		return v
+	})
+}
+
+// Constraint returns a generator of constraints accepted by semver.ParseConstraint, see semver.RandomConstraint.
+// The constraints are derived from a drawn seed, so they shrink less well than versions.
+func Constraint() *rapid.Generator[string] {
+	return seeded(semver.RandomConstraint)
+}
+
+// NearValid returns a generator of strings resembling versions that semver.Parse rejects, see semver.RandomNearValid.
+// The strings are derived from a drawn seed, so they shrink less well than versions.
+func NearValid() *rapid.Generator[string] {
+	return seeded(semver.RandomNearValid)
+}
+
+// identifiers returns a generator of one to three dot separated identifiers matching the expression.
+func identifiers(expr string) *rapid.Generator[string] {
+	return rapid.Map(rapid.SliceOfN(rapid.StringMatching(expr), 1, 3), func(ids []string) string {
+		return strings.Join(ids, ".")
+	})
+}
+
+// seeded returns a generator calling a math/rand based generator with a drawn seed and size.
+func seeded(generate func(r *rand.Rand, size int) string) *rapid.Generator[string] {
+	return rapid.Custom(func(t *rapid.T) string {
+		seed := rapid.Int64().Draw(t, "seed")
+		size := rapid.IntRange(0, 100).Draw(t, "size")
+		return generate(rand.New(rand.NewSource(seed)), size)
+	})
+}
@@ -0,0 +1,39 @@
+package rapidsemver
+
+import (
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+	"pgregory.net/rapid"
+)
+
+func TestVersion(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		v := Version().Draw(t, "v")
+		parsed, err := semver.Parse(v.String())
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", v, err)
+		}
+		if parsed != v {
+			t.Fatalf("Parse(%q) = %#v, want %#v", v, parsed, v)
+		}
+	})
+}
+
+func TestConstraint(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		c := Constraint().Draw(t, "c")
+		if _, err := semver.ParseConstraint(c); err != nil {
+			t.Fatalf("ParseConstraint(%q) failed: %v", c, err)
+		}
+	})
+}
+
+func TestNearValid(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		s := NearValid().Draw(t, "s")
+		if _, err := semver.Parse(s); err == nil {
+			t.Fatalf("Parse(%q) succeeded, want error", s)
+		}
+	})
+}
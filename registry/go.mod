@@ -0,0 +1,10 @@
+module github.com/mkyc/go-semver/registry
+
+go 1.25.0
+
+require (
+	github.com/mkyc/go-semver v0.1.0
+	go.etcd.io/bbolt v1.4.3
+)
+
+require golang.org/x/sys v0.29.0 // indirect
@@ -0,0 +1,230 @@
+// Package registry persists the versions published for artifacts, with their metadata,
+// in an embedded bbolt database, as the storage layer of a self-hosted artifact index.
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+	bolt "go.etcd.io/bbolt"
+)
+
+// artifactsBucket holds a nested bucket per artifact, mapping version strings to JSON encoded metadata.
+var artifactsBucket = []byte("artifacts")
+
+// Entry is a published version of an artifact with its metadata.
+type Entry struct {
+	Version   semver.SemVer
+	Published time.Time
+	Channel   string
+	Yanked    bool
+}
+
+// metadata is the JSON representation of the metadata of an entry.
+type metadata struct {
+	Published time.Time `json:"published"`
+	Channel   string    `json:"channel,omitempty"`
+	Yanked    bool      `json:"yanked,omitempty"`
+}
+
+// Store is a registry of published versions persisted in a file. It is safe for concurrent use,
+// but the file can only be opened by one process at a time.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens the registry stored in the file, creating it if needed.
+// It returns an error if the file cannot be opened or is locked by another process for longer than a second.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open registry: %w", err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(artifactsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("open registry: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the registry file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Publish adds a version of the artifact.
+// It returns an error if a version with the same precedence, e.g. differing in build metadata only, is already published.
+func (s *Store) Publish(artifact string, e Entry) error {
+	if artifact == "" {
+		return fmt.Errorf("publish: empty artifact name")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(artifactsBucket).CreateBucketIfNotExists([]byte(artifact))
+		if err != nil {
+			return fmt.Errorf("publish %s@%s: %w", artifact, e.Version, err)
+		}
+
+		// Reject versions colliding with published ones
+		entries, err := readEntries(b)
+		if err != nil {
+			return err
+		}
+		for _, existing := range entries {
+			if semver.ComparePrecedence(existing.Version, e.Version) == 0 {
+				return fmt.Errorf("publish %s@%s: version %s already published", artifact, e.Version, existing.Version)
+			}
+		}
+
+		return writeEntry(b, e)
+	})
+}
+
+// Yank marks a published version as yanked, or reverts that if yanked is false.
+// Yanked versions are listed but not returned by Query and Latest.
+// It returns an error if the version is not published.
+func (s *Store) Yank(artifact string, v semver.SemVer, yanked bool) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		e, b, err := getEntry(tx, artifact, v)
+		if err != nil {
+			return err
+		}
+		e.Yanked = yanked
+		return writeEntry(b, e)
+	})
+}
+
+// Get returns the published version of the artifact. It returns an error if the version is not published.
+func (s *Store) Get(artifact string, v semver.SemVer) (Entry, error) {
+	var e Entry
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		e, _, err = getEntry(tx, artifact, v)
+		return err
+	})
+	return e, err
+}
+
+// Artifacts returns the names of all artifacts with published versions, sorted.
+func (s *Store) Artifacts() ([]string, error) {
+	var names []string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(artifactsBucket).ForEachBucket(func(name []byte) error {
+			names = append(names, string(name))
+			return nil
+		})
+	})
+	return names, err
+}
+
+// List returns all published versions of the artifact, including yanked ones, sorted by precedence.
+// It returns no versions for an unknown artifact.
+func (s *Store) List(artifact string) ([]Entry, error) {
+	var entries []Entry
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(artifactsBucket).Bucket([]byte(artifact))
+		if b == nil {
+			return nil
+		}
+		var err error
+		entries, err = readEntries(b)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return semver.ComparePrecedence(entries[i].Version, entries[j].Version) < 0
+	})
+	return entries, nil
+}
+
+// Query returns the versions of the artifact satisfying the constraint that are not yanked, sorted by precedence.
+func (s *Store) Query(artifact string, c semver.Constraint) ([]Entry, error) {
+	entries, err := s.List(artifact)
+	if err != nil {
+		return nil, err
+	}
+	var result []Entry
+	for _, e := range entries {
+		if !e.Yanked && c.Allows(e.Version) {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// Latest returns the highest version of the artifact in the channel that is not yanked, or false if there is none.
+// An empty channel selects the highest release version of any channel.
+func (s *Store) Latest(artifact, channel string) (Entry, bool, error) {
+	entries, err := s.List(artifact)
+	if err != nil {
+		return Entry{}, false, err
+	}
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if e.Yanked {
+			continue
+		}
+		if channel == "" && e.Version.IsRelease() || channel != "" && e.Channel == channel {
+			return e, true, nil
+		}
+	}
+	return Entry{}, false, nil
+}
+
+// getEntry returns the entry of a published version and the bucket of its artifact.
+func getEntry(tx *bolt.Tx, artifact string, v semver.SemVer) (Entry, *bolt.Bucket, error) {
+	b := tx.Bucket(artifactsBucket).Bucket([]byte(artifact))
+	if b == nil {
+		return Entry{}, nil, fmt.Errorf("unknown artifact %s", artifact)
+	}
+	data := b.Get([]byte(v.String()))
+	if data == nil {
+		return Entry{}, nil, fmt.Errorf("version %s of %s not published", v, artifact)
+	}
+	e, err := decodeEntry(v.String(), data)
+	return e, b, err
+}
+
+// readEntries returns all entries of an artifact bucket, in key order.
+func readEntries(b *bolt.Bucket) ([]Entry, error) {
+	var entries []Entry
+	err := b.ForEach(func(k, data []byte) error {
+		e, err := decodeEntry(string(k), data)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, e)
+		return nil
+	})
+	return entries, err
+}
+
+// decodeEntry decodes the entry stored under the version key.
+func decodeEntry(key string, data []byte) (Entry, error) {
+	v, err := semver.Parse(key)
+	if err != nil {
+		return Entry{}, fmt.Errorf("corrupt registry: %w", err)
+	}
+	var m metadata
+	if err := json.Unmarshal(data, &m); err != nil {
+		return Entry{}, fmt.Errorf("corrupt registry: version %s: %v", key, err)
+	}
+	return Entry{Version: v, Published: m.Published, Channel: m.Channel, Yanked: m.Yanked}, nil
+}
+
+// writeEntry stores the entry in an artifact bucket.
+func writeEntry(b *bolt.Bucket, e Entry) error {
+	data, err := json.Marshal(metadata{Published: e.Published, Channel: e.Channel, Yanked: e.Yanked})
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(e.Version.String()), data)
+}
@@ -0,0 +1,161 @@
+package registry
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func mustParse(t *testing.T, s string) semver.SemVer {
+	t.Helper()
+	v, err := semver.Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed: %v", s, err)
+	}
+	return v
+}
+
+// openStore opens a registry with published versions of "app" and "lib".
+func openStore(t *testing.T) (*Store, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "registry.db")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() failed: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	published := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, e := range []struct{ artifact, version, channel string }{
+		{"app", "1.0.0", "stable"},
+		{"app", "1.1.0", "stable"},
+		{"app", "1.10.0", "stable"},
+		{"app", "2.0.0-beta.1", "beta"},
+		{"app", "1.2.0+build.7", "stable"},
+		{"lib", "0.1.0", ""},
+	} {
+		entry := Entry{Version: mustParse(t, e.version), Published: published.AddDate(0, 0, i), Channel: e.channel}
+		if err := s.Publish(e.artifact, entry); err != nil {
+			t.Fatalf("Publish() failed: %v", err)
+		}
+	}
+	return s, path
+}
+
+func versions(entries []Entry) []string {
+	var result []string
+	for _, e := range entries {
+		result = append(result, e.Version.String())
+	}
+	return result
+}
+
+func TestStoreListAndQuery(t *testing.T) {
+	s, _ := openStore(t)
+
+	entries, err := s.List("app")
+	if err != nil {
+		t.Fatalf("List() failed: %v", err)
+	}
+	expected := []string{"1.0.0", "1.1.0", "1.2.0+build.7", "1.10.0", "2.0.0-beta.1"}
+	if result := versions(entries); !reflect.DeepEqual(result, expected) {
+		t.Errorf("List() = %v, want %v", result, expected)
+	}
+
+	if err := s.Yank("app", mustParse(t, "1.1.0"), true); err != nil {
+		t.Fatalf("Yank() failed: %v", err)
+	}
+	entries, err = s.Query("app", semver.MustParseConstraint("^1.0.0"))
+	if err != nil {
+		t.Fatalf("Query() failed: %v", err)
+	}
+	expected = []string{"1.0.0", "1.2.0+build.7", "1.10.0"}
+	if result := versions(entries); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Query() = %v, want %v", result, expected)
+	}
+
+	if artifacts, err := s.Artifacts(); err != nil || !reflect.DeepEqual(artifacts, []string{"app", "lib"}) {
+		t.Errorf("Artifacts() = %v, %v, want [app lib]", artifacts, err)
+	}
+	if entries, err := s.List("unknown"); err != nil || len(entries) != 0 {
+		t.Errorf("List() of unknown artifact = %v, %v, want none", entries, err)
+	}
+}
+
+func TestStoreLatest(t *testing.T) {
+	s, _ := openStore(t)
+	if err := s.Yank("app", mustParse(t, "1.10.0"), true); err != nil {
+		t.Fatalf("Yank() failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		artifact string
+		channel  string
+		expected string
+	}{
+		{name: "Any channel skips pre-releases and yanked versions", artifact: "app", expected: "1.2.0+build.7"},
+		{name: "Channel", artifact: "app", channel: "beta", expected: "2.0.0-beta.1"},
+		{name: "Unknown channel", artifact: "app", channel: "nightly"},
+		{name: "Unknown artifact", artifact: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok, err := s.Latest(tt.artifact, tt.channel)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if ok != (tt.expected != "") || ok && e.Version.String() != tt.expected {
+				t.Errorf("Latest() = %v, %v, want %q", e.Version, ok, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStorePublishCollision(t *testing.T) {
+	s, _ := openStore(t)
+	for _, v := range []string{"1.0.0", "1.2.0", "1.0.0+other"} {
+		if err := s.Publish("app", Entry{Version: mustParse(t, v)}); err == nil {
+			t.Errorf("Publish(%v) expected error but got none", v)
+		}
+	}
+	if err := s.Publish("", Entry{Version: mustParse(t, "1.0.0")}); err == nil {
+		t.Errorf("Publish() with empty artifact expected error but got none")
+	}
+}
+
+func TestStorePersistence(t *testing.T) {
+	s, path := openStore(t)
+	if err := s.Yank("lib", mustParse(t, "0.1.0"), true); err != nil {
+		t.Fatalf("Yank() failed: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() failed: %v", err)
+	}
+	defer reopened.Close()
+
+	e, err := reopened.Get("lib", mustParse(t, "0.1.0"))
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	expected := Entry{Version: mustParse(t, "0.1.0"), Published: time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC), Yanked: true}
+	if !reflect.DeepEqual(e, expected) {
+		t.Errorf("Get() = %+v, want %+v", e, expected)
+	}
+
+	if _, err := reopened.Get("lib", mustParse(t, "0.2.0")); err == nil {
+		t.Errorf("Get() of unpublished version expected error but got none")
+	}
+	if err := reopened.Yank("unknown", mustParse(t, "0.1.0"), true); err == nil {
+		t.Errorf("Yank() of unknown artifact expected error but got none")
+	}
+}
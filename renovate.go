@@ -0,0 +1,30 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseRenovateRange normalizes a range as found in Renovate configs and PR metadata,
+// e.g. the allowedVersions or matchCurrentVersion options or a currentValue like "^1.2.3",
+// into a Constraint.
+//
+// Renovate uses npm range semantics, which ParseConstraint implements including hyphen ranges
+// and "x" wildcards. A leading "v" on versions and a "==" operator are accepted.
+// Regular expression ranges like "/^1\./" have no Constraint equivalent.
+//
+// It returns an error for regular expression ranges and malformed constraints.
+func ParseRenovateRange(s string) (Constraint, error) {
+	trimmed := strings.TrimSpace(s)
+
+	// Regular expressions are enclosed in slashes, optionally negated
+	if strings.HasPrefix(strings.TrimPrefix(trimmed, "!"), "/") {
+		return Constraint{}, fmt.Errorf("invalid renovate range: %q, regular expressions are not supported", s)
+	}
+
+	c, err := ParseConstraint(trimmed)
+	if err != nil {
+		return Constraint{}, fmt.Errorf("invalid renovate range: %w", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,87 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseRenovateRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		allowed     []string
+		rejected    []string
+		expectError bool
+	}{
+		{
+			name:     "Caret current value",
+			input:    "^1.2.3",
+			allowed:  []string{"1.2.3", "1.9.0"},
+			rejected: []string{"2.0.0", "1.2.2"},
+		},
+		{
+			name:     "Allowed versions below major",
+			input:    "<2.0.0",
+			allowed:  []string{"1.9.9"},
+			rejected: []string{"2.0.0", "2.0.0-rc.1"},
+		},
+		{
+			name:     "Wildcard",
+			input:    " 1.x ",
+			allowed:  []string{"1.0.0", "1.99.1"},
+			rejected: []string{"2.0.0"},
+		},
+		{
+			name:     "Hyphen range with prefix",
+			input:    "v1.2 - v1.4",
+			allowed:  []string{"1.4.9"},
+			rejected: []string{"1.5.0"},
+		},
+		{
+			name:     "Double equals",
+			input:    "==1.2.3",
+			allowed:  []string{"1.2.3"},
+			rejected: []string{"1.2.4"},
+		},
+		{
+			name:        "Regular expression",
+			input:       "/^1\\./",
+			expectError: true,
+		},
+		{
+			name:        "Negated regular expression",
+			input:       "!/beta/",
+			expectError: true,
+		},
+		{
+			name:        "Malformed range",
+			input:       ">>1",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseRenovateRange(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			for _, tag := range tt.allowed {
+				if !c.Allows(mustParse(t, tag)) {
+					t.Errorf("Allows(%s) = false, want true", tag)
+				}
+			}
+			for _, tag := range tt.rejected {
+				if c.Allows(mustParse(t, tag)) {
+					t.Errorf("Allows(%s) = true, want false", tag)
+				}
+			}
+		})
+	}
+}
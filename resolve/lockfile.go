@@ -0,0 +1,265 @@
+package resolve
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+	"github.com/mkyc/go-semver/internal/atomicfile"
+)
+
+// LockfileVersion is the version of the lockfile format written by Save.
+const LockfileVersion = 1
+
+// Pin is the version a package is locked to, together with the requirements that selected it.
+type Pin struct {
+	Version      semver.SemVer
+	Requirements []Requirement
+}
+
+// Lockfile maps package names to pinned versions, so that a resolution can be reproduced and audited.
+type Lockfile struct {
+	Packages map[string]Pin
+}
+
+// lockfileJSON is the JSON representation of a Lockfile, e.g.
+//
+//	{
+//	  "lockfileVersion": 1,
+//	  "packages": {
+//	    "b": {
+//	      "version": "1.2.0",
+//	      "requirements": [{"constraint": "^1.0.0"}, {"constraint": ">=1.1.0", "by": "a@1.1.0"}]
+//	    }
+//	  }
+//	}
+//
+// Requirements without "by" are root requirements.
+type lockfileJSON struct {
+	LockfileVersion int                `json:"lockfileVersion"`
+	Packages        map[string]pinJSON `json:"packages"`
+}
+
+type pinJSON struct {
+	Version      string            `json:"version"`
+	Requirements []requirementJSON `json:"requirements,omitempty"`
+}
+
+type requirementJSON struct {
+	Constraint string `json:"constraint"`
+	By         string `json:"by,omitempty"`
+}
+
+// NewLockfile solves the root dependencies and pins every selected package,
+// recording the requirements of the root and of the selected versions on it.
+// It returns the errors of Solve.
+func NewLockfile(root []Dependency, source Source) (*Lockfile, error) {
+	list, err := Solve(root, source)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Lockfile{Packages: map[string]Pin{}}
+	for _, m := range list {
+		l.Packages[m.Name] = Pin{Version: m.Version}
+	}
+
+	// Record the requirements of the root and the selected versions, in this order
+	add := func(by Module, deps []Dependency) {
+		for _, dep := range deps {
+			pin := l.Packages[dep.Name]
+			pin.Requirements = append(pin.Requirements, Requirement{By: by, Constraint: dep.Constraint})
+			l.Packages[dep.Name] = pin
+		}
+	}
+	add(Module{}, root)
+	for _, m := range list {
+		deps, err := source.Dependencies(m)
+		if err != nil {
+			return nil, err
+		}
+		add(m, deps)
+	}
+	return l, nil
+}
+
+// LoadLockfile reads a lockfile written by Save.
+// It returns an error if the file cannot be read, is not valid JSON, has an unsupported format version
+// or contains an invalid version or constraint.
+func LoadLockfile(path string) (*Lockfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read lockfile: %w", err)
+	}
+
+	var raw lockfileJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid lockfile %s: %v", path, err)
+	}
+	if raw.LockfileVersion != LockfileVersion {
+		return nil, fmt.Errorf("invalid lockfile %s: unsupported lockfile version %d", path, raw.LockfileVersion)
+	}
+
+	l := &Lockfile{Packages: make(map[string]Pin, len(raw.Packages))}
+	for name, p := range raw.Packages {
+		v, err := semver.Parse(p.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid lockfile %s: package %s: %v", path, name, err)
+		}
+		pin := Pin{Version: v}
+		for _, r := range p.Requirements {
+			c, err := semver.ParseConstraint(r.Constraint)
+			if err != nil {
+				return nil, fmt.Errorf("invalid lockfile %s: package %s: %v", path, name, err)
+			}
+			by, err := parseModule(r.By)
+			if err != nil {
+				return nil, fmt.Errorf("invalid lockfile %s: package %s: %v", path, name, err)
+			}
+			pin.Requirements = append(pin.Requirements, Requirement{By: by, Constraint: c})
+		}
+		l.Packages[name] = pin
+	}
+	return l, nil
+}
+
+// parseModule parses a module written as name@version, returning the zero Module for an empty string.
+func parseModule(s string) (Module, error) {
+	if s == "" {
+		return Module{}, nil
+	}
+	at := strings.LastIndex(s, "@")
+	if at <= 0 {
+		return Module{}, fmt.Errorf("invalid module: %s is not name@version", s)
+	}
+	v, err := semver.Parse(s[at+1:])
+	if err != nil {
+		return Module{}, err
+	}
+	return Module{Name: s[:at], Version: v}, nil
+}
+
+// MarshalJSON encodes the lockfile in the format read by LoadLockfile, with packages sorted by name.
+func (l *Lockfile) MarshalJSON() ([]byte, error) {
+	raw := lockfileJSON{LockfileVersion: LockfileVersion, Packages: make(map[string]pinJSON, len(l.Packages))}
+	for name, pin := range l.Packages {
+		p := pinJSON{Version: pin.Version.String()}
+		for _, r := range pin.Requirements {
+			rj := requirementJSON{Constraint: r.Constraint.String()}
+			if r.By.Name != "" {
+				rj.By = r.By.String()
+			}
+			p.Requirements = append(p.Requirements, rj)
+		}
+		raw.Packages[name] = p
+	}
+
+	// Constraints are written as is, without escaping < and >
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(raw); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// Save atomically writes the lockfile to the path, so readers never observe a partially written file.
+func (l *Lockfile) Save(path string) error {
+	data, err := l.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("write lockfile: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := atomicfile.WriteFile(path, data); err != nil {
+		return fmt.Errorf("write lockfile: %w", err)
+	}
+	return nil
+}
+
+// Modules returns the pinned packages sorted by name.
+func (l *Lockfile) Modules() []Module {
+	list := make([]Module, 0, len(l.Packages))
+	for name, pin := range l.Packages {
+		list = append(list, Module{Name: name, Version: pin.Version})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// Verify checks that every root dependency is pinned to a version it allows
+// and that every pin satisfies the requirements recorded for it.
+// It returns an error describing every violation, or nil if the lockfile is consistent.
+func (l *Lockfile) Verify(root []Dependency) error {
+	var errs []error
+	for _, dep := range root {
+		pin, ok := l.Packages[dep.Name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s is not locked", dep.Name))
+			continue
+		}
+		if !dep.Constraint.Allows(pin.Version) {
+			errs = append(errs, fmt.Errorf("%s@%s does not satisfy %s required by root", dep.Name, pin.Version, dep.Constraint))
+		}
+	}
+
+	for _, m := range l.Modules() {
+		for _, r := range l.Packages[m.Name].Requirements {
+			if !r.Constraint.Allows(m.Version) {
+				errs = append(errs, fmt.Errorf("%s does not satisfy %s", m, r))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Update resolves the root dependencies again and replaces the pins with the result.
+// The named packages are moved to the highest versions allowed, all packages if no names are given.
+// Other packages keep their pinned versions, unless the requirements no longer allow them,
+// in which case all packages are resolved from scratch.
+// It returns the errors of Solve, leaving the lockfile unchanged.
+func (l *Lockfile) Update(root []Dependency, source Source, names ...string) error {
+	pins := map[string]semver.SemVer{}
+	if len(names) > 0 {
+		for name, pin := range l.Packages {
+			pins[name] = pin.Version
+		}
+		for _, name := range names {
+			delete(pins, name)
+		}
+	}
+
+	updated, err := NewLockfile(root, lockedSource{source, pins})
+	var conflict *ConflictError
+	if errors.As(err, &conflict) && len(pins) > 0 {
+		// The pins are incompatible with the new requirements, resolve from scratch
+		updated, err = NewLockfile(root, source)
+	}
+	if err != nil {
+		return err
+	}
+	l.Packages = updated.Packages
+	return nil
+}
+
+// lockedSource is a Source offering only the pinned version of pinned packages.
+type lockedSource struct {
+	Source
+	pins map[string]semver.SemVer
+}
+
+func (s lockedSource) Versions(name string) ([]semver.SemVer, error) {
+	if v, ok := s.pins[name]; ok {
+		return []semver.SemVer{v}, nil
+	}
+	return s.Source.Versions(name)
+}
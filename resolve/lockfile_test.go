@@ -0,0 +1,162 @@
+package resolve
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLockfileSaveLoad(t *testing.T) {
+	r := registry(t, "a@1.0.0: b ^1.0.0", "a@1.1.0: b >=1.1.0", "b@1.0.0:", "b@1.2.0:")
+	root := deps(t, "a ^1.0.0, b ^1.0.0")
+
+	l, err := NewLockfile(root, r)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "versions.lock")
+	if err := l.Save(path); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{
+  "lockfileVersion": 1,
+  "packages": {
+    "a": {
+      "version": "1.1.0",
+      "requirements": [
+        {
+          "constraint": "^1.0.0"
+        }
+      ]
+    },
+    "b": {
+      "version": "1.2.0",
+      "requirements": [
+        {
+          "constraint": "^1.0.0"
+        },
+        {
+          "constraint": ">=1.1.0",
+          "by": "a@1.1.0"
+        }
+      ]
+    }
+  }
+}
+`
+	if string(data) != expected {
+		t.Errorf("Save() wrote %s, want %s", data, expected)
+	}
+
+	loaded, err := LoadLockfile(path)
+	if err != nil {
+		t.Fatalf("LoadLockfile() failed: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Modules(), l.Modules()) {
+		t.Errorf("LoadLockfile().Modules() = %v, want %v", loaded.Modules(), l.Modules())
+	}
+	if err := loaded.Verify(root); err != nil {
+		t.Errorf("Verify() = %v, want nil", err)
+	}
+}
+
+func TestLoadLockfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectError bool
+	}{
+		{name: "Valid", content: `{"lockfileVersion": 1, "packages": {"a": {"version": "1.0.0", "requirements": [{"constraint": "^1", "by": "@scope/b@2.0.0"}]}}}`},
+		{name: "Empty", content: `{"lockfileVersion": 1}`},
+		{name: "Invalid JSON", content: `{`, expectError: true},
+		{name: "Unsupported format version", content: `{"lockfileVersion": 2}`, expectError: true},
+		{name: "Invalid version", content: `{"lockfileVersion": 1, "packages": {"a": {"version": "1.0"}}}`, expectError: true},
+		{name: "Invalid constraint", content: `{"lockfileVersion": 1, "packages": {"a": {"version": "1.0.0", "requirements": [{"constraint": ">>1"}]}}}`, expectError: true},
+		{name: "Invalid requiring module", content: `{"lockfileVersion": 1, "packages": {"a": {"version": "1.0.0", "requirements": [{"constraint": "^1", "by": "b"}]}}}`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "versions.lock")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadLockfile(path)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestLockfileVerify(t *testing.T) {
+	l := &Lockfile{Packages: map[string]Pin{
+		"a": {Version: m(t, "a@1.0.0").Version},
+		"b": {Version: m(t, "b@2.0.0").Version, Requirements: []Requirement{
+			{By: m(t, "a@1.0.0"), Constraint: deps(t, "b ^1.0.0")[0].Constraint},
+		}},
+	}}
+
+	err := l.Verify(deps(t, "a ^2.0.0, c *"))
+	if err == nil {
+		t.Fatalf("Expected error but got none")
+	}
+	for _, expected := range []string{
+		"a@1.0.0 does not satisfy ^2.0.0 required by root",
+		"c is not locked",
+		"b@2.0.0 does not satisfy ^1.0.0 required by a@1.0.0",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Verify() = %v, want it to contain %q", err, expected)
+		}
+	}
+}
+
+func TestLockfileUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		root     string
+		names    []string
+		expected []string
+	}{
+		{name: "Update one package", root: "a *, b *", names: []string{"b"}, expected: []string{"a@1.0.0", "b@1.1.0"}},
+		{name: "Update all packages", root: "a *, b *", names: nil, expected: []string{"a@2.0.0", "b@1.1.0"}},
+		{name: "Pins conflicting with new requirements", root: "a ^2.0.0, b *", names: []string{"b"}, expected: []string{"a@2.0.0", "b@1.1.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The lockfile was created before a@2.0.0 and b@1.1.0 were released
+			old := registry(t, "a@1.0.0:", "b@1.0.0:")
+			l, err := NewLockfile(deps(t, "a *, b *"), old)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+
+			r := registry(t, "a@1.0.0:", "a@2.0.0:", "b@1.0.0:", "b@1.1.0:")
+			if err := l.Update(deps(t, tt.root), r, tt.names...); err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			var result []string
+			for _, mod := range l.Modules() {
+				result = append(result, mod.String())
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Update() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Package resolve selects versions of dependencies: with Minimal Version Selection as used by Go modules,
+// with a backtracking solver for constraint-based ecosystems, and persists the results in lockfiles.
+package resolve
+
+import (
+	"fmt"
+	"sort"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Module is a version of a named package, a node of the dependency graph.
+type Module struct {
+	Name    string
+	Version semver.SemVer
+}
+
+// String returns the module as name@version.
+func (m Module) String() string {
+	return m.Name + "@" + m.Version.String()
+}
+
+// Reqs provides the requirements of modules, i.e. the minimum versions of their direct dependencies.
+type Reqs interface {
+	Required(m Module) ([]Module, error)
+}
+
+// Graph is a Reqs backed by a map from each module to its requirements.
+type Graph map[Module][]Module
+
+// Required returns the requirements of the module.
+// It returns an error if the module is not in the graph.
+func (g Graph) Required(m Module) ([]Module, error) {
+	required, ok := g[m]
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", m)
+	}
+	return required, nil
+}
+
+// BuildList computes the build list of the target with Minimal Version Selection, like the go command:
+// every module reachable from the target is selected at the highest of the minimum versions required
+// anywhere in the graph. Modules only required by versions that are not selected are kept, as the go
+// command keeps every module of the requirement graph.
+// The target comes first, followed by the selected modules sorted by name.
+// Versions are ordered by semver.ComparePrecedence.
+//
+// It returns an error if the requirements of a reachable module are not available.
+func BuildList(target Module, reqs Reqs) ([]Module, error) {
+	// Walk the whole graph and select the highest required version of each module
+	selected := map[string]semver.SemVer{target.Name: target.Version}
+	visited := map[Module]bool{}
+	queue := []Module{target}
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+		if visited[m] {
+			continue
+		}
+		visited[m] = true
+
+		required, err := reqs.Required(m)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range required {
+			if current, ok := selected[r.Name]; !ok || semver.ComparePrecedence(r.Version, current) > 0 {
+				selected[r.Name] = r.Version
+			}
+			queue = append(queue, r)
+		}
+	}
+
+	// The target's own version is not subject to selection
+	list := []Module{target}
+	for name, version := range selected {
+		if name != target.Name {
+			list = append(list, Module{Name: name, Version: version})
+		}
+	}
+
+	sort.Slice(list[1:], func(i, j int) bool {
+		return list[i+1].Name < list[j+1].Name
+	})
+	return list, nil
+}
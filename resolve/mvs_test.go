@@ -0,0 +1,116 @@
+package resolve
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// m returns the module for "name@version".
+func m(t *testing.T, s string) Module {
+	t.Helper()
+	name, version, _ := strings.Cut(s, "@")
+	v, err := semver.Parse(version)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed: %v", version, err)
+	}
+	return Module{Name: name, Version: v}
+}
+
+// graph builds a Graph from lines like "a@1.0.0: b@1.2.0 c@1.0.0".
+func graph(t *testing.T, lines ...string) Graph {
+	t.Helper()
+	g := Graph{}
+	for _, line := range lines {
+		node, deps, _ := strings.Cut(line, ":")
+		var required []Module
+		for _, dep := range strings.Fields(deps) {
+			required = append(required, m(t, dep))
+		}
+		g[m(t, strings.TrimSpace(node))] = required
+	}
+	return g
+}
+
+func TestBuildList(t *testing.T) {
+	// The example of https://research.swtch.com/vgo-mvs
+	g := graph(t,
+		"a@1.0.0: b@1.2.0 c@1.2.0",
+		"b@1.1.0: d@1.1.0",
+		"b@1.2.0: d@1.3.0",
+		"c@1.1.0:",
+		"c@1.2.0: d@1.4.0",
+		"c@1.3.0: f@1.1.0",
+		"d@1.1.0: e@1.1.0",
+		"d@1.2.0: e@1.1.0",
+		"d@1.3.0: e@1.2.0",
+		"d@1.4.0: e@1.2.0",
+		"e@1.1.0:",
+		"e@1.2.0:",
+		"e@1.3.0:",
+		"f@1.1.0: g@1.1.0",
+		"g@1.1.0: f@1.1.0",
+	)
+
+	list, err := BuildList(m(t, "a@1.0.0"), g)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	var result []string
+	for _, mod := range list {
+		result = append(result, mod.String())
+	}
+	expected := []string{"a@1.0.0", "b@1.2.0", "c@1.2.0", "d@1.4.0", "e@1.2.0"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("BuildList() = %v, want %v", result, expected)
+	}
+}
+
+func TestBuildListKeepsUnselectedRequirements(t *testing.T) {
+	// x@1.0.0 is only required by the older b@1.0.0, which is not selected, but stays in the graph
+	g := graph(t,
+		"a@1.0.0: b@1.0.0 c@1.0.0",
+		"b@1.0.0: x@1.0.0",
+		"b@2.0.0-rc.1:",
+		"c@1.0.0: b@2.0.0-rc.1",
+		"x@1.0.0:",
+	)
+
+	list, err := BuildList(m(t, "a@1.0.0"), g)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	expected := []Module{m(t, "a@1.0.0"), m(t, "b@2.0.0-rc.1"), m(t, "c@1.0.0"), m(t, "x@1.0.0")}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("BuildList() = %v, want %v", list, expected)
+	}
+}
+
+func TestBuildListCycleToTarget(t *testing.T) {
+	// A dependency requiring a newer version of the target does not change the target,
+	// but the requirements of the newer version stay in the graph
+	g := graph(t,
+		"a@1.0.0: b@1.0.0",
+		"b@1.0.0: a@1.1.0",
+		"a@1.1.0: c@1.0.0",
+		"c@1.0.0:",
+	)
+
+	list, err := BuildList(m(t, "a@1.0.0"), g)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	expected := []Module{m(t, "a@1.0.0"), m(t, "b@1.0.0"), m(t, "c@1.0.0")}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("BuildList() = %v, want %v", list, expected)
+	}
+}
+
+func TestBuildListMissingModule(t *testing.T) {
+	g := graph(t, "a@1.0.0: b@1.0.0")
+	if _, err := BuildList(m(t, "a@1.0.0"), g); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
@@ -0,0 +1,232 @@
+package resolve
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Dependency is a requirement on any version of a package satisfying a constraint.
+type Dependency struct {
+	Name       string
+	Constraint semver.Constraint
+}
+
+// Source provides the available versions of packages and the dependencies of each version.
+type Source interface {
+	Versions(name string) ([]semver.SemVer, error)
+	Dependencies(m Module) ([]Dependency, error)
+}
+
+// Registry is a Source backed by a map from each available package version to its dependencies.
+type Registry map[Module][]Dependency
+
+// Versions returns the available versions of the package, in no particular order.
+func (r Registry) Versions(name string) ([]semver.SemVer, error) {
+	var versions []semver.SemVer
+	for m := range r {
+		if m.Name == name {
+			versions = append(versions, m.Version)
+		}
+	}
+	return versions, nil
+}
+
+// Dependencies returns the dependencies of the package version.
+// It returns an error if the version is not in the registry.
+func (r Registry) Dependencies(m Module) ([]Dependency, error) {
+	deps, ok := r[m]
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", m)
+	}
+	return deps, nil
+}
+
+// Requirement is a constraint on a package together with the module that imposes it.
+// By is the zero Module for the root requirements given to Solve.
+type Requirement struct {
+	By         Module
+	Constraint semver.Constraint
+}
+
+// String returns the requirement as "<constraint> required by <module>".
+func (r Requirement) String() string {
+	by := "root"
+	if r.By.Name != "" {
+		by = r.By.String()
+	}
+	return r.Constraint.String() + " required by " + by
+}
+
+// ConflictError is returned by Solve when no assignment of versions satisfies all constraints.
+// It describes the first conflict the search ran into: the package and the requirements on it
+// that no available version satisfies together.
+type ConflictError struct {
+	Name         string
+	Requirements []Requirement
+}
+
+func (e *ConflictError) Error() string {
+	requirements := make([]string, len(e.Requirements))
+	for i, r := range e.Requirements {
+		requirements[i] = r.String()
+	}
+	return fmt.Sprintf("cannot select a version of %s: %s", e.Name, strings.Join(requirements, ", "))
+}
+
+// Solve selects one version of each package reachable from the root dependencies so that every constraint
+// of the root and of the selected versions is satisfied. Unlike BuildList, constraints may have upper bounds,
+// so the search prefers the highest allowed version of each package and backtracks to lower versions
+// on conflicts. The selected modules are returned sorted by name.
+//
+// It returns a *ConflictError if there is no solution, and the error of the source if it fails.
+// The search is exhaustive, so it may take exponential time on large graphs without a solution.
+func Solve(root []Dependency, source Source) ([]Module, error) {
+	s := &solver{source: source, versions: map[string][]semver.SemVer{}}
+
+	pending := make([]pendingRequirement, len(root))
+	for i, dep := range root {
+		pending[i] = pendingRequirement{name: dep.Name, Requirement: Requirement{Constraint: dep.Constraint}}
+	}
+
+	selected, ok, err := s.solve(map[string]semver.SemVer{}, pending)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, s.conflict
+	}
+
+	list := make([]Module, 0, len(selected))
+	for name, v := range selected {
+		list = append(list, Module{Name: name, Version: v})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+// pendingRequirement is a requirement on the named package.
+type pendingRequirement struct {
+	name string
+	Requirement
+}
+
+// solver holds the state shared by the steps of the search.
+type solver struct {
+	source   Source
+	versions map[string][]semver.SemVer
+
+	// conflict is the first conflict found, reported if the search fails
+	conflict *ConflictError
+}
+
+// solve selects a version for the first package required but not selected yet and continues with the
+// requirements of that version, trying lower versions when the rest of the search fails.
+// It returns false if no selection satisfies the requirements.
+func (s *solver) solve(selected map[string]semver.SemVer, requirements []pendingRequirement) (map[string]semver.SemVer, bool, error) {
+	// Find the next package to select
+	name := ""
+	for _, r := range requirements {
+		if _, ok := selected[r.name]; !ok {
+			name = r.name
+			break
+		}
+	}
+	if name == "" {
+		return selected, true, nil
+	}
+
+	candidates, err := s.candidates(name, requirements)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(candidates) == 0 {
+		s.recordConflict(name, requirements)
+		return nil, false, nil
+	}
+
+	for _, v := range candidates {
+		m := Module{Name: name, Version: v}
+		deps, err := s.source.Dependencies(m)
+		if err != nil {
+			return nil, false, err
+		}
+
+		next := make(map[string]semver.SemVer, len(selected)+1)
+		for n, sv := range selected {
+			next[n] = sv
+		}
+		next[name] = v
+
+		extended := make([]pendingRequirement, len(requirements), len(requirements)+len(deps))
+		copy(extended, requirements)
+		consistent := true
+		for _, dep := range deps {
+			extended = append(extended, pendingRequirement{name: dep.Name, Requirement: Requirement{By: m, Constraint: dep.Constraint}})
+
+			// Dependencies on packages already selected must be satisfied by the selected version
+			if sv, ok := next[dep.Name]; ok && !dep.Constraint.Allows(sv) {
+				s.recordConflict(dep.Name, extended)
+				consistent = false
+				break
+			}
+		}
+		if !consistent {
+			continue
+		}
+
+		result, ok, err := s.solve(next, extended)
+		if err != nil || ok {
+			return result, ok, err
+		}
+	}
+	return nil, false, nil
+}
+
+// candidates returns the available versions of the package allowed by all requirements on it, highest first.
+func (s *solver) candidates(name string, requirements []pendingRequirement) ([]semver.SemVer, error) {
+	versions, ok := s.versions[name]
+	if !ok {
+		var err error
+		if versions, err = s.source.Versions(name); err != nil {
+			return nil, err
+		}
+		versions = append([]semver.SemVer(nil), versions...)
+		sort.Slice(versions, func(i, j int) bool {
+			return semver.ComparePrecedence(versions[i], versions[j]) > 0
+		})
+		s.versions[name] = versions
+	}
+
+	var candidates []semver.SemVer
+	for _, v := range versions {
+		allowed := true
+		for _, r := range requirements {
+			if r.name == name && !r.Constraint.Allows(v) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			candidates = append(candidates, v)
+		}
+	}
+	return candidates, nil
+}
+
+// recordConflict keeps the requirements on the package as the reported conflict, unless one was found before.
+func (s *solver) recordConflict(name string, requirements []pendingRequirement) {
+	if s.conflict != nil {
+		return
+	}
+	s.conflict = &ConflictError{Name: name}
+	for _, r := range requirements {
+		if r.name == name {
+			s.conflict.Requirements = append(s.conflict.Requirements, r.Requirement)
+		}
+	}
+}
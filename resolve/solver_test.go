@@ -0,0 +1,157 @@
+package resolve
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// deps parses dependencies like "b ^1.2.0, c >=1.0.0 <2.0.0".
+func deps(t *testing.T, s string) []Dependency {
+	t.Helper()
+	var result []Dependency
+	for _, dep := range strings.Split(s, ",") {
+		if dep = strings.TrimSpace(dep); dep == "" {
+			continue
+		}
+		name, constraint, _ := strings.Cut(dep, " ")
+		c, err := semver.ParseConstraint(constraint)
+		if err != nil {
+			t.Fatalf("ParseConstraint(%q) failed: %v", constraint, err)
+		}
+		result = append(result, Dependency{Name: name, Constraint: c})
+	}
+	return result
+}
+
+// registry builds a Registry from lines like "a@1.0.0: b ^1.2.0, c ^1.0.0".
+func registry(t *testing.T, lines ...string) Registry {
+	t.Helper()
+	r := Registry{}
+	for _, line := range lines {
+		node, d, _ := strings.Cut(line, ":")
+		r[m(t, strings.TrimSpace(node))] = deps(t, d)
+	}
+	return r
+}
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		name        string
+		registry    []string
+		root        string
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:     "Highest versions",
+			registry: []string{"a@1.0.0: b ^1.0.0", "a@1.1.0: b ^1.1.0", "b@1.0.0:", "b@1.1.0:", "b@1.2.0:", "b@2.0.0:"},
+			root:     "a ^1.0.0",
+			expected: []string{"a@1.1.0", "b@1.2.0"},
+		},
+		{
+			name: "Backtracking",
+			registry: []string{
+				"a@1.0.0: c ^1.0.0",
+				"a@2.0.0: c ^2.0.0",
+				"b@1.0.0: c ^1.0.0",
+				"c@1.0.0:",
+				"c@2.0.0:",
+			},
+			root:     "a *, b *",
+			expected: []string{"a@1.0.0", "b@1.0.0", "c@1.0.0"},
+		},
+		{
+			name: "Backtracking on a selected package",
+			registry: []string{
+				"a@1.0.0: b ^1.0.0",
+				"b@1.0.0:",
+				"b@1.1.0: c <1.1.0",
+				"c@1.0.0:",
+				"c@1.1.0:",
+			},
+			root:     "c *, a *",
+			expected: []string{"a@1.0.0", "b@1.0.0", "c@1.1.0"},
+		},
+		{
+			name:     "Pre-releases only when named",
+			registry: []string{"a@1.0.0:", "a@1.1.0-rc.1:", "b@1.0.0: a >=1.1.0-rc.1"},
+			root:     "b *",
+			expected: []string{"a@1.1.0-rc.1", "b@1.0.0"},
+		},
+		{
+			name:     "Cycle",
+			registry: []string{"a@1.0.0: b ^1.0.0", "b@1.0.0: a ^1.0.0"},
+			root:     "a *",
+			expected: []string{"a@1.0.0", "b@1.0.0"},
+		},
+		{
+			name:        "Conflict",
+			registry:    []string{"a@1.0.0: c ^1.0.0", "b@1.0.0: c ^2.0.0", "c@1.0.0:", "c@2.0.0:"},
+			root:        "a *, b *",
+			expectError: true,
+		},
+		{
+			name:        "Unknown package",
+			registry:    []string{"a@1.0.0: b ^1.0.0"},
+			root:        "a *",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := Solve(deps(t, tt.root), registry(t, tt.registry...))
+			if tt.expectError {
+				var conflict *ConflictError
+				if !errors.As(err, &conflict) {
+					t.Errorf("Solve() error = %v, want a *ConflictError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			var result []string
+			for _, mod := range list {
+				result = append(result, mod.String())
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Solve() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConflictError(t *testing.T) {
+	r := registry(t, "a@1.0.0: c ^1.0.0", "b@1.0.0: c ^2.0.0", "c@1.0.0:", "c@2.0.0:")
+	_, err := Solve(deps(t, "a *, b *, c >=1.0.0"), r)
+	if err == nil {
+		t.Fatalf("Expected error but got none")
+	}
+	expected := "cannot select a version of c: >=1.0.0 required by root, ^1.0.0 required by a@1.0.0, ^2.0.0 required by b@1.0.0"
+	if err.Error() != expected {
+		t.Errorf("Error() = %q, want %q", err.Error(), expected)
+	}
+}
+
+// failingSource is a Source whose dependencies cannot be fetched.
+type failingSource struct {
+	Registry
+}
+
+func (failingSource) Dependencies(Module) ([]Dependency, error) {
+	return nil, errors.New("registry unavailable")
+}
+
+func TestSolveSourceError(t *testing.T) {
+	source := failingSource{registry(t, "a@1.0.0:")}
+	_, err := Solve(deps(t, "a *"), source)
+	if err == nil || err.Error() != "registry unavailable" {
+		t.Errorf("Solve() error = %v, want registry unavailable", err)
+	}
+}
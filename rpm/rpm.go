@@ -0,0 +1,287 @@
+// Package rpm implements parsing and comparison of RPM epoch-version-release (EVR) strings
+// following rpmvercmp semantics, together with conversion helpers to and from semver.SemVer.
+package rpm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// EVR represents an RPM epoch-version-release triple as found in package headers and
+// dependency expressions, e.g. "2:1.4.0~rc1-3.el9".
+type EVR struct {
+	Epoch   uint
+	Version string
+	Release string
+}
+
+// String returns the string representation of the EVR. The epoch is only emitted when it is non-zero
+// and the release only when it is present.
+func (e EVR) String() string {
+	result := e.Version
+
+	// Add epoch prefix if present
+	if e.Epoch != 0 {
+		result = strconv.FormatUint(uint64(e.Epoch), 10) + ":" + result
+	}
+
+	// Add release suffix if present
+	if e.Release != "" {
+		result += "-" + e.Release
+	}
+
+	return result
+}
+
+// Parse parses a string of the form [epoch:]version[-release] into an EVR struct.
+// It returns an error if the epoch is not numeric or the version is empty.
+func Parse(s string) (EVR, error) {
+	var evr EVR
+
+	rest := s
+
+	// Split off the optional epoch
+	if epochPart, versionPart, found := strings.Cut(rest, ":"); found {
+		epoch, err := strconv.ParseUint(epochPart, 10, 0)
+		if err != nil {
+			return EVR{}, fmt.Errorf("invalid epoch: %s", epochPart)
+		}
+		evr.Epoch = uint(epoch)
+		rest = versionPart
+	}
+
+	// Split off the optional release, which follows the last hyphen
+	if i := strings.LastIndex(rest, "-"); i >= 0 {
+		evr.Release = rest[i+1:]
+		rest = rest[:i]
+		if evr.Release == "" {
+			return EVR{}, fmt.Errorf("invalid release: empty release in %s", s)
+		}
+	}
+
+	if rest == "" {
+		return EVR{}, fmt.Errorf("invalid version: empty version in %s", s)
+	}
+	if strings.ContainsAny(rest, ":-") {
+		return EVR{}, fmt.Errorf("invalid version: %s, contains invalid character", rest)
+	}
+	evr.Version = rest
+
+	return evr, nil
+}
+
+// Compare compares this EVR with another according to RPM precedence rules.
+// Epochs are compared numerically, versions and releases with Vercmp.
+// The release is only compared when both sides carry one, mirroring how RPM matches
+// dependency expressions without a release against any release.
+// It returns -1, 0 or 1 like SemVer.Compare.
+func (e EVR) Compare(other EVR) int {
+	// Compare epoch
+	if e.Epoch < other.Epoch {
+		return -1
+	}
+	if e.Epoch > other.Epoch {
+		return 1
+	}
+
+	// Compare version
+	if result := Vercmp(e.Version, other.Version); result != 0 {
+		return result
+	}
+
+	// Compare release only if both are present
+	if e.Release == "" || other.Release == "" {
+		return 0
+	}
+	return Vercmp(e.Release, other.Release)
+}
+
+// Vercmp compares two version or release strings using the rpmvercmp algorithm.
+// Strings are split into alternating runs of digits and letters, separators are ignored,
+// "~" sorts before everything (including the end of the string) and "^" sorts after the end
+// of the string but before any other segment.
+// It returns -1, 0 or 1.
+func Vercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	one, two := a, b
+	for one != "" || two != "" {
+		// Skip separators, everything that is neither alphanumeric nor tilde or caret
+		one = strings.TrimLeftFunc(one, isSeparator)
+		two = strings.TrimLeftFunc(two, isSeparator)
+
+		// Handle the tilde separator, it sorts before everything else
+		if strings.HasPrefix(one, "~") || strings.HasPrefix(two, "~") {
+			if !strings.HasPrefix(one, "~") {
+				return 1
+			}
+			if !strings.HasPrefix(two, "~") {
+				return -1
+			}
+			one, two = one[1:], two[1:]
+			continue
+		}
+
+		// Handle the caret separator, it sorts after the end of the string but before anything else
+		if strings.HasPrefix(one, "^") || strings.HasPrefix(two, "^") {
+			if one == "" {
+				return -1
+			}
+			if two == "" {
+				return 1
+			}
+			if !strings.HasPrefix(one, "^") {
+				return 1
+			}
+			if !strings.HasPrefix(two, "^") {
+				return -1
+			}
+			one, two = one[1:], two[1:]
+			continue
+		}
+
+		// If we ran to the end of either, we are finished with the loop
+		if one == "" || two == "" {
+			break
+		}
+
+		// Grab the first completely alpha or completely numeric segment of both strings
+		isNum := isDigit(rune(one[0]))
+		var segOne, segTwo string
+		if isNum {
+			segOne, one = splitRun(one, isDigit)
+			segTwo, two = splitRun(two, isDigit)
+		} else {
+			segOne, one = splitRun(one, isAlpha)
+			segTwo, two = splitRun(two, isAlpha)
+		}
+
+		// Segments of different types: numeric segments are newer than alpha ones
+		if segTwo == "" {
+			if isNum {
+				return 1
+			}
+			return -1
+		}
+
+		if isNum {
+			// Compare numerically by stripping leading zeros and comparing lengths first
+			segOne = strings.TrimLeft(segOne, "0")
+			segTwo = strings.TrimLeft(segTwo, "0")
+			if len(segOne) > len(segTwo) {
+				return 1
+			}
+			if len(segOne) < len(segTwo) {
+				return -1
+			}
+		}
+
+		// Compare segments lexically, this also works for numbers of equal length
+		if result := strings.Compare(segOne, segTwo); result != 0 {
+			return result
+		}
+	}
+
+	// Whichever string has characters left over wins
+	if one == "" && two == "" {
+		return 0
+	}
+	if one == "" {
+		return -1
+	}
+	return 1
+}
+
+// FromSemVer converts a SemVer into an EVR with the given release.
+// The pre-release is appended with a tilde so that it sorts before the release version,
+// hyphens inside the pre-release are replaced by underscores because RPM reserves the hyphen
+// as the release separator. Build metadata is dropped.
+func FromSemVer(v semver.SemVer, release string) EVR {
+	version := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		version += "~" + strings.ReplaceAll(v.PreRelease, "-", "_")
+	}
+
+	return EVR{
+		Version: version,
+		Release: release,
+	}
+}
+
+// ToSemVer converts the EVR into a SemVer.
+// The numeric part of the version is padded with zeros to major.minor.patch, a tilde suffix
+// becomes the pre-release and the release is carried as build metadata.
+// It returns an error if the epoch is non-zero, as it cannot be represented in semver,
+// or if the version does not consist of at most three numeric components.
+func (e EVR) ToSemVer() (semver.SemVer, error) {
+	var v semver.SemVer
+
+	if e.Epoch != 0 {
+		return semver.SemVer{}, fmt.Errorf("cannot convert %s: epoch %d has no semver equivalent", e, e.Epoch)
+	}
+
+	// Split off the tilde pre-release
+	versionCore, preRelease, _ := strings.Cut(e.Version, "~")
+	if preRelease != "" {
+		v.PreRelease = strings.ReplaceAll(strings.ReplaceAll(preRelease, "_", "-"), "~", ".")
+	}
+
+	// Parse version core, allowing omitted minor and patch
+	versionParts := strings.Split(versionCore, ".")
+	if len(versionParts) > 3 {
+		return semver.SemVer{}, fmt.Errorf("cannot convert %s: more than three version components", e)
+	}
+	numbers := make([]uint, 3)
+	for i, part := range versionParts {
+		n, err := strconv.ParseUint(part, 10, 0)
+		if err != nil {
+			return semver.SemVer{}, fmt.Errorf("cannot convert %s: non-numeric version component %s", e, part)
+		}
+		numbers[i] = uint(n)
+	}
+	v.Major, v.Minor, v.Patch = numbers[0], numbers[1], numbers[2]
+
+	// Carry the release as build metadata
+	if e.Release != "" {
+		v.Build = sanitizeIdentifiers(e.Release)
+	}
+
+	// Validate the result against the semver grammar
+	return semver.Parse(v.String())
+}
+
+// sanitizeIdentifiers replaces every character that is not allowed in semver identifiers with a hyphen.
+func sanitizeIdentifiers(s string) string {
+	return strings.Map(func(c rune) rune {
+		if c == '.' || isDigit(c) || isAlpha(c) || c == '-' {
+			return c
+		}
+		return '-'
+	}, s)
+}
+
+// splitRun splits s after the longest prefix consisting of characters matching f.
+func splitRun(s string, f func(rune) bool) (string, string) {
+	i := strings.IndexFunc(s, func(c rune) bool { return !f(c) })
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], s[i:]
+}
+
+func isSeparator(c rune) bool {
+	return !isDigit(c) && !isAlpha(c) && c != '~' && c != '^'
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,227 @@
+package rpm
+
+import (
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    EVR
+		expectError bool
+	}{
+		{
+			name:     "Version only",
+			input:    "1.2.3",
+			expected: EVR{Version: "1.2.3"},
+		},
+		{
+			name:     "Version and release",
+			input:    "1.2.3-4.el9",
+			expected: EVR{Version: "1.2.3", Release: "4.el9"},
+		},
+		{
+			name:     "Epoch, version and release",
+			input:    "2:1.2.3~rc1-4.el9",
+			expected: EVR{Epoch: 2, Version: "1.2.3~rc1", Release: "4.el9"},
+		},
+		{
+			name:        "Invalid epoch",
+			input:       "x:1.2.3",
+			expectError: true,
+		},
+		{
+			name:        "Empty version",
+			input:       "1:-4",
+			expectError: true,
+		},
+		{
+			name:        "Empty release",
+			input:       "1.2.3-",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evr, err := Parse(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if evr != tt.expected {
+				t.Errorf("Parse() = %+v, want %+v", evr, tt.expected)
+			}
+			if evr.String() != tt.input {
+				t.Errorf("String() = %v, want %v", evr.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestVercmp(t *testing.T) {
+	// Cases taken from the rpm test suite (rpmvercmp.at)
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "2.0", -1},
+		{"2.0", "1.0", 1},
+		{"2.0.1", "2.0.1", 0},
+		{"2.0", "2.0.1", -1},
+		{"2.0.1a", "2.0.1", 1},
+		{"5.5p1", "5.5p2", -1},
+		{"5.5p10", "5.5p1", 1},
+		{"10xyz", "10.1xyz", -1},
+		{"xyz10", "xyz10.1", -1},
+		{"xyz.4", "8", -1},
+		{"8", "xyz.4", 1},
+		{"5.5p1", "5.5.p1", 0},
+		{"10b2", "10a1", 1},
+		{"1.0aa", "1.0a", 1},
+		{"6.0.rc1", "6.0", 1},
+		{"10.0001", "10.1", 0},
+		{"10.0001", "10.0039", -1},
+		{"4.999.9", "5.0", -1},
+		{"20101121", "20101122", -1},
+		{"a+", "a_", 0},
+		{"+", "_", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0~rc1", "1.0~rc2", -1},
+		{"1.0~rc1~git123", "1.0~rc1", -1},
+		{"1.0^", "1.0", 1},
+		{"1.0^git1", "1.0^git2", -1},
+		{"1.0^git1", "1.01", -1},
+		{"1.0^20160101", "1.0.1", -1},
+		{"1.0~rc1^git1", "1.0~rc1", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			if result := Vercmp(tt.a, tt.b); result != tt.expected {
+				t.Errorf("Vercmp(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+			if result := Vercmp(tt.b, tt.a); result != -tt.expected {
+				t.Errorf("Vercmp(%q, %q) = %v, want %v", tt.b, tt.a, result, -tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     EVR
+		expected int
+	}{
+		{
+			name:     "Epoch wins over version",
+			a:        EVR{Epoch: 1, Version: "1.0"},
+			b:        EVR{Version: "2.0"},
+			expected: 1,
+		},
+		{
+			name:     "Release compared when both present",
+			a:        EVR{Version: "1.0", Release: "1.el9"},
+			b:        EVR{Version: "1.0", Release: "2.el9"},
+			expected: -1,
+		},
+		{
+			name:     "Release ignored when one is missing",
+			a:        EVR{Version: "1.0"},
+			b:        EVR{Version: "1.0", Release: "2.el9"},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.a.Compare(tt.b); result != tt.expected {
+				t.Errorf("Compare() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSemVerConversion(t *testing.T) {
+	tests := []struct {
+		name        string
+		evr         EVR
+		expected    semver.SemVer
+		expectError bool
+	}{
+		{
+			name:     "Full version",
+			evr:      EVR{Version: "1.2.3"},
+			expected: semver.SemVer{Major: 1, Minor: 2, Patch: 3},
+		},
+		{
+			name:     "Short version with release",
+			evr:      EVR{Version: "1.2", Release: "4.el9_2"},
+			expected: semver.SemVer{Major: 1, Minor: 2, Build: "4.el9-2"},
+		},
+		{
+			name:     "Tilde pre-release",
+			evr:      EVR{Version: "1.2.3~rc_1"},
+			expected: semver.SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc-1"},
+		},
+		{
+			name:        "Non-zero epoch",
+			evr:         EVR{Epoch: 1, Version: "1.2.3"},
+			expectError: true,
+		},
+		{
+			name:        "Too many components",
+			evr:         EVR{Version: "1.2.3.4"},
+			expectError: true,
+		},
+		{
+			name:        "Non-numeric component",
+			evr:         EVR{Version: "1.2a"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := tt.evr.ToSemVer()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if v != tt.expected {
+				t.Errorf("ToSemVer() = %v, want %v", v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromSemVer(t *testing.T) {
+	v := semver.SemVer{Major: 1, Minor: 4, Patch: 0, PreRelease: "rc-1.2", Build: "abc"}
+	evr := FromSemVer(v, "1.el9")
+	if evr.String() != "1.4.0~rc_1.2-1.el9" {
+		t.Errorf("FromSemVer() = %v, want %v", evr, "1.4.0~rc_1.2-1.el9")
+	}
+
+	// Pre-releases must sort before the release in both worlds
+	release := FromSemVer(semver.SemVer{Major: 1, Minor: 4, Patch: 0}, "1.el9")
+	if evr.Compare(release) != -1 {
+		t.Errorf("Compare() = %v, want %v", evr.Compare(release), -1)
+	}
+}
@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseRubyGemsConstraint parses a requirement in RubyGems syntax as used in Gemfiles and gemspecs,
+// e.g. "~> 1.4" or "~> 1.4, >= 1.4.2", into a Constraint.
+//
+// Requirements are separated by commas and all of them must match, they may be quoted as in
+// `"~> 1.4", ">= 1.4.2"`. Supported operators are =, !=, >, >=, <, <= and the pessimistic ~>, which allows
+// only the right-most given segment to increase: "~> 1.4" is >=1.4.0 <2.0.0 and "~> 1.4.2" is >=1.4.2 <1.5.0.
+// Missing segments are zero, so "> 1.2" matches 1.2.1. RubyGems pre-releases like "1.0.0.rc1" or "1.0.0.pre.2",
+// where the segments from the first one starting with a letter are the pre-release, map to 1.0.0-rc1 and 1.0.0-pre.2.
+// Pre-releases are matched as by ParseConstraint. Versions with more than three numeric segments are not supported.
+//
+// It returns an error if the requirement is empty or malformed.
+func ParseRubyGemsConstraint(s string) (Constraint, error) {
+	c := Constraint{raw: s}
+
+	var comparators []comparator
+	for _, part := range strings.Split(s, ",") {
+		part = strings.Trim(strings.TrimSpace(part), `"'`)
+		if part == "" {
+			return Constraint{}, fmt.Errorf("invalid rubygems requirement: %q, empty requirement", s)
+		}
+
+		// Split operator and version
+		i := strings.IndexFunc(part, func(r rune) bool { return !strings.ContainsRune("=!<>~", r) })
+		if i < 0 {
+			return Constraint{}, fmt.Errorf("invalid rubygems requirement: %q, missing version", s)
+		}
+		op, versionPart := part[:i], strings.TrimSpace(part[i:])
+		if op == "" {
+			op = "="
+		}
+
+		p, err := parseRubyGemsVersion(versionPart)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid rubygems requirement: %q: %w", s, err)
+		}
+
+		switch op {
+		case "=", "!=", ">", ">=", "<", "<=":
+			comparators = append(comparators, comparator{op, p.version})
+		case "~>":
+			comparators = append(comparators, comparator{">=", p.version}, comparator{"<", pessimisticUpperBound(p)})
+		default:
+			return Constraint{}, fmt.Errorf("invalid rubygems requirement: %q, unknown operator %s", s, op)
+		}
+	}
+	c.ranges = [][]comparator{comparators}
+
+	return c, nil
+}
+
+// parseRubyGemsVersion parses a RubyGems version like "1.4", "1.0.0.rc1" or "1.0.0-rc1", padding missing segments with zeros.
+func parseRubyGemsVersion(s string) (partialVersion, error) {
+	// RubyGems treats a hyphen as the start of a pre-release
+	segments := strings.Split(strings.Replace(s, "-", ".pre.", 1), ".")
+
+	// The numeric segments form the version core, the rest is the pre-release
+	var p partialVersion
+	for _, segment := range segments {
+		if !isDigits(segment) {
+			break
+		}
+		p.parts++
+	}
+	if p.parts == 0 {
+		return partialVersion{}, fmt.Errorf("invalid version %s", s)
+	}
+	if p.parts > 3 {
+		return partialVersion{}, fmt.Errorf("invalid version %s, more than three segments are not supported", s)
+	}
+
+	core := append(segments[:p.parts:p.parts], "0", "0", "0")[:3]
+	full := strings.Join(core, ".")
+	if preRelease := segments[p.parts:]; len(preRelease) > 0 {
+		full += "-" + strings.Join(preRelease, ".")
+	}
+	v, err := Parse(full)
+	if err != nil {
+		return partialVersion{}, fmt.Errorf("invalid version %s", s)
+	}
+	p.version = v
+	return p, nil
+}
@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseRubyGemsConstraint(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraint  string
+		expectError bool
+	}{
+		{name: "Pessimistic minor", constraint: "~> 1.4"},
+		{name: "Pessimistic patch", constraint: "~> 1.4.2"},
+		{name: "Several requirements", constraint: "~> 1.4, >= 1.4.2"},
+		{name: "Quoted", constraint: `"~> 1.4", ">= 1.4.2"`},
+		{name: "Exact without operator", constraint: "1.2.3"},
+		{name: "Pre-release", constraint: "= 1.0.0.rc1"},
+		{name: "Hyphenated pre-release", constraint: ">= 1.0.0-rc1"},
+		{name: "Empty", constraint: "", expectError: true},
+		{name: "Trailing comma", constraint: "~> 1.4,", expectError: true},
+		{name: "Four segments", constraint: "~> 1.2.3.4", expectError: true},
+		{name: "Caret is not supported", constraint: "^1.2", expectError: true},
+		{name: "Invalid character", constraint: ">= 1.2_3", expectError: true},
+		{name: "Empty segment", constraint: "1..2", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseRubyGemsConstraint(tt.constraint)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if c.String() != tt.constraint {
+				t.Errorf("String() = %v, want %v", c.String(), tt.constraint)
+			}
+		})
+	}
+}
+
+func TestRubyGemsConstraintAllows(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"~> 1.4", "1.4.0", true},
+		{"~> 1.4", "1.10.3", true},
+		{"~> 1.4", "2.0.0", false},
+		{"~> 1.4", "1.3.9", false},
+		{"~> 1.4.2", "1.4.9", true},
+		{"~> 1.4.2", "1.5.0", false},
+		{"~> 1", "1.9.0", true},
+		{"~> 1", "2.0.0", false},
+		{"~> 1.4, >= 1.4.2", "1.4.1", false},
+		{"~> 1.4, >= 1.4.2", "1.9.0", true},
+		{"> 1.2", "1.2.1", true},
+		{"!= 1.5.0, ~> 1.4", "1.5.0", false},
+		{"= 1.0.0.rc1", "1.0.0-rc1", true},
+		{">= 1.0.0.pre.2", "1.0.0-pre.3", true},
+		{">= 1.0.0-rc1", "1.0.0-pre.rc2", true},
+		{"~> 1.4", "1.5.0-rc1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" allows "+tt.version, func(t *testing.T) {
+			c, err := ParseRubyGemsConstraint(tt.constraint)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := c.Allows(mustParse(t, tt.version)); result != tt.expected {
+				t.Errorf("Allows() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package semver
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// SemVerJSONSchema is a JSON Schema (draft 2020-12) of a version serialized as a JSON string in the form
+// returned by String, e.g. "1.2.3-rc.1". It is the content of schema/semver.schema.json, so pipelines
+// outside of Go can use the same definition.
+//
+//go:embed schema/semver.schema.json
+var SemVerJSONSchema string
+
+// ConstraintJSONSchema is a JSON Schema (draft 2020-12) of a constraint serialized as a JSON string in the syntax
+// of ParseConstraint, e.g. ">=1.2.0 <2.0.0". The schema only checks the type, as the constraint syntax cannot be
+// expressed by a pattern, use ValidateConstraintJSON for a full check. It is the content of
+// schema/constraint.schema.json.
+//
+//go:embed schema/constraint.schema.json
+var ConstraintJSONSchema string
+
+// ValidateJSON checks that data is a JSON string holding a version in canonical form as described by
+// SemVerJSONSchema, e.g. a version field of a config file before it is unmarshaled into Go types.
+// It returns an error if data is not a JSON string or does not hold a valid version.
+func ValidateJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid version: %w", err)
+	}
+	v, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	// Parse tolerates empty pre-release and build metadata, which the schema rejects
+	if v.String() != s {
+		return fmt.Errorf("invalid version: %s, expected the canonical form %s", s, v)
+	}
+	return nil
+}
+
+// ValidateConstraintJSON checks that data is a JSON string holding a constraint in the syntax of ParseConstraint.
+// It returns an error if data is not a JSON string or does not hold a valid constraint.
+func ValidateConstraintJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid constraint: %w", err)
+	}
+	_, err := ParseConstraint(s)
+	return err
+}
@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+// schemaPattern returns the compiled pattern of a JSON Schema.
+func schemaPattern(t *testing.T, schema string) *regexp.Regexp {
+	t.Helper()
+	var s struct {
+		Type    string `json:"type"`
+		Pattern string `json:"pattern"`
+	}
+	if err := json.Unmarshal([]byte(schema), &s); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if s.Type != "string" {
+		t.Fatalf("schema type = %v, want string", s.Type)
+	}
+	return regexp.MustCompile(s.Pattern)
+}
+
+func TestValidateJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expectError bool
+	}{
+		{name: "Release", data: `"1.2.3"`},
+		{name: "Pre-release and build metadata", data: `"1.2.3-rc.1+sha.1a2b3c4"`},
+		{name: "Alphanumeric identifier with leading zero", data: `"1.0.0-0abc"`},
+		{name: "Prefix", data: `"v1.2.3"`, expectError: true},
+		{name: "Partial version", data: `"1.2"`, expectError: true},
+		{name: "Leading zero", data: `"01.2.3"`, expectError: true},
+		{name: "Numeric pre-release with leading zero", data: `"1.2.3-01"`, expectError: true},
+		{name: "Empty pre-release", data: `"1.2.3-"`, expectError: true},
+		{name: "Empty build metadata", data: `"1.2.3+"`, expectError: true},
+		{name: "Empty identifier", data: `"1.2.3-rc..1"`, expectError: true},
+		{name: "Number", data: `1.2`, expectError: true},
+		{name: "Object", data: `{"Major":1,"Minor":2,"Patch":3}`, expectError: true},
+		{name: "Malformed JSON", data: `"1.2.3`, expectError: true},
+	}
+
+	pattern := schemaPattern(t, SemVerJSONSchema)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJSON([]byte(tt.data))
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+
+			// The schema must agree with ValidateJSON on strings
+			var s string
+			if json.Unmarshal([]byte(tt.data), &s) == nil {
+				if matches := pattern.MatchString(s); matches == tt.expectError {
+					t.Errorf("schema pattern matches %q = %v, want %v", s, matches, !tt.expectError)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConstraintJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expectError bool
+	}{
+		{name: "Range", data: `">=1.2.0 <2.0.0"`},
+		{name: "Alternatives", data: `"^1.2 || ~2.4"`},
+		{name: "Wildcard", data: `"1.x"`},
+		{name: "Empty", data: `""`, expectError: true},
+		{name: "Only separators", data: `" || "`, expectError: true},
+		{name: "Malformed comparator", data: `">=>1.0.0"`, expectError: true},
+		{name: "Array", data: `[">=1.0.0"]`, expectError: true},
+	}
+
+	pattern := schemaPattern(t, ConstraintJSONSchema)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConstraintJSON([]byte(tt.data))
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+
+			// The schema only rejects a subset of the invalid constraints, but must accept every valid one
+			var s string
+			if !tt.expectError && json.Unmarshal([]byte(tt.data), &s) == nil && !pattern.MatchString(s) {
+				t.Errorf("schema pattern does not match valid constraint %q", s)
+			}
+		})
+	}
+}
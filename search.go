@@ -0,0 +1,36 @@
+package semver
+
+import (
+	"sort"
+)
+
+// Search returns the index of the target in a slice sorted in ascending order by ComparePrecedence,
+// or the index where it would be inserted, and whether it was found. Versions differing in build metadata
+// only have the same precedence and are found for each other.
+// Note that Sort orders by Compare, which puts pre-releases below all releases; sort by ComparePrecedence instead.
+func Search(sorted []SemVer, target SemVer) (int, bool) {
+	i := sort.Search(len(sorted), func(i int) bool {
+		return ComparePrecedence(sorted[i], target) >= 0
+	})
+	return i, i < len(sorted) && ComparePrecedence(sorted[i], target) == 0
+}
+
+// SearchConstraint returns the index of the first version allowed by the constraint in a slice sorted
+// in ascending order by ComparePrecedence, and whether there is one. It searches for the lower bound of each
+// interval of the constraint, so only the pre-releases the constraint rejects within its bounds are scanned.
+func SearchConstraint(sorted []SemVer, c Constraint) (int, bool) {
+	start := 0
+	for _, iv := range c.Intervals() {
+		// Find the first version not below the interval
+		i := start + sort.Search(len(sorted)-start, func(i int) bool { return !belowInterval(sorted[start+i], iv) })
+
+		// Skip the versions of the interval the pre-release rules reject
+		for ; i < len(sorted) && iv.Contains(sorted[i]); i++ {
+			if c.Allows(sorted[i]) {
+				return i, true
+			}
+		}
+		start = i
+	}
+	return len(sorted), false
+}
@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"testing"
+)
+
+// sortedVersions parses versions sorted by precedence.
+func sortedVersions(t *testing.T, versions ...string) []SemVer {
+	t.Helper()
+	var result []SemVer
+	for _, s := range versions {
+		v := mustParse(t, s)
+		if len(result) > 0 && ComparePrecedence(result[len(result)-1], v) > 0 {
+			t.Fatalf("versions are not sorted by precedence at %v", s)
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func TestSearch(t *testing.T) {
+	sorted := sortedVersions(t, "0.1.0", "1.0.0-rc.1", "1.0.0", "1.2.0", "2.0.0-alpha", "2.0.0")
+
+	tests := []struct {
+		target string
+		index  int
+		found  bool
+	}{
+		{"0.1.0", 0, true},
+		{"1.0.0-rc.1", 1, true},
+		{"1.0.0", 2, true},
+		{"1.0.0+build", 2, true},
+		{"1.1.0", 3, false},
+		{"2.0.0-alpha", 4, true},
+		{"2.0.0-beta", 5, false},
+		{"0.0.1", 0, false},
+		{"3.0.0", 6, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			index, found := Search(sorted, mustParse(t, tt.target))
+			if index != tt.index || found != tt.found {
+				t.Errorf("Search() = %v, %v, want %v, %v", index, found, tt.index, tt.found)
+			}
+		})
+	}
+
+	if index, found := Search(nil, SemVer{}); index != 0 || found {
+		t.Errorf("Search(nil) = %v, %v, want 0, false", index, found)
+	}
+}
+
+func TestSearchConstraint(t *testing.T) {
+	sorted := sortedVersions(t, "0.1.0", "1.0.0-rc.1", "1.0.0", "1.2.0", "1.3.0-beta", "1.3.0", "2.0.0-alpha", "2.0.0", "3.1.0")
+
+	tests := []struct {
+		constraint string
+		index      int
+		found      bool
+	}{
+		{"^1.0.0", 2, true},
+		{">=1.0.0-rc.1", 1, true},
+		{">1.2.0", 5, true},
+		{">1.2.0 <2.0.0", 5, true},
+		{"1.2.0 || 0.1.0", 0, true},
+		{"<1.0.0", 0, true},
+		{"2.x", 7, true},
+		{">=1.0.0 <1.0.0", 9, false},
+		{"1.1.x || 3.0.x", 9, false},
+		{"^3.1.1", 9, false},
+		{"*", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			index, found := SearchConstraint(sorted, MustParseConstraint(tt.constraint))
+			if index != tt.index || found != tt.found {
+				t.Errorf("SearchConstraint() = %v, %v, want %v, %v", index, found, tt.index, tt.found)
+			}
+		})
+	}
+}
@@ -35,6 +35,12 @@ func (s SemVer) String() string {
 	return result
 }
 
+// StringV returns the version with a "v" prefix, e.g. "v1.2.3", as git tags and Go modules use it.
+// ParseWith with WithVPrefix(VPrefixRequire) or WithVPrefix(VPrefixAllow) parses it back.
+func (s SemVer) StringV() string {
+	return "v" + s.String()
+}
+
 // IsRelease returns true if the semantic version represents a release version.
 // A release version is one that doesn't have a pre-release identifier.
 func (s SemVer) IsRelease() bool {
@@ -44,107 +50,130 @@ func (s SemVer) IsRelease() bool {
 // Parse parses a string tag into a SemVer struct according to the semantic versioning specification.
 // It returns an error if the tag does not conform to the semantic versioning format.
 func Parse(tag string) (SemVer, error) {
+	semver, _, err := parse(tag, nil)
+	return semver, err
+}
+
+// parse implements Parse. It splits the version core into the scratch slice, which is returned for reuse by a Parser.
+func parse(tag string, scratch []string) (SemVer, []string, error) {
 	var semver SemVer
 
 	// Split the tag into version core and optional parts (pre-release and build)
-	versionAndMeta := strings.SplitN(tag, "+", 2)
-	versionPart := versionAndMeta[0]
+	versionPart, build, hasBuild := strings.Cut(tag, "+")
 
 	// Check if there's build metadata
-	if len(versionAndMeta) > 1 {
-		semver.Build = versionAndMeta[1]
+	if hasBuild {
+		semver.Build = build
 	}
 
 	// Split version part into version core and pre-release
-	versionAndPreRelease := strings.SplitN(versionPart, "-", 2)
-	versionCore := versionAndPreRelease[0]
+	versionCore, preRelease, hasPreRelease := strings.Cut(versionPart, "-")
 
 	// Check if there's pre-release information
-	if len(versionAndPreRelease) > 1 {
-		semver.PreRelease = versionAndPreRelease[1]
+	if hasPreRelease {
+		semver.PreRelease = preRelease
 	}
 
 	// Parse version core (major.minor.patch)
-	versionParts := strings.Split(versionCore, ".")
+	versionParts := appendSplit(scratch[:0], versionCore, ".")
 	if len(versionParts) != 3 {
-		return SemVer{}, fmt.Errorf("invalid version format: %s, expected major.minor.patch", versionCore)
+		return SemVer{}, versionParts, fmt.Errorf("invalid version format: %s, expected major.minor.patch", versionCore)
 	}
 
 	// Parse major version
 	major, err := strconv.ParseUint(versionParts[0], 10, 0)
 	if err != nil {
-		return SemVer{}, fmt.Errorf("invalid major version: %s", versionParts[0])
+		return SemVer{}, versionParts, fmt.Errorf("invalid major version: %s", versionParts[0])
 	}
 	semver.Major = uint(major)
 
 	// Parse minor version
 	minor, err := strconv.ParseUint(versionParts[1], 10, 0)
 	if err != nil {
-		return SemVer{}, fmt.Errorf("invalid minor version: %s", versionParts[1])
+		return SemVer{}, versionParts, fmt.Errorf("invalid minor version: %s", versionParts[1])
 	}
 	semver.Minor = uint(minor)
 
 	// Parse patch version
 	patch, err := strconv.ParseUint(versionParts[2], 10, 0)
 	if err != nil {
-		return SemVer{}, fmt.Errorf("invalid patch version: %s", versionParts[2])
+		return SemVer{}, versionParts, fmt.Errorf("invalid patch version: %s", versionParts[2])
 	}
 	semver.Patch = uint(patch)
 
 	// Validate numeric identifiers according to the spec
 	if versionParts[0] != "0" && strings.HasPrefix(versionParts[0], "0") {
-		return SemVer{}, fmt.Errorf("invalid major version: %s, leading zeros not allowed", versionParts[0])
+		return SemVer{}, versionParts, fmt.Errorf("invalid major version: %s, leading zeros not allowed", versionParts[0])
 	}
 	if versionParts[1] != "0" && strings.HasPrefix(versionParts[1], "0") {
-		return SemVer{}, fmt.Errorf("invalid minor version: %s, leading zeros not allowed", versionParts[1])
+		return SemVer{}, versionParts, fmt.Errorf("invalid minor version: %s, leading zeros not allowed", versionParts[1])
 	}
 	if versionParts[2] != "0" && strings.HasPrefix(versionParts[2], "0") {
-		return SemVer{}, fmt.Errorf("invalid patch version: %s, leading zeros not allowed", versionParts[2])
+		return SemVer{}, versionParts, fmt.Errorf("invalid patch version: %s, leading zeros not allowed", versionParts[2])
 	}
 
 	// Validate pre-release format if present
-	if semver.PreRelease != "" {
-		preReleaseParts := strings.Split(semver.PreRelease, ".")
-		for _, part := range preReleaseParts {
-			if part == "" {
-				return SemVer{}, fmt.Errorf("invalid pre-release: empty identifier")
-			}
+	for rest, more := semver.PreRelease, semver.PreRelease != ""; more; {
+		var part string
+		part, rest, more = strings.Cut(rest, ".")
+		if part == "" {
+			return SemVer{}, versionParts, fmt.Errorf("invalid pre-release: empty identifier")
+		}
 
-			// Check if it's a numeric identifier
-			if _, err := strconv.ParseUint(part, 10, 64); err == nil {
-				// Numeric identifiers must not have leading zeros unless they are zero
-				if part != "0" && strings.HasPrefix(part, "0") {
-					return SemVer{}, fmt.Errorf("invalid pre-release: %s, numeric identifiers must not have leading zeros", part)
-				}
-			} else {
-				// Alphanumeric identifiers must only contain alphanumeric characters and hyphens
-				for _, c := range part {
-					if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-') {
-						return SemVer{}, fmt.Errorf("invalid pre-release: %s, contains invalid character", part)
-					}
+		// Check if it's a numeric identifier
+		if isNumericIdentifier(part) {
+			// Numeric identifiers must not have leading zeros unless they are zero
+			if part != "0" && strings.HasPrefix(part, "0") {
+				return SemVer{}, versionParts, fmt.Errorf("invalid pre-release: %s, numeric identifiers must not have leading zeros", part)
+			}
+		} else {
+			// Alphanumeric identifiers must only contain alphanumeric characters and hyphens
+			for _, c := range part {
+				if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-') {
+					return SemVer{}, versionParts, fmt.Errorf("invalid pre-release: %s, contains invalid character", part)
 				}
 			}
 		}
 	}
 
 	// Validate build metadata format if present
-	if semver.Build != "" {
-		buildParts := strings.Split(semver.Build, ".")
-		for _, part := range buildParts {
-			if part == "" {
-				return SemVer{}, fmt.Errorf("invalid build metadata: empty identifier")
-			}
+	for rest, more := semver.Build, semver.Build != ""; more; {
+		var part string
+		part, rest, more = strings.Cut(rest, ".")
+		if part == "" {
+			return SemVer{}, versionParts, fmt.Errorf("invalid build metadata: empty identifier")
+		}
 
-			// Build identifiers must only contain alphanumeric characters and hyphens
-			for _, c := range part {
-				if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-') {
-					return SemVer{}, fmt.Errorf("invalid build metadata: %s, contains invalid character", part)
-				}
+		// Build identifiers must only contain alphanumeric characters and hyphens
+		for _, c := range part {
+			if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-') {
+				return SemVer{}, versionParts, fmt.Errorf("invalid build metadata: %s, contains invalid character", part)
 			}
 		}
 	}
 
-	return semver, nil
+	return semver, versionParts, nil
+}
+
+// appendSplit appends the substrings of s separated by sep to dst, like strings.Split without allocating a new slice.
+func appendSplit(dst []string, s, sep string) []string {
+	for {
+		before, after, found := strings.Cut(s, sep)
+		dst = append(dst, before)
+		if !found {
+			return dst
+		}
+		s = after
+	}
+}
+
+// isNumericIdentifier reports whether a pre-release identifier is numeric: digits only, fitting into 64 bits.
+func isNumericIdentifier(s string) bool {
+	if !isDigits(s) {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
 }
 
 // Compare compares this version with another version according to semantic versioning precedence rules.
@@ -162,6 +191,13 @@ func (s SemVer) Compare(other SemVer) int {
 		return 1
 	}
 
+	return ComparePrecedence(s, other)
+}
+
+// ComparePrecedence compares two versions strictly by the precedence rules of the specification (section 11),
+// where a pre-release only has lower precedence than its own normal version.
+// Unlike Compare, it orders 1.0.0 < 2.0.0-alpha < 2.0.0, which is what range checks like ">=1.0.0 <2.0.0" rely on.
+func ComparePrecedence(s SemVer, other SemVer) int {
 	// Compare major version
 	if s.Major < other.Major {
 		return -1
@@ -186,10 +222,16 @@ func (s SemVer) Compare(other SemVer) int {
 		return 1
 	}
 
-	// At this point, major.minor.patch are equal, and either both have pre-release or both don't
+	// At this point, major.minor.patch are equal, a pre-release has lower precedence than the normal version
 	if s.PreRelease == "" && other.PreRelease == "" {
 		return 0
 	}
+	if s.PreRelease == "" {
+		return 1
+	}
+	if other.PreRelease == "" {
+		return -1
+	}
 
 	// Both have pre-release identifiers, compare them
 	sPreReleaseParts := strings.Split(s.PreRelease, ".")
@@ -872,3 +872,36 @@ func TestSort(t *testing.T) {
 		})
 	}
 }
+
+// mustParse parses a tag and fails the test if it is invalid.
+func mustParse(t *testing.T, tag string) SemVer {
+	t.Helper()
+	v, err := Parse(tag)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	return v
+}
+
+func TestComparePrecedence(t *testing.T) {
+	tests := []struct {
+		version1 string
+		version2 string
+		expected int
+	}{
+		{"1.0.0", "2.0.0-alpha", -1},
+		{"2.0.0-alpha", "1.0.0", 1},
+		{"2.0.0-alpha", "2.0.0", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-rc.1+a", "1.0.0-rc.1+b", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version1+" vs "+tt.version2, func(t *testing.T) {
+			result := ComparePrecedence(mustParse(t, tt.version1), mustParse(t, tt.version2))
+			if result != tt.expected {
+				t.Errorf("ComparePrecedence() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
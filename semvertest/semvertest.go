@@ -0,0 +1,157 @@
+// Package semvertest provides assertions for tests of code using semantic versions.
+//
+// Assertions on order use semantic versioning precedence as defined by semver.ComparePrecedence, so a pre-release
+// of a higher version sorts after a release of a lower one, e.g. 1.0.0 < 2.0.0-rc.1.
+//
+// The assertions report failures with t.Errorf, so a test continues after a failed assertion,
+// and return whether they passed. Malformed versions and constraints given to them are reported with t.Fatalf.
+package semvertest
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// AssertEqual asserts that two versions are identical, including build metadata.
+func AssertEqual(t testing.TB, got, want semver.SemVer) bool {
+	t.Helper()
+	if got != want {
+		t.Errorf("got version %s, want %s", got, want)
+		return false
+	}
+	return true
+}
+
+// AssertOrdered asserts that the versions are in strictly ascending order of precedence,
+// as defined by semver.ComparePrecedence.
+func AssertOrdered(t testing.TB, versions ...semver.SemVer) bool {
+	t.Helper()
+	for i := 1; i < len(versions); i++ {
+		if semver.ComparePrecedence(versions[i-1], versions[i]) >= 0 {
+			t.Errorf("versions not in ascending order: %s at index %d is not lower than %s at index %d", versions[i-1], i-1, versions[i], i)
+			return false
+		}
+	}
+	return true
+}
+
+// AssertSatisfies asserts that the version satisfies the constraint.
+func AssertSatisfies(t testing.TB, v semver.SemVer, constraint string) bool {
+	t.Helper()
+	if !mustParseConstraint(t, constraint).Allows(v) {
+		t.Errorf("version %s does not satisfy %q", v, constraint)
+		return false
+	}
+	return true
+}
+
+// AssertNotSatisfies asserts that the version does not satisfy the constraint.
+func AssertNotSatisfies(t testing.TB, v semver.SemVer, constraint string) bool {
+	t.Helper()
+	if mustParseConstraint(t, constraint).Allows(v) {
+		t.Errorf("version %s satisfies %q", v, constraint)
+		return false
+	}
+	return true
+}
+
+// AssertSortOrder asserts that sorting by semver.ComparePrecedence, the order AssertOrdered checks, orders the
+// golden versions as given, whatever their initial order. The versions are sorted reversed and in several shuffled
+// orders, and a mismatch is reported as a line diff between the golden and the sorted order. As versions differing
+// in build metadata only have no order among each other, the golden order should not contain such versions.
+func AssertSortOrder(t testing.TB, golden ...string) bool {
+	t.Helper()
+	versions := make([]semver.SemVer, len(golden))
+	for i, s := range golden {
+		versions[i] = MustParse(t, s)
+	}
+
+	// Sort the reversed order and a few shuffles with a fixed seed, so failures are reproducible
+	r := rand.New(rand.NewSource(1))
+	for attempt := 0; attempt < 5; attempt++ {
+		input := append([]semver.SemVer(nil), versions...)
+		if attempt == 0 {
+			for i, j := 0, len(input)-1; i < j; i, j = i+1, j-1 {
+				input[i], input[j] = input[j], input[i]
+			}
+		} else {
+			r.Shuffle(len(input), func(i, j int) { input[i], input[j] = input[j], input[i] })
+		}
+
+		slices.SortStableFunc(input, semver.ComparePrecedence)
+		sorted := make([]string, len(input))
+		for i, v := range input {
+			sorted[i] = v.String()
+		}
+		if d := diff(golden, sorted); d != "" {
+			t.Errorf("sorted order differs from the golden order (-golden +sorted):\n%s", d)
+			return false
+		}
+	}
+	return true
+}
+
+// MustParse parses a version, failing the test if it is invalid.
+func MustParse(t testing.TB, s string) semver.SemVer {
+	t.Helper()
+	v, err := semver.Parse(s)
+	if err != nil {
+		t.Fatalf("semver.Parse(%q) failed: %v", s, err)
+	}
+	return v
+}
+
+// mustParseConstraint parses a constraint, failing the test if it is invalid.
+func mustParseConstraint(t testing.TB, s string) semver.Constraint {
+	t.Helper()
+	c, err := semver.ParseConstraint(s)
+	if err != nil {
+		t.Fatalf("semver.ParseConstraint(%q) failed: %v", s, err)
+	}
+	return c
+}
+
+// diff returns a line diff from a to b based on their longest common subsequence, or "" if they are equal.
+// Common lines are prefixed with two spaces, removed lines with "- " and added lines with "+ ".
+func diff(a, b []string) string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	if lcs[0][0] == len(a) && len(a) == len(b) {
+		return ""
+	}
+
+	var out strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			fmt.Fprintf(&out, "  %s\n", a[i])
+			i++
+			j++
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			fmt.Fprintf(&out, "- %s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&out, "+ %s\n", b[j])
+			j++
+		}
+	}
+	return out.String()
+}
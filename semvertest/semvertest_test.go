@@ -0,0 +1,80 @@
+package semvertest
+
+import (
+	"fmt"
+	"testing"
+)
+
+// recorder is a testing.TB recording failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+	r.fatal = true
+}
+
+func TestAssertions(t *testing.T) {
+	tests := []struct {
+		name     string
+		assert   func(t testing.TB) bool
+		expected bool
+	}{
+		{name: "Equal", assert: func(t testing.TB) bool { return AssertEqual(t, MustParse(t, "1.2.3+b"), MustParse(t, "1.2.3+b")) }, expected: true},
+		{name: "Equal differing in build", assert: func(t testing.TB) bool { return AssertEqual(t, MustParse(t, "1.2.3+a"), MustParse(t, "1.2.3+b")) }},
+		{name: "Ordered", assert: func(t testing.TB) bool {
+			return AssertOrdered(t, MustParse(t, "1.0.0"), MustParse(t, "2.0.0-rc.1"), MustParse(t, "2.0.0"))
+		}, expected: true},
+		{name: "Not ordered", assert: func(t testing.TB) bool { return AssertOrdered(t, MustParse(t, "1.0.0"), MustParse(t, "0.9.0")) }},
+		{name: "Equal precedence is not ordered", assert: func(t testing.TB) bool {
+			return AssertOrdered(t, MustParse(t, "1.0.0+a"), MustParse(t, "1.0.0+b"))
+		}},
+		{name: "Satisfies", assert: func(t testing.TB) bool { return AssertSatisfies(t, MustParse(t, "1.4.0"), "^1.2") }, expected: true},
+		{name: "Does not satisfy", assert: func(t testing.TB) bool { return AssertSatisfies(t, MustParse(t, "2.0.0"), "^1.2") }},
+		{name: "Not satisfies", assert: func(t testing.TB) bool { return AssertNotSatisfies(t, MustParse(t, "2.0.0"), "^1.2") }, expected: true},
+		{name: "Satisfies unexpectedly", assert: func(t testing.TB) bool { return AssertNotSatisfies(t, MustParse(t, "1.4.0"), "^1.2") }},
+		{name: "Sort order", assert: func(t testing.TB) bool {
+			return AssertSortOrder(t, "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0", "1.2.0", "1.10.0", "2.0.0-rc.1", "2.0.0")
+		}, expected: true},
+		{name: "Wrong sort order", assert: func(t testing.TB) bool { return AssertSortOrder(t, "1.10.0", "1.2.0") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			if result := tt.assert(r); result != tt.expected {
+				t.Errorf("assertion returned %v, want %v", result, tt.expected)
+			}
+			if failed := len(r.errors) > 0; failed == tt.expected || r.fatal {
+				t.Errorf("assertion reported %q, want a failure: %v", r.errors, !tt.expected)
+			}
+		})
+	}
+}
+
+func TestAssertSortOrderDiff(t *testing.T) {
+	r := &recorder{TB: t}
+	AssertSortOrder(r, "1.0.0", "1.0.0-rc.1", "1.1.0")
+
+	expected := "sorted order differs from the golden order (-golden +sorted):\n- 1.0.0\n  1.0.0-rc.1\n+ 1.0.0\n  1.1.0\n"
+	if len(r.errors) != 1 || r.errors[0] != expected {
+		t.Errorf("AssertSortOrder() reported %q, want %q", r.errors, expected)
+	}
+}
+
+func TestMustParseInvalid(t *testing.T) {
+	r := &recorder{TB: t}
+	MustParse(r, "1.2")
+	if !r.fatal {
+		t.Errorf("MustParse() did not fail the test")
+	}
+}
@@ -0,0 +1,69 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// Series is a release line sharing a major and minor version, e.g. the maintenance line 1.4.x.
+type Series struct {
+	Major uint
+	Minor uint
+}
+
+// SeriesOf returns the series the version belongs to.
+func SeriesOf(v SemVer) Series {
+	return Series{Major: v.Major, Minor: v.Minor}
+}
+
+// ParseSeries parses a series written as "1.4" or "1.4.x".
+// It returns an error if the string is not a major and minor version with an optional wildcard patch.
+func ParseSeries(s string) (Series, error) {
+	p, err := parsePartialVersion(s)
+	if err != nil {
+		return Series{}, fmt.Errorf("invalid series: %s: %w", s, err)
+	}
+	if p.parts != 2 || p.version.PreRelease != "" {
+		return Series{}, fmt.Errorf("invalid series: %s, expected major.minor", s)
+	}
+	return SeriesOf(p.version), nil
+}
+
+// String returns the series as "major.minor.x".
+func (s Series) String() string {
+	return fmt.Sprintf("%d.%d.x", s.Major, s.Minor)
+}
+
+// Contains reports whether the version, including its pre-releases, belongs to the series.
+func (s Series) Contains(v SemVer) bool {
+	return v.Major == s.Major && v.Minor == s.Minor
+}
+
+// Latest returns the highest version of the series in the list by ComparePrecedence, so 1.4.8-rc.1 is above 1.4.7
+// but below 1.4.8. It returns false if the list has no version of the series.
+func (s Series) Latest(versions []SemVer) (SemVer, bool) {
+	var latest SemVer
+	found := false
+	for _, v := range versions {
+		if s.Contains(v) && (!found || ComparePrecedence(v, latest) > 0) {
+			latest, found = v, true
+		}
+	}
+	return latest, found
+}
+
+// NextPatch returns the next patch version of the series, regardless of newer series in the list:
+// the latest version of the series with its patch bumped, or major.minor.0 if the list has none.
+// A pre-release without a release of the series is bumped to its release, so 1.4.0-rc.1 gives 1.4.0.
+func (s Series) NextPatch(versions []SemVer) SemVer {
+	latest, ok := s.Latest(versions)
+	if !ok {
+		return SemVer{Major: s.Major, Minor: s.Minor}
+	}
+	return latest.BumpPatch()
+}
+
+// LatestInSeries returns the highest version of the major.minor series in the list by ComparePrecedence.
+// It returns false if the list has no version of the series.
+func LatestInSeries(versions []SemVer, major, minor uint) (SemVer, bool) {
+	return Series{Major: major, Minor: minor}.Latest(versions)
+}
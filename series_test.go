@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseSeries(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    Series
+		expectError bool
+	}{
+		{name: "Major and minor", input: "1.4", expected: Series{Major: 1, Minor: 4}},
+		{name: "Wildcard patch", input: "1.4.x", expected: Series{Major: 1, Minor: 4}},
+		{name: "Uppercase wildcard", input: "0.12.X", expected: Series{Minor: 12}},
+		{name: "Full version", input: "1.4.2", expectError: true},
+		{name: "Major only", input: "1", expectError: true},
+		{name: "Major wildcard", input: "1.x", expectError: true},
+		{name: "Leading zero", input: "1.04", expectError: true},
+		{name: "Empty", input: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseSeries(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result != tt.expected {
+				t.Errorf("ParseSeries() = %v, want %v", result, tt.expected)
+			}
+			if result.String() != tt.expected.String() {
+				t.Errorf("String() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSeriesLatestAndNextPatch(t *testing.T) {
+	var versions []SemVer
+	for _, v := range []string{"1.3.9-rc.1", "1.3.9", "1.4.0", "1.4.7", "1.4.8-rc.1", "1.5.0", "2.0.0-rc.1", "2.1.0"} {
+		versions = append(versions, mustParse(t, v))
+	}
+
+	tests := []struct {
+		name              string
+		series            Series
+		expectedLatest    string
+		expectedNextPatch string
+	}{
+		{name: "Maintenance line with newer series", series: Series{Major: 1, Minor: 4}, expectedLatest: "1.4.8-rc.1", expectedNextPatch: "1.4.8"},
+		{name: "Release above its pre-release", series: Series{Major: 1, Minor: 3}, expectedLatest: "1.3.9", expectedNextPatch: "1.3.10"},
+		{name: "Only a pre-release", series: Series{Major: 2}, expectedLatest: "2.0.0-rc.1", expectedNextPatch: "2.0.0"},
+		{name: "No version", series: Series{Major: 1, Minor: 6}, expectedNextPatch: "1.6.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest, ok := LatestInSeries(versions, tt.series.Major, tt.series.Minor)
+			if tt.expectedLatest == "" {
+				if ok {
+					t.Errorf("LatestInSeries() = %v, want none", latest)
+				}
+			} else if !ok || latest.String() != tt.expectedLatest {
+				t.Errorf("LatestInSeries() = %v, %v, want %v", latest, ok, tt.expectedLatest)
+			}
+			if result := tt.series.NextPatch(versions).String(); result != tt.expectedNextPatch {
+				t.Errorf("NextPatch() = %v, want %v", result, tt.expectedNextPatch)
+			}
+		})
+	}
+}
+
+func TestSeriesContains(t *testing.T) {
+	s := SeriesOf(mustParse(t, "1.4.2"))
+	for v, expected := range map[string]bool{"1.4.0": true, "1.4.9-rc.1": true, "1.5.0": false, "2.4.0": false} {
+		if result := s.Contains(mustParse(t, v)); result != expected {
+			t.Errorf("Contains(%v) = %v, want %v", v, result, expected)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package semver
+
+import "fmt"
+
+// Short returns an abbreviated form of a release for display, e.g. in user interfaces or release notes:
+// "1.2" for 1.2.0 and "1" for 1.0.0, while 1.2.3 stays "1.2.3".
+// Pre-releases and versions with build metadata are returned in full, as String does.
+//
+// The short form is not a valid semantic version and Parse rejects it, so it must not be stored or compared.
+// Use String wherever the version is read back.
+func (s SemVer) Short() string {
+	if s.PreRelease != "" || s.Build != "" {
+		return s.String()
+	}
+
+	switch {
+	case s.Patch != 0:
+		return s.String()
+	case s.Minor != 0:
+		return fmt.Sprintf("%d.%d", s.Major, s.Minor)
+	}
+	return fmt.Sprintf("%d", s.Major)
+}
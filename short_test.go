@@ -0,0 +1,33 @@
+package semver
+
+import "testing"
+
+func TestShort(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "Major only", version: "1.0.0", expected: "1"},
+		{name: "Zero patch", version: "1.2.0", expected: "1.2"},
+		{name: "Full", version: "1.2.3", expected: "1.2.3"},
+		{name: "Zero major", version: "0.3.0", expected: "0.3"},
+		{name: "All zero", version: "0.0.0", expected: "0"},
+		{name: "Zero minor with patch", version: "1.0.3", expected: "1.0.3"},
+		{name: "Pre-release", version: "2.0.0-rc.1", expected: "2.0.0-rc.1"},
+		{name: "Build", version: "2.0.0+build.5", expected: "2.0.0+build.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := mustParse(t, tt.version)
+			if result := v.Short(); result != tt.expected {
+				t.Errorf("Short() = %v, want %v", result, tt.expected)
+			}
+			// The canonical form is unaffected
+			if result := v.String(); result != tt.version {
+				t.Errorf("String() = %v, want %v", result, tt.version)
+			}
+		})
+	}
+}
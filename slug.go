@@ -0,0 +1,54 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Slug returns the version encoded with lowercase letters, digits and hyphens only, so it can be used in
+// file names, URL paths, DNS labels and container image tags.
+//
+// The encoding replaces the dots of the version core with hyphens, separates the pre-release with a hyphen
+// and the build metadata with two hyphens, replaces the dots between identifiers with hyphens and lowercases
+// all letters: 1.2.3-RC.1+Build.5 becomes "1-2-3-rc-1--build-5".
+//
+// ParseSlug reverses the encoding exactly for versions whose identifiers have no uppercase letters and no hyphens.
+// Hyphens within identifiers are decoded as dots. Slug does not enforce length limits like the 63 characters of a DNS label.
+func (s SemVer) Slug() string {
+	slug := fmt.Sprintf("%d-%d-%d", s.Major, s.Minor, s.Patch)
+	if s.PreRelease != "" {
+		slug += "-" + strings.ReplaceAll(s.PreRelease, ".", "-")
+	}
+	if s.Build != "" {
+		slug += "--" + strings.ReplaceAll(s.Build, ".", "-")
+	}
+	return strings.ToLower(slug)
+}
+
+// ParseSlug parses a version encoded by Slug.
+// It returns an error if the slug does not decode to a valid version.
+func ParseSlug(slug string) (SemVer, error) {
+	// Split off the build metadata at the first double hyphen
+	rest, build, hasBuild := strings.Cut(slug, "--")
+	if hasBuild && build == "" {
+		return SemVer{}, fmt.Errorf("invalid slug: %s, empty build metadata", slug)
+	}
+
+	parts := strings.SplitN(rest, "-", 4)
+	if len(parts) < 3 {
+		return SemVer{}, fmt.Errorf("invalid slug: %s, expected major-minor-patch", slug)
+	}
+	version := strings.Join(parts[:3], ".")
+	if len(parts) == 4 {
+		version += "-" + strings.ReplaceAll(parts[3], "-", ".")
+	}
+	if hasBuild {
+		version += "+" + strings.ReplaceAll(build, "-", ".")
+	}
+
+	v, err := Parse(version)
+	if err != nil {
+		return SemVer{}, fmt.Errorf("invalid slug: %s: %w", slug, err)
+	}
+	return v, nil
+}
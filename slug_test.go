@@ -0,0 +1,74 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+		decoded  string
+	}{
+		{name: "Release", version: "1.2.3", expected: "1-2-3", decoded: "1.2.3"},
+		{name: "Pre-release", version: "1.2.3-rc.1", expected: "1-2-3-rc-1", decoded: "1.2.3-rc.1"},
+		{name: "Build", version: "1.2.3+sha.abc", expected: "1-2-3--sha-abc", decoded: "1.2.3+sha.abc"},
+		{name: "Pre-release and build", version: "10.0.0-beta.2+20260101", expected: "10-0-0-beta-2--20260101", decoded: "10.0.0-beta.2+20260101"},
+		{name: "Uppercase is lowercased", version: "1.2.3-RC.1+Build.5", expected: "1-2-3-rc-1--build-5", decoded: "1.2.3-rc.1+build.5"},
+		{name: "Hyphens in identifiers become dots", version: "1.0.0-x-y-z", expected: "1-0-0-x-y-z", decoded: "1.0.0-x.y.z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug := mustParse(t, tt.version).Slug()
+			if slug != tt.expected {
+				t.Errorf("Slug() = %v, want %v", slug, tt.expected)
+			}
+			decoded, err := ParseSlug(slug)
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if decoded.String() != tt.decoded {
+				t.Errorf("ParseSlug() = %v, want %v", decoded, tt.decoded)
+			}
+		})
+	}
+}
+
+func TestParseSlug(t *testing.T) {
+	tests := []struct {
+		name        string
+		slug        string
+		expected    string
+		expectError bool
+	}{
+		{name: "Release", slug: "0-1-0", expected: "0.1.0"},
+		{name: "Missing patch", slug: "1-2", expectError: true},
+		{name: "Dots", slug: "1.2.3", expectError: true},
+		{name: "Leading zero", slug: "1-02-3", expectError: true},
+		{name: "Empty build", slug: "1-2-3--", expectError: true},
+		{name: "Empty pre-release identifier", slug: "1-2-3-rc-", expectError: true},
+		{name: "Invalid character", slug: "1-2-3-rc_1", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseSlug(tt.slug)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result.String() != tt.expected {
+				t.Errorf("ParseSlug() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
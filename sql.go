@@ -0,0 +1,83 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SQLSchema describes how versions are stored in a relational database table, to filter them by a constraint
+// within a query. Each version needs its major, minor and patch numbers, its pre-release ("" for releases)
+// and a sortable key, Padded(KeyWidth) of the version without build metadata, stored with a binary collation
+// so the database compares keys byte-wise. Column names are written to the predicate as they are.
+type SQLSchema struct {
+	Major      string
+	Minor      string
+	Patch      string
+	PreRelease string
+	Key        string
+	KeyWidth   int
+
+	// Placeholder returns the placeholder of the n-th argument starting at 1, e.g. "$1" for PostgreSQL.
+	// Nil uses "?" for all arguments.
+	Placeholder func(n int) string
+}
+
+// Where returns a parameterized SQL predicate selecting the versions the constraint allows, with its arguments,
+// e.g. for "^1.2":
+//
+//	(key >= ? AND key < ? AND prerelease = '')
+//
+// The bounds are compared on the key column, the pre-release rules of the constraint on the other columns.
+// It returns an error if a version of the constraint does not fit the key width.
+func (s SQLSchema) Where(c Constraint) (string, []any, error) {
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		if s.Placeholder == nil {
+			return "?"
+		}
+		return s.Placeholder(len(args))
+	}
+
+	if len(c.ranges) == 0 {
+		return "1 = 0", nil, nil
+	}
+
+	var alternatives []string
+	for _, comparators := range c.ranges {
+		var conditions []string
+
+		// Compare the bounds on the sortable key
+		for _, comp := range comparators {
+			key, err := comp.version.Padded(s.KeyWidth)
+			if err != nil {
+				return "", nil, fmt.Errorf("cannot convert constraint %q to sql: %w", c, err)
+			}
+			op := comp.op
+			if op == "!=" {
+				op = "<>"
+			}
+			conditions = append(conditions, fmt.Sprintf("%s %s %s", s.Key, op, arg(key)))
+		}
+
+		// Allow releases and the pre-releases of the version cores named by a comparator
+		preReleases := []string{fmt.Sprintf("%s = ''", s.PreRelease)}
+		for _, comp := range comparators {
+			if comp.version.PreRelease == "" || c.exactPreReleases && comp.op != "=" {
+				continue
+			}
+			v := comp.version
+			preReleases = append(preReleases, fmt.Sprintf("(%s = %s AND %s = %s AND %s = %s)",
+				s.Major, arg(v.Major), s.Minor, arg(v.Minor), s.Patch, arg(v.Patch)))
+		}
+		if len(preReleases) == 1 {
+			conditions = append(conditions, preReleases[0])
+		} else {
+			conditions = append(conditions, "("+strings.Join(preReleases, " OR ")+")")
+		}
+
+		alternatives = append(alternatives, "("+strings.Join(conditions, " AND ")+")")
+	}
+
+	return strings.Join(alternatives, " OR "), args, nil
+}
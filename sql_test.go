@@ -0,0 +1,102 @@
+package semver
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSQLSchemaWhere(t *testing.T) {
+	schema := SQLSchema{Major: "major", Minor: "minor", Patch: "patch", PreRelease: "prerelease", Key: "sort_key", KeyWidth: 4}
+	dollar := schema
+	dollar.Placeholder = func(n int) string { return fmt.Sprintf("$%d", n) }
+
+	tests := []struct {
+		name        string
+		schema      SQLSchema
+		constraint  Constraint
+		expected    string
+		args        []any
+		expectError bool
+	}{
+		{
+			name:       "Caret",
+			schema:     schema,
+			constraint: MustParseConstraint("^1.2"),
+			expected:   "(sort_key >= ? AND sort_key < ? AND prerelease = '')",
+			args:       []any{"0001.0002.0000~", "0002.0000.0000~"},
+		},
+		{
+			name:       "Alternatives with not equal",
+			schema:     schema,
+			constraint: MustParseConstraint("1.2.3 || >=2.0.0 !=2.1.0"),
+			expected:   "(sort_key = ? AND prerelease = '') OR (sort_key >= ? AND sort_key <> ? AND prerelease = '')",
+			args:       []any{"0001.0002.0003~", "0002.0000.0000~", "0002.0001.0000~"},
+		},
+		{
+			name:       "Pre-release",
+			schema:     dollar,
+			constraint: MustParseConstraint(">=1.0.0-rc.1 <2.0.0"),
+			expected:   "(sort_key >= $1 AND sort_key < $2 AND (prerelease = '' OR (major = $3 AND minor = $4 AND patch = $5)))",
+			args:       []any{"0001.0000.0000-rc.0001", "0002.0000.0000~", uint(1), uint(0), uint(0)},
+		},
+		{
+			name:       "Alphanumeric pre-release starting with a digit sorts after numeric ones",
+			schema:     schema,
+			constraint: MustParseConstraint(">=1.0.0-1a"),
+			expected:   "(sort_key >= ? AND (prerelease = '' OR (major = ? AND minor = ? AND patch = ?)))",
+			args:       []any{"0001.0000.0000-@1a", uint(1), uint(0), uint(0)},
+		},
+		{
+			name:       "Terraform pre-releases",
+			schema:     schema,
+			constraint: mustParseTerraform(t, ">= 1.0.0-rc.1"),
+			expected:   "(sort_key >= ? AND prerelease = '')",
+			args:       []any{"0001.0000.0000-rc.0001"},
+		},
+		{
+			name:       "Matches nothing",
+			schema:     schema,
+			constraint: IntervalSet(nil).Constraint(),
+			expected:   "1 = 0",
+		},
+		{
+			name:        "Version too wide",
+			schema:      schema,
+			constraint:  MustParseConstraint(">=10000.0.0"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args, err := tt.schema.Where(tt.constraint)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if where != tt.expected {
+				t.Errorf("Where() = %v, want %v", where, tt.expected)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("Where() args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
+
+// mustParseTerraform parses a Terraform constraint, failing the test on error.
+func mustParseTerraform(t *testing.T, s string) Constraint {
+	t.Helper()
+	c, err := ParseTerraformConstraint(s)
+	if err != nil {
+		t.Fatalf("ParseTerraformConstraint(%q) failed: %v", s, err)
+	}
+	return c
+}
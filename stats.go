@@ -0,0 +1,95 @@
+package semver
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Stats summarizes a list of versions, e.g. the versions the clients of a service run.
+type Stats struct {
+	// Total is the number of versions, counting duplicates
+	Total int
+	// PreReleases is the number of pre-release versions
+	PreReleases int
+	// Oldest and Newest are the versions with the lowest and highest precedence, zero if there are no versions
+	Oldest SemVer
+	Newest SemVer
+	// PerMajor and PerMinor count the versions of each major version and each series
+	PerMajor map[uint]int
+	PerMinor map[Series]int
+}
+
+// Summarize returns the statistics of the versions.
+func Summarize(versions []SemVer) Stats {
+	stats := Stats{PerMajor: map[uint]int{}, PerMinor: map[Series]int{}}
+	for i, v := range versions {
+		stats.Total++
+		if !v.IsRelease() {
+			stats.PreReleases++
+		}
+		if i == 0 || ComparePrecedence(v, stats.Oldest) < 0 {
+			stats.Oldest = v
+		}
+		if i == 0 || ComparePrecedence(v, stats.Newest) > 0 {
+			stats.Newest = v
+		}
+		stats.PerMajor[v.Major]++
+		stats.PerMinor[SeriesOf(v)]++
+	}
+	return stats
+}
+
+// PreReleaseShare returns the fraction of pre-release versions between 0 and 1, 0 if there are no versions.
+func (s Stats) PreReleaseShare() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.PreReleases) / float64(s.Total)
+}
+
+// Bucket is a group of versions in a histogram.
+type Bucket struct {
+	Key   string
+	Count int
+}
+
+// BucketByMajor puts versions into buckets by major version, e.g. "1.x".
+func BucketByMajor(v SemVer) string {
+	return fmt.Sprintf("%d.x", v.Major)
+}
+
+// BucketByMinor puts versions into buckets by series, e.g. "1.4.x".
+func BucketByMinor(v SemVer) string {
+	return SeriesOf(v).String()
+}
+
+// BucketByRelease puts pre-releases into the bucket of their release and drops build metadata, e.g. "1.4.2".
+func BucketByRelease(v SemVer) string {
+	return SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch}.String()
+}
+
+// Histogram counts the versions per bucket, the key of a version's bucket given by bucketBy,
+// e.g. BucketByMinor. The buckets are sorted by the lowest version they contain.
+func Histogram(versions []SemVer, bucketBy func(SemVer) string) []Bucket {
+	counts := map[string]int{}
+	lowest := map[string]SemVer{}
+	for _, v := range versions {
+		key := bucketBy(v)
+		if counts[key] == 0 || ComparePrecedence(v, lowest[key]) < 0 {
+			lowest[key] = v
+		}
+		counts[key]++
+	}
+
+	buckets := make([]Bucket, 0, len(counts))
+	for key, count := range counts {
+		buckets = append(buckets, Bucket{Key: key, Count: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if result := ComparePrecedence(lowest[buckets[i].Key], lowest[buckets[j].Key]); result != 0 {
+			return result < 0
+		}
+		return buckets[i].Key < buckets[j].Key
+	})
+	return buckets
+}
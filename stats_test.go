@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	versions := []SemVer{
+		mustParse(t, "1.4.2"),
+		mustParse(t, "1.4.2"),
+		mustParse(t, "1.5.0-rc.1"),
+		mustParse(t, "2.0.0"),
+		mustParse(t, "0.9.0"),
+	}
+
+	stats := Summarize(versions)
+	if stats.Total != 5 {
+		t.Errorf("Total = %v, want 5", stats.Total)
+	}
+	if stats.PreReleases != 1 {
+		t.Errorf("PreReleases = %v, want 1", stats.PreReleases)
+	}
+	if share := stats.PreReleaseShare(); share != 0.2 {
+		t.Errorf("PreReleaseShare() = %v, want 0.2", share)
+	}
+	if stats.Oldest.String() != "0.9.0" || stats.Newest.String() != "2.0.0" {
+		t.Errorf("Oldest, Newest = %v, %v, want 0.9.0, 2.0.0", stats.Oldest, stats.Newest)
+	}
+	if expected := map[uint]int{0: 1, 1: 3, 2: 1}; !reflect.DeepEqual(stats.PerMajor, expected) {
+		t.Errorf("PerMajor = %v, want %v", stats.PerMajor, expected)
+	}
+	if expected := map[Series]int{{0, 9}: 1, {1, 4}: 2, {1, 5}: 1, {2, 0}: 1}; !reflect.DeepEqual(stats.PerMinor, expected) {
+		t.Errorf("PerMinor = %v, want %v", stats.PerMinor, expected)
+	}
+}
+
+func TestSummarizeEmpty(t *testing.T) {
+	stats := Summarize(nil)
+	if stats.Total != 0 || stats.PreReleaseShare() != 0 || stats.Newest != (SemVer{}) {
+		t.Errorf("Summarize(nil) = %+v, want empty statistics", stats)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	versions := []SemVer{
+		mustParse(t, "1.10.0"),
+		mustParse(t, "1.4.2"),
+		mustParse(t, "1.4.2-rc.1"),
+		mustParse(t, "1.4.0+build.1"),
+		mustParse(t, "2.0.0"),
+		mustParse(t, "1.9.0"),
+	}
+
+	tests := []struct {
+		name     string
+		bucketBy func(SemVer) string
+		expected []Bucket
+	}{
+		{name: "Major", bucketBy: BucketByMajor, expected: []Bucket{{"1.x", 5}, {"2.x", 1}}},
+		{name: "Minor", bucketBy: BucketByMinor, expected: []Bucket{{"1.4.x", 3}, {"1.9.x", 1}, {"1.10.x", 1}, {"2.0.x", 1}}},
+		{name: "Release", bucketBy: BucketByRelease, expected: []Bucket{{"1.4.0", 1}, {"1.4.2", 2}, {"1.9.0", 1}, {"1.10.0", 1}, {"2.0.0", 1}}},
+		{name: "Custom", bucketBy: func(v SemVer) string {
+			if v.IsRelease() {
+				return "stable"
+			}
+			return "pre-release"
+		}, expected: []Bucket{{"stable", 5}, {"pre-release", 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Histogram(versions, tt.bucketBy); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Histogram() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
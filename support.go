@@ -0,0 +1,97 @@
+package semver
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SupportPolicy decides which versions are still supported, either relative to the latest releases,
+// e.g. "the latest two minor versions of each of the latest two major versions",
+// or by explicit end-of-life dates per release series, or both.
+type SupportPolicy struct {
+	// Releases are the published versions the relative rules are applied to. Pre-releases are ignored.
+	Releases []SemVer
+
+	// Majors is the number of latest major versions that are supported, 0 for all of them.
+	Majors int
+
+	// Minors is the number of latest minor versions of each supported major version that are supported,
+	// 0 for all of them.
+	Minors int
+
+	// EOL maps release series to the date support ends. A series is either a major version like "1"
+	// or a minor version like "1.2", the latter taking precedence.
+	EOL map[string]time.Time
+
+	// Now returns the current time, time.Now if nil.
+	Now func() time.Time
+}
+
+// EOLDate returns the end-of-life date of the release series of v, or false if none is declared.
+func (p SupportPolicy) EOLDate(v SemVer) (time.Time, bool) {
+	if date, ok := p.EOL[fmt.Sprintf("%d.%d", v.Major, v.Minor)]; ok {
+		return date, true
+	}
+	date, ok := p.EOL[fmt.Sprintf("%d", v.Major)]
+	return date, ok
+}
+
+// IsSupported reports whether v is supported: its series has not reached its end-of-life date, and it belongs
+// to one of the latest supported major and minor versions of the releases. Versions newer than all releases
+// are supported, as are pre-releases of supported series.
+func (p SupportPolicy) IsSupported(v SemVer) bool {
+	now := time.Now
+	if p.Now != nil {
+		now = p.Now
+	}
+	if date, ok := p.EOLDate(v); ok && !now().Before(date) {
+		return false
+	}
+
+	// Apply the relative rules to the latest releases
+	if oldest, ok := oldestSupported(p.Releases, p.Majors, func(r SemVer) (bool, uint) { return true, r.Major }); ok && v.Major < oldest {
+		return false
+	}
+	if oldest, ok := oldestSupported(p.Releases, p.Minors, func(r SemVer) (bool, uint) { return r.Major == v.Major, r.Minor }); ok && v.Minor < oldest {
+		return false
+	}
+	return true
+}
+
+// UnsupportedVersions returns the versions of the list that are not supported, in their original order.
+func (p SupportPolicy) UnsupportedVersions(versions []SemVer) []SemVer {
+	var unsupported []SemVer
+	for _, v := range versions {
+		if !p.IsSupported(v) {
+			unsupported = append(unsupported, v)
+		}
+	}
+	return unsupported
+}
+
+// oldestSupported returns the lowest of the latest n distinct components of the releases selected by the function.
+// It returns false if n is 0 or there are no more than n distinct components, so all of them are supported.
+func oldestSupported(releases []SemVer, n int, component func(SemVer) (bool, uint)) (uint, bool) {
+	if n <= 0 {
+		return 0, false
+	}
+	seen := map[uint]bool{}
+	var values []uint
+	for _, r := range releases {
+		if !r.IsRelease() {
+			continue
+		}
+		if ok, c := component(r); ok && !seen[c] {
+			seen[c] = true
+			values = append(values, c)
+		}
+	}
+	if len(values) <= n {
+		return 0, false
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] > values[j]
+	})
+	return values[n-1], true
+}
@@ -0,0 +1,75 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSupportPolicyIsSupported(t *testing.T) {
+	var releases []SemVer
+	for _, r := range []string{"1.0.0", "1.1.0", "1.2.0", "1.2.1", "2.0.0", "2.1.0", "2.2.0-rc.1", "3.0.0", "3.1.0"} {
+		releases = append(releases, mustParse(t, r))
+	}
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		policy   SupportPolicy
+		version  string
+		expected bool
+	}{
+		{name: "No rules", version: "0.1.0", expected: true},
+		{name: "Latest major", policy: SupportPolicy{Majors: 2}, version: "3.0.0", expected: true},
+		{name: "Second latest major", policy: SupportPolicy{Majors: 2}, version: "2.0.0", expected: true},
+		{name: "Old major", policy: SupportPolicy{Majors: 2}, version: "1.2.1", expected: false},
+		{name: "Unreleased major", policy: SupportPolicy{Majors: 2}, version: "4.0.0-alpha", expected: true},
+		{name: "Latest minor", policy: SupportPolicy{Minors: 2}, version: "1.2.0", expected: true},
+		{name: "Second latest minor", policy: SupportPolicy{Minors: 2}, version: "1.1.5", expected: true},
+		{name: "Old minor", policy: SupportPolicy{Minors: 2}, version: "1.0.3", expected: false},
+		{name: "Pre-releases are not minor releases", policy: SupportPolicy{Minors: 1}, version: "2.1.0", expected: true},
+		{name: "Pre-release of supported minor", policy: SupportPolicy{Minors: 1}, version: "3.1.1-rc.1", expected: true},
+		{name: "Minors of old major", policy: SupportPolicy{Majors: 1, Minors: 2}, version: "2.1.0", expected: false},
+		{name: "Minors of latest major", policy: SupportPolicy{Majors: 1, Minors: 2}, version: "3.0.2", expected: true},
+		{name: "Before major EOL", policy: SupportPolicy{EOL: map[string]time.Time{"1": now.Add(time.Hour)}}, version: "1.0.0", expected: true},
+		{name: "After major EOL", policy: SupportPolicy{EOL: map[string]time.Time{"1": now}}, version: "1.2.1", expected: false},
+		{name: "Minor EOL takes precedence", policy: SupportPolicy{EOL: map[string]time.Time{"1": now, "1.2": now.AddDate(1, 0, 0)}}, version: "1.2.1", expected: true},
+		{name: "After minor EOL", policy: SupportPolicy{EOL: map[string]time.Time{"3.0": now.AddDate(0, -1, 0)}}, version: "3.0.1", expected: false},
+		{name: "EOL of other series", policy: SupportPolicy{EOL: map[string]time.Time{"3.0": now.AddDate(0, -1, 0)}}, version: "3.1.0", expected: true},
+		{name: "EOL and relative rules", policy: SupportPolicy{Majors: 2, EOL: map[string]time.Time{"2": now}}, version: "2.1.0", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.policy.Releases = releases
+			tt.policy.Now = func() time.Time { return now }
+			if result := tt.policy.IsSupported(mustParse(t, tt.version)); result != tt.expected {
+				t.Errorf("IsSupported() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSupportPolicyEOLDate(t *testing.T) {
+	eol := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := SupportPolicy{EOL: map[string]time.Time{"1": eol}}
+
+	if date, ok := p.EOLDate(mustParse(t, "1.4.2")); !ok || !date.Equal(eol) {
+		t.Errorf("EOLDate() = %v, %v, want %v, true", date, ok, eol)
+	}
+	if _, ok := p.EOLDate(mustParse(t, "2.0.0")); ok {
+		t.Errorf("EOLDate() = _, true, want false")
+	}
+}
+
+func TestSupportPolicyUnsupportedVersions(t *testing.T) {
+	p := SupportPolicy{
+		Releases: []SemVer{{Major: 1}, {Major: 2}, {Major: 3}},
+		Majors:   2,
+	}
+	versions := []SemVer{{Major: 3}, {Major: 1, Minor: 1}, {Major: 2}, {Major: 1}}
+	expected := []SemVer{{Major: 1, Minor: 1}, {Major: 1}}
+	if result := p.UnsupportedVersions(versions); !reflect.DeepEqual(result, expected) {
+		t.Errorf("UnsupportedVersions() = %v, want %v", result, expected)
+	}
+}
@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SynthesizeConstraint returns the tightest simple constraint allowing all the versions, e.g. ones known to work
+// from a test matrix. The lower bound is the lowest version, the upper bound excludes the versions after the highest
+// one that a change of the given type would introduce: for 1.3.2 and 1.5.1, ChangeMinor gives ">=1.3.2 <1.6.0",
+// ChangeMajor ">=1.3.2 <2.0.0", ChangePatch ">=1.3.2 <1.5.2" and ChangeNone ">=1.3.2 <=1.5.1".
+//
+// Pre-releases are only allowed by a constraint naming them, so a pre-release that is not the lowest version
+// is added as an exact alternative, e.g. ">=1.3.2 <1.6.0 || 1.4.0-rc.1". Build metadata is ignored.
+// It returns an error if there are no versions.
+func SynthesizeConstraint(versions []SemVer, change ChangeType) (Constraint, error) {
+	if len(versions) == 0 {
+		return Constraint{}, fmt.Errorf("cannot synthesize a constraint without versions")
+	}
+
+	// Find the lowest and the highest version
+	lowest, highest := versions[0], versions[0]
+	for _, v := range versions[1:] {
+		if ComparePrecedence(v, lowest) < 0 {
+			lowest = v
+		}
+		if ComparePrecedence(v, highest) > 0 {
+			highest = v
+		}
+	}
+	lowest.Build = ""
+
+	comparators := []string{">=" + lowest.String()}
+	switch change {
+	case ChangeMajor:
+		comparators = append(comparators, "<"+SemVer{Major: highest.Major + 1}.String())
+	case ChangeMinor:
+		comparators = append(comparators, "<"+SemVer{Major: highest.Major, Minor: highest.Minor + 1}.String())
+	case ChangePatch:
+		comparators = append(comparators, "<"+SemVer{Major: highest.Major, Minor: highest.Minor, Patch: highest.Patch + 1}.String())
+	default:
+		highest.Build = ""
+		comparators = append(comparators, "<="+highest.String())
+	}
+	alternatives := []string{strings.Join(comparators, " ")}
+
+	// Name the pre-releases the range does not allow
+	c := MustParseConstraint(alternatives[0])
+	seen := map[string]bool{}
+	for _, v := range versions {
+		v.Build = ""
+		if c.Allows(v) || seen[v.String()] {
+			continue
+		}
+		seen[v.String()] = true
+		alternatives = append(alternatives, v.String())
+	}
+
+	return ParseConstraint(strings.Join(alternatives, " || "))
+}
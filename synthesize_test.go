@@ -0,0 +1,57 @@
+package semver
+
+import "testing"
+
+func TestSynthesizeConstraint(t *testing.T) {
+	tests := []struct {
+		name        string
+		versions    []string
+		change      ChangeType
+		expected    string
+		expectError bool
+	}{
+		{name: "Minor", versions: []string{"1.5.1", "1.3.2", "1.4.0"}, change: ChangeMinor, expected: ">=1.3.2 <1.6.0"},
+		{name: "Major", versions: []string{"1.3.2", "1.5.1"}, change: ChangeMajor, expected: ">=1.3.2 <2.0.0"},
+		{name: "Patch", versions: []string{"1.3.2", "1.5.1"}, change: ChangePatch, expected: ">=1.3.2 <1.5.2"},
+		{name: "None", versions: []string{"1.3.2", "1.5.1"}, change: ChangeNone, expected: ">=1.3.2 <=1.5.1"},
+		{name: "Single version", versions: []string{"2.0.0"}, change: ChangeMinor, expected: ">=2.0.0 <2.1.0"},
+		{name: "Build metadata", versions: []string{"1.0.0+linux", "1.1.0+darwin"}, change: ChangeNone, expected: ">=1.0.0 <=1.1.0"},
+		{name: "Lowest pre-release", versions: []string{"1.0.0-rc.1", "1.2.0"}, change: ChangeMinor, expected: ">=1.0.0-rc.1 <1.3.0"},
+		{
+			name:     "Other pre-releases",
+			versions: []string{"1.0.0", "1.4.0-rc.1", "1.2.0", "1.4.0-rc.1+b2"},
+			change:   ChangeMinor,
+			expected: ">=1.0.0 <1.5.0 || 1.4.0-rc.1",
+		},
+		{name: "No versions", change: ChangeMinor, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var versions []SemVer
+			for _, s := range tt.versions {
+				versions = append(versions, mustParse(t, s))
+			}
+
+			c, err := SynthesizeConstraint(versions, tt.change)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if c.String() != tt.expected {
+				t.Errorf("SynthesizeConstraint() = %v, want %v", c, tt.expected)
+			}
+			for _, v := range versions {
+				if !c.Allows(v) {
+					t.Errorf("SynthesizeConstraint() does not allow %v", v)
+				}
+			}
+		})
+	}
+}
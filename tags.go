@@ -0,0 +1,66 @@
+package semver
+
+import (
+	"fmt"
+	"sort"
+)
+
+// InvalidTagPolicy controls how SortTags handles tags that are not valid versions, like "latest" or "test-tag".
+type InvalidTagPolicy int
+
+const (
+	// InvalidTagAbort makes SortTags return an error for the first invalid tag.
+	InvalidTagAbort InvalidTagPolicy = iota
+	// InvalidTagSkip drops invalid tags silently.
+	InvalidTagSkip
+	// InvalidTagReport drops invalid tags and reports each of them with the reason.
+	InvalidTagReport
+)
+
+// InvalidTag is a tag SortTags dropped, with the parse error explaining why.
+type InvalidTag struct {
+	Tag string
+	Err error
+}
+
+// String returns the tag and the reason, e.g. `"latest": invalid version format: latest, expected major.minor.patch`.
+func (t InvalidTag) String() string {
+	return fmt.Sprintf("%q: %v", t.Tag, t.Err)
+}
+
+// SortTags returns the tags that are valid versions sorted in ascending order as by Sort, keeping their original
+// spelling. The tags are parsed with ParseWith and the options, e.g. WithVPrefix(VPrefixAllow) for "v1.2.3" tags.
+// The policy decides what happens to the other tags; the invalid tags are only returned with InvalidTagReport.
+// It returns an error for the first invalid tag with InvalidTagAbort.
+func SortTags(tags []string, policy InvalidTagPolicy, opts ...ParseOption) ([]string, []InvalidTag, error) {
+	type versionTag struct {
+		tag     string
+		version SemVer
+	}
+
+	var valid []versionTag
+	var invalid []InvalidTag
+	for _, tag := range tags {
+		v, err := ParseWith(tag, opts...)
+		if err == nil {
+			valid = append(valid, versionTag{tag, v})
+			continue
+		}
+
+		switch policy {
+		case InvalidTagAbort:
+			return nil, nil, fmt.Errorf("invalid tag %q: %w", tag, err)
+		case InvalidTagReport:
+			invalid = append(invalid, InvalidTag{Tag: tag, Err: err})
+		}
+	}
+
+	sort.SliceStable(valid, func(i, j int) bool {
+		return valid[i].version.Compare(valid[j].version) < 0
+	})
+	sorted := make([]string, len(valid))
+	for i, t := range valid {
+		sorted[i] = t.tag
+	}
+	return sorted, invalid, nil
+}
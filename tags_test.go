@@ -0,0 +1,94 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortTags(t *testing.T) {
+	tags := []string{"v1.10.0", "latest", "v1.2.0", "test-tag", "v1.2.0-rc.1", "1.0.0"}
+
+	tests := []struct {
+		name        string
+		policy      InvalidTagPolicy
+		opts        []ParseOption
+		expected    []string
+		invalid     []string
+		expectError bool
+	}{
+		{
+			name:     "Skip",
+			policy:   InvalidTagSkip,
+			opts:     []ParseOption{WithVPrefix(VPrefixAllow)},
+			expected: []string{"v1.2.0-rc.1", "1.0.0", "v1.2.0", "v1.10.0"},
+		},
+		{
+			name:     "Report",
+			policy:   InvalidTagReport,
+			opts:     []ParseOption{WithVPrefix(VPrefixAllow)},
+			expected: []string{"v1.2.0-rc.1", "1.0.0", "v1.2.0", "v1.10.0"},
+			invalid:  []string{"latest", "test-tag"},
+		},
+		{
+			name:     "Report with strict parsing",
+			policy:   InvalidTagReport,
+			expected: []string{"1.0.0"},
+			invalid:  []string{"v1.10.0", "latest", "v1.2.0", "test-tag", "v1.2.0-rc.1"},
+		},
+		{
+			name:        "Abort",
+			policy:      InvalidTagAbort,
+			opts:        []ParseOption{WithVPrefix(VPrefixAllow)},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, invalid, err := SortTags(tags, tt.policy, tt.opts...)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if !reflect.DeepEqual(sorted, tt.expected) {
+				t.Errorf("SortTags() = %v, want %v", sorted, tt.expected)
+			}
+			var invalidTags []string
+			for _, i := range invalid {
+				if i.Err == nil {
+					t.Errorf("invalid tag %q has no reason", i.Tag)
+				}
+				invalidTags = append(invalidTags, i.Tag)
+			}
+			if !reflect.DeepEqual(invalidTags, tt.invalid) {
+				t.Errorf("SortTags() invalid = %v, want %v", invalidTags, tt.invalid)
+			}
+		})
+	}
+}
+
+func TestSortTagsAbortWithValidTags(t *testing.T) {
+	sorted, _, err := SortTags([]string{"2.0.0", "1.0.0"}, InvalidTagAbort)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if expected := []string{"1.0.0", "2.0.0"}; !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("SortTags() = %v, want %v", sorted, expected)
+	}
+}
+
+func TestInvalidTagString(t *testing.T) {
+	_, invalid, _ := SortTags([]string{"latest"}, InvalidTagReport)
+	if len(invalid) != 1 {
+		t.Fatalf("SortTags() reported %d invalid tags, want 1", len(invalid))
+	}
+	if expected := `"latest": invalid version format: latest, expected major.minor.patch`; invalid[0].String() != expected {
+		t.Errorf("String() = %v, want %v", invalid[0], expected)
+	}
+}
@@ -0,0 +1,74 @@
+package semver
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// TemplateFuncs returns functions for text/template and html/template, e.g. to render release notes,
+// manifests or Helm-style charts. Versions may be passed as SemVer values or strings, with an optional "v" prefix:
+//
+//	semver "1.2.3"                      the parsed version, e.g. for {{ (semver .Tag).Major }}
+//	semverCompare "1.2.3" "1.10.0"      -1, 0 or 1 by precedence, as ComparePrecedence
+//	semverSatisfies ">=1.2 <2" "1.4.0"  whether the version satisfies the constraint
+//	semverBump "minor" "1.2.3"          the next version for a change of none, patch, minor or major
+//
+// The version is the last argument, so functions can be used in pipelines like {{ .Version | semverBump "minor" }}.
+// Unlike Sprig's semverCompare, which checks a constraint like semverSatisfies, semverCompare compares two versions.
+// Functions fail the template execution if a version, constraint or change is malformed.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"semver": templateVersion,
+		"semverCompare": func(a, b any) (int, error) {
+			va, err := templateVersion(a)
+			if err != nil {
+				return 0, err
+			}
+			vb, err := templateVersion(b)
+			if err != nil {
+				return 0, err
+			}
+			return ComparePrecedence(va, vb), nil
+		},
+		"semverSatisfies": func(constraint string, v any) (bool, error) {
+			c, err := ParseConstraint(constraint)
+			if err != nil {
+				return false, err
+			}
+			version, err := templateVersion(v)
+			if err != nil {
+				return false, err
+			}
+			return c.Allows(version), nil
+		},
+		"semverBump": func(change string, v any) (SemVer, error) {
+			version, err := templateVersion(v)
+			if err != nil {
+				return SemVer{}, err
+			}
+			for c := ChangeNone; c <= ChangeMajor; c++ {
+				if c.String() == change {
+					return version.Bump(c), nil
+				}
+			}
+			return SemVer{}, fmt.Errorf("invalid change: %s, expected none, patch, minor or major", change)
+		},
+	}
+}
+
+// templateVersion converts a template argument to a version, parsing strings like git tags.
+func templateVersion(v any) (SemVer, error) {
+	switch v := v.(type) {
+	case SemVer:
+		return v, nil
+	case *SemVer:
+		if v != nil {
+			return *v, nil
+		}
+	case string:
+		return ParseWith(v, WithVPrefix(VPrefixAllow))
+	case fmt.Stringer:
+		return ParseWith(v.String(), WithVPrefix(VPrefixAllow))
+	}
+	return SemVer{}, fmt.Errorf("invalid version: %v of type %T", v, v)
+}
@@ -0,0 +1,53 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		data        any
+		expected    string
+		expectError bool
+	}{
+		{name: "Parse version", template: `{{ (semver "1.2.3-rc.1").Minor }}`, expected: "2"},
+		{name: "Compare lower", template: `{{ semverCompare "1.2.3" "1.10.0" }}`, expected: "-1"},
+		{name: "Compare ignores build metadata", template: `{{ semverCompare "1.2.3+a" "1.2.3+b" }}`, expected: "0"},
+		{name: "Satisfies", template: `{{ semverSatisfies ">=1.2 <2" "1.4.0" }}`, expected: "true"},
+		{name: "Does not satisfy", template: `{{ semverSatisfies "^2" "1.4.0" }}`, expected: "false"},
+		{name: "Bump in pipeline", template: `{{ .Version | semverBump "minor" }}`, data: map[string]any{"Version": SemVer{Major: 1, Minor: 2, Patch: 3}}, expected: "1.3.0"},
+		{name: "Bump string", template: `{{ semverBump "major" "v1.2.3" | printf "v%s" }}`, expected: "v2.0.0"},
+		{name: "Pointer version", template: `{{ semverCompare . "1.0.0" }}`, data: &SemVer{Major: 2}, expected: "1"},
+		{name: "Malformed version", template: `{{ semver "1.2" }}`, expectError: true},
+		{name: "Malformed constraint", template: `{{ semverSatisfies ">=>1" "1.0.0" }}`, expectError: true},
+		{name: "Unknown change", template: `{{ semverBump "huge" "1.0.0" }}`, expectError: true},
+		{name: "Unsupported type", template: `{{ semver 42 }}`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New(tt.name).Funcs(TemplateFuncs()).Parse(tt.template)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			var b strings.Builder
+			err = tmpl.Execute(&b, tt.data)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if b.String() != tt.expected {
+				t.Errorf("Execute() = %q, want %q", b.String(), tt.expected)
+			}
+		})
+	}
+}
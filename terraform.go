@@ -0,0 +1,68 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseTerraformConstraint parses a constraint in Terraform's version constraint syntax,
+// e.g. "~> 1.4" or ">= 1.2, < 2.0", into a Constraint.
+//
+// Comparators are separated by commas and all of them must match. Supported operators are
+// =, !=, >, >=, <, <= and the pessimistic ~>, which allows only the right-most given component
+// to increase: "~> 1.4" is >=1.4.0 <2.0.0 and "~> 1.4.2" is >=1.4.2 <1.5.0.
+// Unlike ParseConstraint, partial versions are padded with zeros, so "> 1.2" matches 1.2.1,
+// and pre-release versions are only matched by an exact "=" comparator naming them.
+//
+// It returns an error if the constraint is empty or a comparator is malformed.
+func ParseTerraformConstraint(s string) (Constraint, error) {
+	c := Constraint{raw: s, exactPreReleases: true}
+
+	var comparators []comparator
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return Constraint{}, fmt.Errorf("invalid terraform constraint: %q, empty comparator", s)
+		}
+
+		// Split operator and version
+		i := strings.IndexFunc(part, func(r rune) bool { return !strings.ContainsRune("=!<>~", r) })
+		if i < 0 {
+			return Constraint{}, fmt.Errorf("invalid terraform constraint: %q, missing version", s)
+		}
+		op, versionPart := part[:i], strings.TrimSpace(part[i:])
+		if op == "" {
+			op = "="
+		}
+
+		// Terraform has no wildcards, every given component must be numeric
+		versionPart = strings.TrimPrefix(versionPart, "v")
+		versionCore, _, _ := strings.Cut(strings.SplitN(versionPart, "+", 2)[0], "-")
+		p, err := parsePartialVersion(versionPart)
+		if err != nil || p.parts == 0 || p.parts != strings.Count(versionCore, ".")+1 {
+			return Constraint{}, fmt.Errorf("invalid terraform constraint: %q, invalid version %s", s, versionPart)
+		}
+
+		switch op {
+		case "=", "!=", ">", ">=", "<", "<=":
+			comparators = append(comparators, comparator{op, p.version})
+		case "~>":
+			comparators = append(comparators, comparator{">=", p.version}, comparator{"<", pessimisticUpperBound(p)})
+		default:
+			return Constraint{}, fmt.Errorf("invalid terraform constraint: %q, unknown operator %s", s, op)
+		}
+	}
+	c.ranges = [][]comparator{comparators}
+
+	return c, nil
+}
+
+// pessimisticUpperBound returns the exclusive upper bound of a pessimistic "~>" comparator,
+// which increments the second to last given component.
+func pessimisticUpperBound(p partialVersion) SemVer {
+	v := p.version
+	if p.parts == 3 {
+		return SemVer{Major: v.Major, Minor: v.Minor + 1}
+	}
+	return SemVer{Major: v.Major + 1}
+}
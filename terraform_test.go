@@ -0,0 +1,91 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseTerraformConstraint(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraint  string
+		expectError bool
+	}{
+		{name: "Pessimistic minor", constraint: "~> 1.4"},
+		{name: "Pessimistic patch", constraint: "~> 1.4.2"},
+		{name: "Range", constraint: ">= 1.2, < 2.0"},
+		{name: "Exact without operator", constraint: "1.2.3"},
+		{name: "Pre-release", constraint: "= 1.2.0-beta1"},
+		{name: "Empty", constraint: "", expectError: true},
+		{name: "Trailing comma", constraint: ">= 1.2,", expectError: true},
+		{name: "Alternatives are not supported", constraint: "1.2.3 || 1.2.4", expectError: true},
+		{name: "Caret is not supported", constraint: "^1.2", expectError: true},
+		{name: "Wildcard is not supported", constraint: "1.x", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseTerraformConstraint(tt.constraint)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if c.String() != tt.constraint {
+				t.Errorf("String() = %v, want %v", c.String(), tt.constraint)
+			}
+		})
+	}
+}
+
+func TestTerraformConstraintAllows(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"~> 1.4", "1.4.0", true},
+		{"~> 1.4", "1.10.3", true},
+		{"~> 1.4", "2.0.0", false},
+		{"~> 1.4", "1.3.9", false},
+		{"~> 1.4.2", "1.4.9", true},
+		{"~> 1.4.2", "1.5.0", false},
+		{"~> 1", "1.9.0", true},
+		{"~> 1", "2.0.0", false},
+		{">= 1.2, < 2.0", "1.2.0", true},
+		{">= 1.2, < 2.0", "2.0.0", false},
+		{"> 1.2", "1.2.1", true},
+		{"= 1.2", "1.2.0", true},
+		{"= 1.2", "1.2.1", false},
+		{"!= 1.3.0, >= 1.2", "1.3.0", false},
+		{"v1.2.3", "1.2.3", true},
+		{"1.2.3+build.1", "1.2.3", true},
+
+		// Pre-releases are only matched exactly
+		{"= 1.2.0-beta1", "1.2.0-beta1", true},
+		{"1.2.0-beta1", "1.2.0-beta1", true},
+		{">= 1.2.0-beta1", "1.2.0-beta2", false},
+		{">= 1.2.0-beta1", "1.2.0", true},
+		{"~> 1.2", "1.3.0-rc1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" allows "+tt.version, func(t *testing.T) {
+			c, err := ParseTerraformConstraint(tt.constraint)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			v, err := Parse(tt.version)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := c.Allows(v); result != tt.expected {
+				t.Errorf("Allows() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
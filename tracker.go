@@ -0,0 +1,47 @@
+package semver
+
+import (
+	"sync"
+)
+
+// Tracker keeps the highest versions observed, e.g. by watchers over several registries.
+// It is safe for concurrent use. The zero value is ready to use.
+type Tracker struct {
+	mu        sync.RWMutex
+	latest    SemVer
+	stable    SemVer
+	hasLatest bool
+	hasStable bool
+}
+
+// Observe records a version. It reports whether the version is the new latest version.
+// Versions with the same precedence as the latest version, differing in build metadata only, do not replace it.
+func (t *Tracker) Observe(v SemVer) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if v.IsRelease() && (!t.hasStable || ComparePrecedence(v, t.stable) > 0) {
+		t.stable, t.hasStable = v, true
+	}
+	if !t.hasLatest || ComparePrecedence(v, t.latest) > 0 {
+		t.latest, t.hasLatest = v, true
+		return true
+	}
+	return false
+}
+
+// Latest returns the highest version observed, including pre-releases, or false if none was observed.
+func (t *Tracker) Latest() (SemVer, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.latest, t.hasLatest
+}
+
+// LatestStable returns the highest release version observed, or false if none was observed.
+func (t *Tracker) LatestStable() (SemVer, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.stable, t.hasStable
+}
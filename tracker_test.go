@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestTracker(t *testing.T) {
+	tests := []struct {
+		name           string
+		observed       []string
+		expectedLatest string
+		expectedStable string
+	}{
+		{name: "Nothing observed"},
+		{name: "Releases", observed: []string{"1.2.0", "1.10.0", "1.9.0"}, expectedLatest: "1.10.0", expectedStable: "1.10.0"},
+		{name: "Pre-release above releases", observed: []string{"1.2.0", "2.0.0-rc.1", "1.3.0"}, expectedLatest: "2.0.0-rc.1", expectedStable: "1.3.0"},
+		{name: "Pre-releases only", observed: []string{"1.0.0-beta", "1.0.0-alpha"}, expectedLatest: "1.0.0-beta"},
+		{name: "Release above its pre-release", observed: []string{"2.0.0-rc.1", "2.0.0"}, expectedLatest: "2.0.0", expectedStable: "2.0.0"},
+		{name: "First build metadata wins", observed: []string{"1.0.0+a", "1.0.0+b"}, expectedLatest: "1.0.0+a", expectedStable: "1.0.0+a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tracker Tracker
+			for _, v := range tt.observed {
+				tracker.Observe(mustParse(t, v))
+			}
+
+			latest, ok := tracker.Latest()
+			if ok != (tt.expectedLatest != "") || ok && latest.String() != tt.expectedLatest {
+				t.Errorf("Latest() = %v, %v, want %q", latest, ok, tt.expectedLatest)
+			}
+			stable, ok := tracker.LatestStable()
+			if ok != (tt.expectedStable != "") || ok && stable.String() != tt.expectedStable {
+				t.Errorf("LatestStable() = %v, %v, want %q", stable, ok, tt.expectedStable)
+			}
+		})
+	}
+}
+
+func TestTrackerObserveReportsNewLatest(t *testing.T) {
+	var tracker Tracker
+	for _, tt := range []struct {
+		version  string
+		expected bool
+	}{
+		{"1.0.0", true},
+		{"0.9.0", false},
+		{"1.0.0", false},
+		{"1.1.0-rc.1", true},
+		{"1.0.1", false},
+	} {
+		if result := tracker.Observe(mustParse(t, tt.version)); result != tt.expected {
+			t.Errorf("Observe(%v) = %v, want %v", tt.version, result, tt.expected)
+		}
+	}
+}
+
+func TestTrackerConcurrent(t *testing.T) {
+	var tracker Tracker
+	var wg sync.WaitGroup
+	for major := uint(0); major < 8; major++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for minor := uint(0); minor < 100; minor++ {
+				tracker.Observe(SemVer{Major: major, Minor: minor})
+				tracker.Latest()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if latest, _ := tracker.Latest(); latest != (SemVer{Major: 7, Minor: 99}) {
+		t.Errorf("Latest() = %v, want 7.99.0", latest)
+	}
+}
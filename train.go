@@ -0,0 +1,86 @@
+package semver
+
+import (
+	"fmt"
+	"time"
+)
+
+// Release is a version published at a date.
+type Release struct {
+	Version SemVer
+	Date    time.Time
+}
+
+// ReleaseTrain schedules releases at a fixed cadence, e.g. a minor version every six weeks,
+// while other releases like patches are published on demand without moving the schedule.
+type ReleaseTrain struct {
+	// Cadence is the time between two scheduled releases.
+	Cadence time.Duration
+
+	// Change is the change type of scheduled releases, ChangeMinor if ChangeNone.
+	Change ChangeType
+
+	// Start is the date of the first scheduled release if the history contains none.
+	Start time.Time
+}
+
+// Next returns the next scheduled release after now: the latest version of the history bumped
+// for the change type of the train, and the first date of the schedule not before now.
+// The schedule is anchored at the date of the latest scheduled release of the history,
+// i.e. the latest release with zero components below the change type, and at Start without one.
+// Missed dates are skipped, so a late release does not cause several releases in a row.
+//
+// It returns an error if the cadence is not positive or there is no anchor for the schedule.
+func (t ReleaseTrain) Next(history []Release, now time.Time) (Release, error) {
+	if t.Cadence <= 0 {
+		return Release{}, fmt.Errorf("invalid release train: cadence %v is not positive", t.Cadence)
+	}
+	change := t.Change
+	if change == ChangeNone {
+		change = ChangeMinor
+	}
+
+	// Find the latest version and the latest scheduled release
+	var latest, anchorVersion SemVer
+	if len(history) > 0 {
+		latest = history[0].Version
+	}
+	anchor, anchored := t.Start, false
+	for _, r := range history {
+		if ComparePrecedence(r.Version, latest) > 0 {
+			latest = r.Version
+		}
+		if r.Version.IsRelease() && scheduled(r.Version, change) && (!anchored || ComparePrecedence(r.Version, anchorVersion) > 0) {
+			anchor, anchored, anchorVersion = r.Date, true, r.Version
+		}
+	}
+	if anchor.IsZero() {
+		return Release{}, fmt.Errorf("invalid release train: no scheduled release in the history and no start date")
+	}
+
+	// Skip the dates of the schedule that have passed
+	date := anchor
+	if anchored {
+		date = date.Add(t.Cadence)
+	}
+	if date.Before(now) {
+		missed := now.Sub(date) / t.Cadence
+		date = date.Add(missed * t.Cadence)
+		if date.Before(now) {
+			date = date.Add(t.Cadence)
+		}
+	}
+
+	return Release{Version: latest.Bump(change), Date: date}, nil
+}
+
+// scheduled reports whether the version has zero components below the change type, as releases of a train have.
+func scheduled(v SemVer, change ChangeType) bool {
+	switch change {
+	case ChangeMajor:
+		return v.Minor == 0 && v.Patch == 0
+	case ChangeMinor:
+		return v.Patch == 0
+	}
+	return true
+}
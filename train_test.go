@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReleaseTrainNext(t *testing.T) {
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	sixWeeks := 6 * 7 * 24 * time.Hour
+	history := []Release{
+		{Version: mustParse(t, "1.4.0"), Date: day(1, 6)},
+		{Version: mustParse(t, "1.5.0"), Date: day(2, 17)},
+		{Version: mustParse(t, "1.5.1"), Date: day(3, 2)},
+		{Version: mustParse(t, "1.6.0-rc.1"), Date: day(3, 24)},
+	}
+
+	tests := []struct {
+		name            string
+		train           ReleaseTrain
+		history         []Release
+		now             time.Time
+		expectedVersion string
+		expectedDate    time.Time
+		expectError     bool
+	}{
+		{
+			name:            "Next minor",
+			train:           ReleaseTrain{Cadence: sixWeeks},
+			history:         history,
+			now:             day(3, 25),
+			expectedVersion: "1.6.0",
+			expectedDate:    day(3, 31),
+		},
+		{
+			name:            "Missed dates are skipped",
+			train:           ReleaseTrain{Cadence: sixWeeks},
+			history:         history,
+			now:             day(4, 1),
+			expectedVersion: "1.6.0",
+			expectedDate:    day(5, 12),
+		},
+		{
+			name:            "Cut date is today",
+			train:           ReleaseTrain{Cadence: sixWeeks},
+			history:         history,
+			now:             day(5, 12),
+			expectedVersion: "1.6.0",
+			expectedDate:    day(5, 12),
+		},
+		{
+			name:            "Only a pre-release in the history",
+			train:           ReleaseTrain{Cadence: sixWeeks, Start: day(4, 1)},
+			history:         []Release{{Version: mustParse(t, "1.0.0-alpha.1"), Date: day(3, 2)}},
+			now:             day(3, 25),
+			expectedVersion: "1.0.0",
+			expectedDate:    day(4, 1),
+		},
+		{
+			name:            "Major train",
+			train:           ReleaseTrain{Cadence: 365 * 24 * time.Hour, Change: ChangeMajor, Start: day(1, 1)},
+			history:         history,
+			now:             day(3, 25),
+			expectedVersion: "2.0.0",
+			expectedDate:    day(1, 1).AddDate(1, 0, 0),
+		},
+		{
+			name:            "Start without history",
+			train:           ReleaseTrain{Cadence: sixWeeks, Start: day(7, 1)},
+			now:             day(3, 25),
+			expectedVersion: "0.1.0",
+			expectedDate:    day(7, 1),
+		},
+		{name: "No anchor", train: ReleaseTrain{Cadence: sixWeeks}, now: day(3, 25), expectError: true},
+		{name: "No cadence", train: ReleaseTrain{}, history: history, now: day(3, 25), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.train.Next(tt.history, tt.now)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result.Version.String() != tt.expectedVersion {
+				t.Errorf("Next().Version = %v, want %v", result.Version, tt.expectedVersion)
+			}
+			if !result.Date.Equal(tt.expectedDate) {
+				t.Errorf("Next().Date = %v, want %v", result.Date, tt.expectedDate)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// UpgradeKind classifies the change from one version to another, e.g. for notification and policy systems.
+type UpgradeKind int
+
+const (
+	// UpgradeNone is no change at all, the versions are identical.
+	UpgradeNone UpgradeKind = iota
+	// UpgradeDowngrade is a change to a version of lower precedence.
+	UpgradeDowngrade
+	// UpgradeRebuild is a change of the build metadata only, e.g. 1.2.3+1 to 1.2.3+2.
+	UpgradeRebuild
+	// UpgradePreRelease is a change within the pre-releases of a version core or to its release,
+	// e.g. 1.3.0-rc.1 to 1.3.0-rc.2 or 1.3.0.
+	UpgradePreRelease
+	// UpgradePatch is a change to a higher patch version, e.g. 1.2.3 to 1.2.4.
+	UpgradePatch
+	// UpgradeMinor is a change to a higher minor version, e.g. 1.2.3 to 1.3.0.
+	UpgradeMinor
+	// UpgradeMajor is a change to a higher major version, e.g. 1.2.3 to 2.0.0.
+	UpgradeMajor
+)
+
+// String returns the name of the upgrade kind: none, downgrade, rebuild, prerelease, patch, minor or major.
+func (k UpgradeKind) String() string {
+	switch k {
+	case UpgradeNone:
+		return "none"
+	case UpgradeDowngrade:
+		return "downgrade"
+	case UpgradeRebuild:
+		return "rebuild"
+	case UpgradePreRelease:
+		return "prerelease"
+	case UpgradePatch:
+		return "patch"
+	case UpgradeMinor:
+		return "minor"
+	case UpgradeMajor:
+		return "major"
+	}
+	return fmt.Sprintf("UpgradeKind(%d)", int(k))
+}
+
+// ClassifyUpgrade returns the kind of the change from one version to another. Upgrades to a higher version core
+// are classified by the most significant component that changed, regardless of pre-releases, so 1.2.3 to
+// 2.0.0-rc.1 is a major upgrade.
+func ClassifyUpgrade(from, to SemVer) UpgradeKind {
+	switch result := ComparePrecedence(from, to); {
+	case result > 0:
+		return UpgradeDowngrade
+	case result == 0 && from.Build == to.Build:
+		return UpgradeNone
+	case result == 0:
+		return UpgradeRebuild
+	}
+
+	switch {
+	case to.Major != from.Major:
+		return UpgradeMajor
+	case to.Minor != from.Minor:
+		return UpgradeMinor
+	case to.Patch != from.Patch:
+		return UpgradePatch
+	}
+	return UpgradePreRelease
+}
@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestClassifyUpgrade(t *testing.T) {
+	tests := []struct {
+		from     string
+		to       string
+		expected UpgradeKind
+	}{
+		{"1.2.3", "1.2.3", UpgradeNone},
+		{"1.2.3+1", "1.2.3+1", UpgradeNone},
+		{"1.2.3", "1.2.2", UpgradeDowngrade},
+		{"1.3.0", "1.3.0-rc.1", UpgradeDowngrade},
+		{"2.0.0", "1.9.9+build", UpgradeDowngrade},
+		{"1.2.3+1", "1.2.3+2", UpgradeRebuild},
+		{"1.2.3", "1.2.3+1", UpgradeRebuild},
+		{"1.3.0-rc.1", "1.3.0-rc.2", UpgradePreRelease},
+		{"1.3.0-rc.2", "1.3.0", UpgradePreRelease},
+		{"1.2.3", "1.2.4", UpgradePatch},
+		{"1.2.3-rc.1", "1.2.4", UpgradePatch},
+		{"1.2.3", "1.3.0", UpgradeMinor},
+		{"1.2.3", "1.3.0-rc.1", UpgradeMinor},
+		{"1.2.3", "2.0.0", UpgradeMajor},
+		{"1.2.3", "2.0.0-rc.1", UpgradeMajor},
+		{"0.9.0", "1.0.0", UpgradeMajor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			if result := ClassifyUpgrade(mustParse(t, tt.from), mustParse(t, tt.to)); result != tt.expected {
+				t.Errorf("ClassifyUpgrade() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUpgradeKindString(t *testing.T) {
+	tests := []struct {
+		kind     UpgradeKind
+		expected string
+	}{
+		{UpgradeNone, "none"},
+		{UpgradeDowngrade, "downgrade"},
+		{UpgradeRebuild, "rebuild"},
+		{UpgradePreRelease, "prerelease"},
+		{UpgradePatch, "patch"},
+		{UpgradeMinor, "minor"},
+		{UpgradeMajor, "major"},
+		{UpgradeKind(42), "UpgradeKind(42)"},
+	}
+
+	for _, tt := range tests {
+		if result := tt.kind.String(); result != tt.expected {
+			t.Errorf("String() = %q, want %q", result, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package semver
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PlanUpgrade returns the ordered upgrades from current to target passing through every required version
+// in between, e.g. for databases that must be upgraded through each minor version to run its migrations.
+// The result lists the required versions above current and below target by precedence, in ascending order
+// and without duplicates, followed by target. Required versions outside that range are ignored.
+// It returns an error if target is below current; an empty plan if they have the same precedence.
+func PlanUpgrade(current, target SemVer, required []SemVer) ([]SemVer, error) {
+	switch result := ComparePrecedence(current, target); {
+	case result > 0:
+		return nil, fmt.Errorf("invalid upgrade: %s is below %s", target, current)
+	case result == 0:
+		return nil, nil
+	}
+
+	var plan []SemVer
+	for _, v := range required {
+		if ComparePrecedence(v, current) > 0 && ComparePrecedence(v, target) < 0 {
+			plan = append(plan, v)
+		}
+	}
+	sort.SliceStable(plan, func(i, j int) bool {
+		return ComparePrecedence(plan[i], plan[j]) < 0
+	})
+
+	// Drop versions differing in build metadata only, keeping the first given
+	steps := plan[:0]
+	for _, v := range plan {
+		if len(steps) == 0 || ComparePrecedence(steps[len(steps)-1], v) != 0 {
+			steps = append(steps, v)
+		}
+	}
+	return append(steps, target), nil
+}
@@ -0,0 +1,47 @@
+package semver
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlanUpgrade(t *testing.T) {
+	// The latest patch of each minor version runs its migrations
+	var required []SemVer
+	for _, s := range []string{"1.6.4", "1.4.9", "1.5.7", "2.0.3", "1.5.7+rebuild", "1.7.0-rc.1", "1.3.2"} {
+		required = append(required, mustParse(t, s))
+	}
+
+	tests := []struct {
+		name        string
+		current     string
+		target      string
+		expected    []string
+		expectError bool
+	}{
+		{name: "Through each minor", current: "1.4.2", target: "2.1.0", expected: []string{"1.4.9", "1.5.7", "1.6.4", "1.7.0-rc.1", "2.0.3", "2.1.0"}},
+		{name: "Current is a stepping stone", current: "1.5.7", target: "1.6.9", expected: []string{"1.6.4", "1.6.9"}},
+		{name: "Target is a stepping stone", current: "1.5.0", target: "1.6.4", expected: []string{"1.5.7", "1.6.4"}},
+		{name: "Direct upgrade", current: "1.6.5", target: "1.6.8", expected: []string{"1.6.8"}},
+		{name: "Same version", current: "1.6.5", target: "1.6.5+rebuild", expected: nil},
+		{name: "Downgrade", current: "1.6.5", target: "1.5.0", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := PlanUpgrade(mustParse(t, tt.current), mustParse(t, tt.target), required)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := versionStrings(plan); !slices.Equal(result, tt.expected) {
+				t.Errorf("PlanUpgrade() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// UpgradeRule declares the versions a product can be upgraded from directly: versions matching To
+// can only be installed over versions matching From, e.g. 2.x over ">=1.6 <2.0".
+type UpgradeRule struct {
+	To   Constraint
+	From Constraint
+}
+
+// UpgradeRuleError reports a direct upgrade violating an upgrade rule.
+type UpgradeRuleError struct {
+	Rule UpgradeRule
+	From SemVer
+	To   SemVer
+}
+
+// Error describes the violated rule, e.g. "upgrade from 1.5.0 to 2.1.0 not allowed: 2.x requires upgrading from >=1.6 <2.0".
+func (e *UpgradeRuleError) Error() string {
+	return fmt.Sprintf("upgrade from %s to %s not allowed: %s requires upgrading from %s", e.From, e.To, e.Rule.To, e.Rule.From)
+}
+
+// UpgradePolicy holds the upgrade rules of a product, so skip-level upgrades can be checked before they are attempted.
+// Versions not matched by the To constraint of any rule can be upgraded to from any version.
+type UpgradePolicy struct {
+	Rules []UpgradeRule
+}
+
+// Add parses both constraints and appends a rule allowing upgrades to versions matching to only from versions matching from.
+// It returns an error if a constraint cannot be parsed.
+func (p *UpgradePolicy) Add(to, from string) error {
+	ct, err := ParseConstraint(to)
+	if err != nil {
+		return err
+	}
+	cf, err := ParseConstraint(from)
+	if err != nil {
+		return err
+	}
+	p.Rules = append(p.Rules, UpgradeRule{To: ct, From: cf})
+	return nil
+}
+
+// Check reports whether a direct upgrade from one version to another is allowed: from must match the From
+// constraint of every rule whose To constraint matches to. Downgrades are not checked.
+// It returns an *UpgradeRuleError for the first rule violated.
+func (p UpgradePolicy) Check(from, to SemVer) error {
+	for _, rule := range p.Rules {
+		if rule.To.Allows(to) && !rule.From.Allows(from) {
+			return &UpgradeRuleError{Rule: rule, From: from, To: to}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUpgradePolicyCheck(t *testing.T) {
+	var p UpgradePolicy
+	for _, rule := range [][2]string{
+		{"2.x", ">=1.6 <2.0 || 2.x"},
+		{">=2.4", ">=2.2"},
+		{"3.x", ">=2.8 || 3.x"},
+	} {
+		if err := p.Add(rule[0], rule[1]); err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		from         string
+		to           string
+		violatedRule string
+	}{
+		{name: "Within the supported range", from: "1.6.3", to: "2.1.0"},
+		{name: "Skipping too many minor versions", from: "1.5.0", to: "2.1.0", violatedRule: "2.x"},
+		{name: "Several rules matching", from: "2.2.0", to: "2.5.0"},
+		{name: "Second rule violated", from: "2.1.0", to: "2.5.0", violatedRule: ">=2.4"},
+		{name: "First rule violated first", from: "1.9.0", to: "2.5.0", violatedRule: ">=2.4"},
+		{name: "Skip-level major upgrade", from: "2.7.0", to: "3.0.0", violatedRule: "3.x"},
+		{name: "Target without rules", from: "0.1.0", to: "1.9.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Check(mustParse(t, tt.from), mustParse(t, tt.to))
+			if tt.violatedRule == "" {
+				if err != nil {
+					t.Errorf("Did not expect error but got: %v", err)
+				}
+				return
+			}
+			var ruleErr *UpgradeRuleError
+			if !errors.As(err, &ruleErr) {
+				t.Fatalf("Check() = %v, want an *UpgradeRuleError", err)
+			}
+			if result := ruleErr.Rule.To.String(); result != tt.violatedRule {
+				t.Errorf("violated rule = %q, want %q", result, tt.violatedRule)
+			}
+		})
+	}
+}
+
+func TestUpgradePolicyAdd(t *testing.T) {
+	var p UpgradePolicy
+	if err := p.Add(">=2.y", ">=1.6"); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+	if err := p.Add(">=2.0", "<<1"); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+	if len(p.Rules) != 0 {
+		t.Errorf("invalid rules were added: %v", p.Rules)
+	}
+}
+
+func TestUpgradeRuleError(t *testing.T) {
+	var p UpgradePolicy
+	if err := p.Add("2.x", ">=1.6 <2.0"); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	err := p.Check(mustParse(t, "1.5.0"), mustParse(t, "2.1.0"))
+	expected := "upgrade from 1.5.0 to 2.1.0 not allowed: 2.x requires upgrading from >=1.6 <2.0"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Check() = %v, want %q", err, expected)
+	}
+}
@@ -0,0 +1,145 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// MaxVersionCode is the largest versionCode accepted by Google Play.
+const MaxVersionCode = 2100000000
+
+// VersionCodeLayout describes how a SemVer is packed into an Android versionCode integer.
+// Minor, patch and pre-release each get a fixed number of decimal digits, the major version
+// takes the remaining leading digits.
+//
+// The pre-release slot is split into equal blocks for the alpha, beta and rc stages, with the
+// largest slot value reserved for the release, so codes increase monotonically from pre-releases
+// to their release. A layout without pre-release digits only accepts releases.
+type VersionCodeLayout struct {
+	MinorDigits      uint
+	PatchDigits      uint
+	PreReleaseDigits uint
+}
+
+// DefaultVersionCodeLayout packs 1.2.3 into 1020399 and 1.2.3-rc.1 into 1020368.
+var DefaultVersionCodeLayout = VersionCodeLayout{MinorDigits: 2, PatchDigits: 2, PreReleaseDigits: 2}
+
+// Encode packs the SemVer into a versionCode according to the layout. Build metadata is ignored.
+// It returns an error if the layout allocates more than 9 digits, a component does not fit into
+// its digits, the pre-release does not follow the stage[.N] form (alpha, beta or rc) or the code
+// would exceed MaxVersionCode.
+func (l VersionCodeLayout) Encode(s SemVer) (int, error) {
+	if err := l.validate(); err != nil {
+		return 0, err
+	}
+
+	// Encode the pre-release slot
+	var slot uint64
+	if l.PreReleaseDigits > 0 {
+		slot = pow10(l.PreReleaseDigits) - 1
+	}
+	if s.PreRelease != "" {
+		block := l.stageBlock()
+		if block == 0 {
+			return 0, fmt.Errorf("cannot encode %s: layout has no room for pre-releases", s)
+		}
+		stage, ordinal, err := parseStage(s.PreRelease)
+		if err != nil {
+			return 0, fmt.Errorf("cannot encode %s: %w", s, err)
+		}
+		if ordinal >= block {
+			return 0, fmt.Errorf("cannot encode %s: pre-release number must be between 0 and %d", s, block-2)
+		}
+		slot = uint64(stage)*block + ordinal
+	}
+
+	// Pack the components from the least significant slot upwards
+	code := slot
+	shift := pow10(l.PreReleaseDigits)
+	for _, component := range []struct {
+		name   string
+		value  uint
+		digits uint
+	}{
+		{"patch", s.Patch, l.PatchDigits},
+		{"minor", s.Minor, l.MinorDigits},
+	} {
+		if uint64(component.value) >= pow10(component.digits) {
+			return 0, fmt.Errorf("cannot encode %s: %s %d does not fit into %d digits", s, component.name, component.value, component.digits)
+		}
+		code += uint64(component.value) * shift
+		shift *= pow10(component.digits)
+	}
+	if uint64(s.Major) > MaxVersionCode/shift {
+		return 0, fmt.Errorf("cannot encode %s: code exceeds %d", s, MaxVersionCode)
+	}
+	code += uint64(s.Major) * shift
+
+	if code > MaxVersionCode {
+		return 0, fmt.Errorf("cannot encode %s: code exceeds %d", s, MaxVersionCode)
+	}
+	return int(code), nil
+}
+
+// Decode unpacks a versionCode produced by Encode with the same layout back into a SemVer.
+// It returns an error if the code is negative, exceeds MaxVersionCode or its pre-release slot
+// does not decode to a stage.
+func (l VersionCodeLayout) Decode(code int) (SemVer, error) {
+	if code < 0 || code > MaxVersionCode {
+		return SemVer{}, fmt.Errorf("invalid version code: %d, must be between 0 and %d", code, MaxVersionCode)
+	}
+	if err := l.validate(); err != nil {
+		return SemVer{}, err
+	}
+
+	var s SemVer
+	rest := uint64(code)
+
+	// Decode the pre-release slot
+	if l.PreReleaseDigits > 0 {
+		size := pow10(l.PreReleaseDigits)
+		slot := rest % size
+		rest /= size
+		if slot != size-1 {
+			block := l.stageBlock()
+			stage := slot / block
+			if stage >= uint64(len(preReleaseStages)) {
+				return SemVer{}, fmt.Errorf("invalid version code: %d, pre-release slot %d does not encode a stage", code, slot)
+			}
+			s.PreRelease = formatStage(int(stage), slot%block)
+		}
+	}
+
+	// Decode the version core
+	s.Patch = uint(rest % pow10(l.PatchDigits))
+	rest /= pow10(l.PatchDigits)
+	s.Minor = uint(rest % pow10(l.MinorDigits))
+	rest /= pow10(l.MinorDigits)
+	s.Major = uint(rest)
+
+	return s, nil
+}
+
+// validate checks that the layout leaves at least one digit for the major version.
+func (l VersionCodeLayout) validate() error {
+	if l.MinorDigits+l.PatchDigits+l.PreReleaseDigits > 9 {
+		return fmt.Errorf("invalid version code layout: %d digits allocated, at most 9 allowed", l.MinorDigits+l.PatchDigits+l.PreReleaseDigits)
+	}
+	return nil
+}
+
+// stageBlock returns the number of slot values available to each pre-release stage.
+func (l VersionCodeLayout) stageBlock() uint64 {
+	if l.PreReleaseDigits == 0 {
+		return 0
+	}
+	return (pow10(l.PreReleaseDigits) - 1) / uint64(len(preReleaseStages))
+}
+
+// pow10 returns 10 to the power of n.
+func pow10(n uint) uint64 {
+	result := uint64(1)
+	for i := uint(0); i < n; i++ {
+		result *= 10
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestVersionCodeLayout(t *testing.T) {
+	tests := []struct {
+		name        string
+		layout      VersionCodeLayout
+		semver      SemVer
+		expected    int
+		expectError bool
+	}{
+		{
+			name:     "Release with default layout",
+			layout:   DefaultVersionCodeLayout,
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3},
+			expected: 1020399,
+		},
+		{
+			name:     "Release candidate with default layout",
+			layout:   DefaultVersionCodeLayout,
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1"},
+			expected: 1020368,
+		},
+		{
+			name:     "Bare alpha with default layout",
+			layout:   DefaultVersionCodeLayout,
+			semver:   SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "alpha"},
+			expected: 1020300,
+		},
+		{
+			name:     "Layout without pre-release digits",
+			layout:   VersionCodeLayout{MinorDigits: 3, PatchDigits: 3},
+			semver:   SemVer{Major: 12, Minor: 345, Patch: 6},
+			expected: 12345006,
+		},
+		{
+			name:     "Single pre-release digit",
+			layout:   VersionCodeLayout{MinorDigits: 2, PatchDigits: 2, PreReleaseDigits: 1},
+			semver:   SemVer{Major: 3, Minor: 0, Patch: 1, PreRelease: "beta.1"},
+			expected: 300015,
+		},
+		{
+			name:        "Pre-release without room",
+			layout:      VersionCodeLayout{MinorDigits: 2, PatchDigits: 2},
+			semver:      SemVer{Major: 1, PreRelease: "rc.1"},
+			expectError: true,
+		},
+		{
+			name:        "Minor does not fit",
+			layout:      DefaultVersionCodeLayout,
+			semver:      SemVer{Major: 1, Minor: 100},
+			expectError: true,
+		},
+		{
+			name:        "Pre-release number does not fit",
+			layout:      DefaultVersionCodeLayout,
+			semver:      SemVer{Major: 1, PreRelease: "rc.32"},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported pre-release",
+			layout:      DefaultVersionCodeLayout,
+			semver:      SemVer{Major: 1, PreRelease: "preview.1"},
+			expectError: true,
+		},
+		{
+			name:        "Code exceeds maximum",
+			layout:      DefaultVersionCodeLayout,
+			semver:      SemVer{Major: 2100},
+			expectError: true,
+		},
+		{
+			name:        "Too many digits allocated",
+			layout:      VersionCodeLayout{MinorDigits: 4, PatchDigits: 4, PreReleaseDigits: 2},
+			semver:      SemVer{Major: 1},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := tt.layout.Encode(tt.semver)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if code != tt.expected {
+				t.Errorf("Encode() = %v, want %v", code, tt.expected)
+			}
+
+			// Decoding must yield the original version
+			back, err := tt.layout.Decode(code)
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if back != tt.semver {
+				t.Errorf("Decode() = %v, want %v", back, tt.semver)
+			}
+		})
+	}
+}
+
+func TestVersionCodeMonotonic(t *testing.T) {
+	tags := []string{"1.9.9", "2.0.0-alpha", "2.0.0-alpha.0", "2.0.0-beta.3", "2.0.0-rc", "2.0.0-rc.30", "2.0.0", "2.0.1", "2.1.0"}
+
+	previous := -1
+	for _, tag := range tags {
+		v, err := Parse(tag)
+		if err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+		code, err := DefaultVersionCodeLayout.Encode(v)
+		if err != nil {
+			t.Fatalf("Did not expect error but got: %v", err)
+		}
+		if code <= previous {
+			t.Errorf("Encode(%s) = %v, want greater than %v", tag, code, previous)
+		}
+		previous = code
+	}
+}
+
+func TestVersionCodeDecodeInvalid(t *testing.T) {
+	if _, err := DefaultVersionCodeLayout.Decode(-1); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+	if _, err := (VersionCodeLayout{PreReleaseDigits: 1}).Decode(10); err != nil {
+		t.Errorf("Did not expect error but got: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mkyc/go-semver/internal/atomicfile"
+)
+
+// VersionFile is a plain text file holding a single version, like the VERSION file of many projects.
+// The "v" prefix and line ending of the file are kept, so rewriting it produces a minimal diff.
+type VersionFile struct {
+	Path    string
+	Version SemVer
+	Prefix  string // "v" or empty
+	Newline string // "\n", "\r\n" or empty for files without a trailing newline
+}
+
+// ReadVersionFile reads and validates a version file.
+// It returns an error if the file cannot be read or does not hold exactly one valid version.
+func ReadVersionFile(path string) (VersionFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return VersionFile{}, fmt.Errorf("invalid version file: %w", err)
+	}
+
+	f := VersionFile{Path: path}
+	content := string(data)
+
+	// Detect the line ending
+	switch {
+	case strings.HasSuffix(content, "\r\n"):
+		f.Newline = "\r\n"
+	case strings.HasSuffix(content, "\n"):
+		f.Newline = "\n"
+	}
+	content = strings.TrimSuffix(content, f.Newline)
+
+	// Detect the prefix
+	if strings.HasPrefix(content, "v") {
+		f.Prefix = "v"
+		content = content[1:]
+	}
+
+	if f.Version, err = Parse(content); err != nil {
+		return VersionFile{}, fmt.Errorf("invalid version file: %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// String returns the content of the file.
+func (f VersionFile) String() string {
+	return f.Prefix + f.Version.String() + f.Newline
+}
+
+// Write atomically replaces the file with its content, so readers never observe a partially written version.
+// The permissions of an existing file are kept.
+func (f VersionFile) Write() error {
+	if err := atomicfile.WriteFile(f.Path, []byte(f.String())); err != nil {
+		return fmt.Errorf("write version file: %w", err)
+	}
+	return nil
+}
+
+// BumpVersionFile reads the version file, bumps its version for a change of the given type and rewrites it.
+// It returns the new version.
+func BumpVersionFile(path string, change ChangeType) (SemVer, error) {
+	f, err := ReadVersionFile(path)
+	if err != nil {
+		return SemVer{}, err
+	}
+	f.Version = f.Version.Bump(change)
+	if err := f.Write(); err != nil {
+		return SemVer{}, err
+	}
+	return f.Version, nil
+}
@@ -0,0 +1,106 @@
+package semver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadVersionFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expected    VersionFile
+		expectError bool
+	}{
+		{
+			name:     "Version with newline",
+			content:  "1.2.3\n",
+			expected: VersionFile{Version: SemVer{Major: 1, Minor: 2, Patch: 3}, Newline: "\n"},
+		},
+		{
+			name:     "Version with prefix and CRLF",
+			content:  "v1.2.3-rc.1\r\n",
+			expected: VersionFile{Version: SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1"}, Prefix: "v", Newline: "\r\n"},
+		},
+		{
+			name:     "Version without newline",
+			content:  "0.1.0",
+			expected: VersionFile{Version: SemVer{Minor: 1}},
+		},
+		{name: "Empty file", content: "", expectError: true},
+		{name: "Several lines", content: "1.2.3\n1.2.4\n", expectError: true},
+		{name: "Surrounding whitespace", content: " 1.2.3\n", expectError: true},
+		{name: "Invalid version", content: "1.2\n", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "VERSION")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			f, err := ReadVersionFile(path)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			tt.expected.Path = path
+			if f != tt.expected {
+				t.Errorf("ReadVersionFile() = %+v, want %+v", f, tt.expected)
+			}
+			if f.String() != tt.content {
+				t.Errorf("String() = %q, want %q", f.String(), tt.content)
+			}
+		})
+	}
+
+	if _, err := ReadVersionFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Errorf("Expected error for missing file but got none")
+	}
+}
+
+func TestBumpVersionFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "VERSION")
+	if err := os.WriteFile(path, []byte("v1.2.3\r\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := BumpVersionFile(path, ChangeMinor)
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	if v.String() != "1.3.0" {
+		t.Errorf("BumpVersionFile() = %v, want %v", v, "1.3.0")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "v1.3.0\r\n" {
+		t.Errorf("content = %q, want %q", data, "v1.3.0\r\n")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("mode = %v, want %v", info.Mode().Perm(), os.FileMode(0o600))
+	}
+
+	// No temporary files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory has %d entries, want 1", len(entries))
+	}
+}
@@ -0,0 +1,42 @@
+package versioninfo
+
+import (
+	"fmt"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Embedded parses the content of a VERSION file embedded into the binary, keeping the file the single
+// authoritative version artifact:
+//
+//	//go:embed VERSION
+//	var versionFile string
+//
+// Surrounding whitespace and a leading "v" are accepted.
+// It returns an error if the content is not exactly one valid semantic version.
+func Embedded(content string) (semver.SemVer, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return semver.SemVer{}, fmt.Errorf("invalid embedded version: empty VERSION file")
+	}
+
+	v, err := semver.ParseWith(content, semver.WithVPrefix(semver.VPrefixAllow))
+	if err != nil {
+		return semver.SemVer{}, fmt.Errorf("invalid embedded version: %w", err)
+	}
+	return v, nil
+}
+
+// MustEmbedded is like Embedded but panics if the content is not a valid version.
+// It is meant for package level variables, so a broken VERSION file fails the binary at start
+// and every test of the package:
+//
+//	var version = versioninfo.MustEmbedded(versionFile)
+func MustEmbedded(content string) semver.SemVer {
+	v, err := Embedded(content)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,57 @@
+package versioninfo
+
+import (
+	_ "embed"
+	"testing"
+)
+
+//go:embed testdata/VERSION
+var versionFile string
+
+func TestEmbedded(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expected    string
+		expectError bool
+	}{
+		{name: "Embedded file", content: versionFile, expected: "1.4.0-rc.2"},
+		{name: "Without prefix", content: "1.2.3", expected: "1.2.3"},
+		{name: "Surrounding whitespace", content: "\n 1.2.3+abc\r\n", expected: "1.2.3+abc"},
+		{name: "Empty file", content: "\n", expectError: true},
+		{name: "Several versions", content: "1.2.3\n1.2.4\n", expectError: true},
+		{name: "Invalid version", content: "1.2\n", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Embedded(tt.content)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if v.String() != tt.expected {
+				t.Errorf("Embedded() = %v, want %v", v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMustEmbedded(t *testing.T) {
+	if v := MustEmbedded(versionFile); v.String() != "1.4.0-rc.2" {
+		t.Errorf("MustEmbedded() = %v, want %v", v, "1.4.0-rc.2")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic but got none")
+		}
+	}()
+	MustEmbedded("")
+}
@@ -0,0 +1,36 @@
+package versioninfo
+
+import (
+	"expvar"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Publish publishes the version as an expvar map under the given name, e.g. "version", so it shows up
+// on /debug/vars next to the other runtime variables:
+//
+//	"version": {"build": "", "major": 1, "minor": 2, "patch": 3, "prerelease": "", "version": "1.2.3"}
+//
+// Like expvar.Publish, it panics if the name is already in use.
+func Publish(name string, v semver.SemVer) *expvar.Map {
+	m := expvar.NewMap(name)
+	m.Set("version", stringVar(v.String()))
+	m.Set("major", intVar(v.Major))
+	m.Set("minor", intVar(v.Minor))
+	m.Set("patch", intVar(v.Patch))
+	m.Set("prerelease", stringVar(v.PreRelease))
+	m.Set("build", stringVar(v.Build))
+	return m
+}
+
+func stringVar(s string) *expvar.String {
+	v := new(expvar.String)
+	v.Set(s)
+	return v
+}
+
+func intVar(i uint) *expvar.Int {
+	v := new(expvar.Int)
+	v.Set(int64(i))
+	return v
+}
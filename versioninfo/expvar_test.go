@@ -0,0 +1,31 @@
+package versioninfo
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+func TestPublish(t *testing.T) {
+	Publish("test_version", semver.SemVer{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1", Build: "abc"})
+
+	var published map[string]any
+	if err := json.Unmarshal([]byte(expvar.Get("test_version").String()), &published); err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	expected := map[string]any{
+		"version":    "1.2.3-rc.1+abc",
+		"major":      float64(1),
+		"minor":      float64(2),
+		"patch":      float64(3),
+		"prerelease": "rc.1",
+		"build":      "abc",
+	}
+	for key, value := range expected {
+		if published[key] != value {
+			t.Errorf("%s = %v, want %v", key, published[key], value)
+		}
+	}
+}
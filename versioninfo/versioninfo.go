@@ -0,0 +1,48 @@
+// Package versioninfo standardizes how binaries expose their own version.
+// The version is stamped at link time:
+//
+//	go build -ldflags "-X github.com/mkyc/go-semver/versioninfo.Version=1.2.3"
+//
+// and read back validated with Get or MustGet. Binaries built without stamping,
+// e.g. with "go install module@version", fall back to the version recorded by the go command.
+// Binaries keeping their version in a VERSION file embed it instead and read it with Embedded or MustEmbedded.
+package versioninfo
+
+import (
+	"fmt"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Version is the version of the binary, set with -ldflags -X. A leading "v" is accepted.
+var Version string
+
+// Get returns the stamped Version, or the main module version from the build information if
+// Version is empty. It returns an error if the stamped Version is not a valid semantic version
+// or neither is available.
+func Get() (semver.SemVer, error) {
+	if Version == "" {
+		v, err := semver.FromBuildInfo()
+		if err != nil {
+			return semver.SemVer{}, fmt.Errorf("invalid version info: not stamped and %w", err)
+		}
+		return v, nil
+	}
+
+	v, err := semver.Parse(strings.TrimPrefix(strings.TrimSpace(Version), "v"))
+	if err != nil {
+		return semver.SemVer{}, fmt.Errorf("invalid version info: %w", err)
+	}
+	return v, nil
+}
+
+// MustGet is like Get but panics if the version is not available.
+// It is meant for binaries whose release builds are always stamped.
+func MustGet() semver.SemVer {
+	v, err := Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,58 @@
+package versioninfo
+
+import (
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		expected    string
+		expectError bool
+	}{
+		{name: "Stamped version", version: "1.2.3", expected: "1.2.3"},
+		{name: "Stamped version with prefix", version: "v1.2.3-rc.1+abc", expected: "1.2.3-rc.1+abc"},
+		{name: "Invalid stamped version", version: "1.2", expectError: true},
+		// Test binaries carry no main module version
+		{name: "Not stamped", version: "", expectError: true},
+	}
+
+	defer func(original string) { Version = original }(Version)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Version = tt.version
+			v, err := Get()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if v.String() != tt.expected {
+				t.Errorf("Get() = %v, want %v", v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMustGet(t *testing.T) {
+	defer func(original string) { Version = original }(Version)
+
+	Version = "2.0.0"
+	if v := MustGet(); v.String() != "2.0.0" {
+		t.Errorf("MustGet() = %v, want %v", v, "2.0.0")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic but got none")
+		}
+	}()
+	Version = "invalid"
+	MustGet()
+}
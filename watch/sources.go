@@ -0,0 +1,74 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// GitTags returns a source listing the version tags of a git remote, a URL or a path, with or without a "v" prefix,
+// in no particular order.
+// It runs git ls-remote, so git must be installed. Tags that are not versions are ignored.
+func GitTags(remote string) Source {
+	return SourceFunc(func(ctx context.Context) ([]semver.SemVer, error) {
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, "git", "ls-remote", "--tags", "--refs", remote)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("git ls-remote %s: %v: %s", remote, err, strings.TrimSpace(stderr.String()))
+		}
+
+		// Each line is the object name and the ref, e.g. "<sha>\trefs/tags/v1.2.3"
+		var versions []semver.SemVer
+		for _, line := range strings.Split(stdout.String(), "\n") {
+			_, ref, _ := strings.Cut(line, "\t")
+			tag, ok := strings.CutPrefix(ref, "refs/tags/")
+			if !ok {
+				continue
+			}
+			if v, err := semver.ParseWith(tag, semver.WithVPrefix(semver.VPrefixAllow)); err == nil {
+				versions = append(versions, v)
+			}
+		}
+		return versions, nil
+	})
+}
+
+// HTTPList returns a source fetching a plain text list of versions, one per line, from the URL with the client,
+// e.g. a release index served by a download server. Nil uses http.DefaultClient. The list may contain blank lines
+// and "#" comments, and versions may have a "v" prefix; other invalid lines are ignored.
+func HTTPList(client *http.Client, url string) Source {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return SourceFunc(func(ctx context.Context) ([]semver.SemVer, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetching %s: unexpected status %s", url, resp.Status)
+		}
+
+		lines, _, err := semver.ParseLines(resp.Body, semver.WithVPrefix(semver.VPrefixAllow))
+		if err != nil {
+			return nil, fmt.Errorf("fetching %s: %w", url, err)
+		}
+		versions := make([]semver.SemVer, len(lines))
+		for i, line := range lines {
+			versions[i] = line.Version
+		}
+		return versions, nil
+	})
+}
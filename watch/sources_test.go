@@ -0,0 +1,95 @@
+package watch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// versionStrings returns the string forms of the versions.
+func versionStrings(versions []semver.SemVer) []string {
+	var result []string
+	for _, v := range versions {
+		result = append(result, v.String())
+	}
+	return result
+}
+
+func TestGitTags(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		args = append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "tag.gpgSign=false", "-c", "commit.gpgSign=false"}, args...)
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %v: %s", strings.Join(args, " "), err, out)
+		}
+	}
+	git("init", "-q")
+	git("commit", "-q", "--allow-empty", "-m", "initial")
+	for _, tag := range []string{"v1.0.0", "1.1.0", "latest", "v2.0.0-rc.1"} {
+		git("tag", tag)
+	}
+
+	versions, err := GitTags(dir).Versions(context.Background())
+	if err != nil {
+		t.Fatalf("Did not expect error but got: %v", err)
+	}
+	slices.SortFunc(versions, semver.ComparePrecedence)
+	if result, expected := versionStrings(versions), []string{"1.0.0", "1.1.0", "2.0.0-rc.1"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("Versions() = %v, want %v", result, expected)
+	}
+
+	if _, err := GitTags(dir + "/missing").Versions(context.Background()); err == nil {
+		t.Errorf("Expected error but got none")
+	}
+}
+
+func TestHTTPList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/versions.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("# releases\nv1.0.0\n1.1.0\n\nnot-a-version\n2.0.0-rc.1\n"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		path        string
+		expected    []string
+		expectError bool
+	}{
+		{name: "List", path: "/versions.txt", expected: []string{"1.0.0", "1.1.0", "2.0.0-rc.1"}},
+		{name: "Not found", path: "/missing", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, err := HTTPList(server.Client(), server.URL+tt.path).Versions(context.Background())
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+				return
+			}
+			if result := versionStrings(versions); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Versions() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
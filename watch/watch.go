@@ -0,0 +1,120 @@
+// Package watch polls a source of versions, like a git remote, a registry or an HTTP endpoint,
+// and delivers the versions it has not seen before on a channel, e.g. for self-update and deploy notification bots.
+package watch
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// Source lists the versions currently available.
+type Source interface {
+	Versions(ctx context.Context) ([]semver.SemVer, error)
+}
+
+// SourceFunc adapts a function to a Source.
+type SourceFunc func(ctx context.Context) ([]semver.SemVer, error)
+
+// Versions calls f.
+func (f SourceFunc) Versions(ctx context.Context) ([]semver.SemVer, error) {
+	return f(ctx)
+}
+
+// Event is a version observed by a Watcher, or an error of its source.
+type Event struct {
+	Version semver.SemVer
+	// Latest reports whether the version is higher than every version observed before and in the same poll
+	Latest bool
+	// Err is the error of a failed poll, the watcher tries again at the next interval
+	Err error
+}
+
+// DefaultInterval is the polling interval of a Watcher without one.
+const DefaultInterval = time.Minute
+
+// Watcher polls a source on an interval and reports the versions it has not observed before.
+type Watcher struct {
+	Source Source
+	// Interval is the time between polls, DefaultInterval if not positive
+	Interval time.Duration
+
+	// Known are versions observed before watching, e.g. the running version, which are not reported
+	Known []semver.SemVer
+	// LatestOnly reports only new latest versions instead of all new versions
+	LatestOnly bool
+}
+
+// Watch polls the source right away and then on every interval until the context is canceled,
+// and returns a channel delivering the new versions in ascending order by precedence for each poll.
+// The channel is closed when the context is canceled. Versions differing in build metadata only count as the same.
+func (w *Watcher) Watch(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		var latest semver.Tracker
+		seen := map[string]bool{}
+		for _, v := range w.Known {
+			latest.Observe(v)
+			seen[v.PrecedenceKey()] = true
+		}
+
+		interval := w.Interval
+		if interval <= 0 {
+			interval = DefaultInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			for _, e := range w.poll(ctx, &latest, seen) {
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// poll lists the versions of the source and returns the events for the ones not seen yet.
+func (w *Watcher) poll(ctx context.Context, latest *semver.Tracker, seen map[string]bool) []Event {
+	versions, err := w.Source.Versions(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return []Event{{Err: err}}
+	}
+
+	var fresh []semver.SemVer
+	for _, v := range versions {
+		if key := v.PrecedenceKey(); !seen[key] {
+			seen[key] = true
+			fresh = append(fresh, v)
+		}
+	}
+	slices.SortFunc(fresh, semver.ComparePrecedence)
+
+	// Only the highest new version can be higher than every version observed before
+	var events []Event
+	for i, v := range fresh {
+		isLatest := latest.Observe(v) && i == len(fresh)-1
+		if isLatest || !w.LatestOnly {
+			events = append(events, Event{Version: v, Latest: isLatest})
+		}
+	}
+	return events
+}
@@ -0,0 +1,142 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	semver "github.com/mkyc/go-semver"
+)
+
+// sequence returns a source returning the lists of versions one after another, repeating the last one.
+// An "error" entry makes the poll fail.
+func sequence(t *testing.T, polls ...[]string) Source {
+	t.Helper()
+	i := 0
+	return SourceFunc(func(ctx context.Context) ([]semver.SemVer, error) {
+		poll := polls[min(i, len(polls)-1)]
+		i++
+		var versions []semver.SemVer
+		for _, s := range poll {
+			if s == "error" {
+				return nil, errors.New("registry unavailable")
+			}
+			v, err := semver.Parse(s)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", s, err)
+			}
+			versions = append(versions, v)
+		}
+		return versions, nil
+	})
+}
+
+// describe returns the events as strings, e.g. "1.1.0 latest".
+func describe(e Event) string {
+	switch {
+	case e.Err != nil:
+		return "error: " + e.Err.Error()
+	case e.Latest:
+		return e.Version.String() + " latest"
+	}
+	return e.Version.String()
+}
+
+func TestWatcher(t *testing.T) {
+	polls := [][]string{
+		{"1.0.0"},
+		{"1.0.0", "1.1.0", "1.0.1"},
+		{"error"},
+		{"1.0.0", "1.1.0", "1.0.1", "1.1.0+rebuilt", "2.0.0-rc.1", "1.2.0"},
+	}
+
+	tests := []struct {
+		name       string
+		known      []string
+		latestOnly bool
+		expected   []string
+	}{
+		{
+			name:     "All new versions",
+			known:    []string{"1.0.0"},
+			expected: []string{"1.0.1", "1.1.0 latest", "error: registry unavailable", "1.2.0", "2.0.0-rc.1 latest"},
+		},
+		{
+			name:       "Latest only",
+			known:      []string{"1.0.0"},
+			latestOnly: true,
+			expected:   []string{"1.1.0 latest", "error: registry unavailable", "2.0.0-rc.1 latest"},
+		},
+		{
+			name:     "Nothing known",
+			expected: []string{"1.0.0 latest", "1.0.1", "1.1.0 latest", "error: registry unavailable", "1.2.0", "2.0.0-rc.1 latest"},
+		},
+		{
+			name:       "Known above the source",
+			known:      []string{"3.0.0"},
+			latestOnly: true,
+			expected:   []string{"error: registry unavailable"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Watcher{Source: sequence(t, polls...), Interval: time.Millisecond, LatestOnly: tt.latestOnly}
+			for _, s := range tt.known {
+				v, _ := semver.Parse(s)
+				w.Known = append(w.Known, v)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			var result []string
+			for e := range w.Watch(ctx) {
+				result = append(result, describe(e))
+				if len(result) == len(tt.expected) {
+					break
+				}
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("events = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWatcherCancel(t *testing.T) {
+	polled := make(chan struct{}, 100)
+	source := SourceFunc(func(ctx context.Context) ([]semver.SemVer, error) {
+		polled <- struct{}{}
+		return nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := (&Watcher{Source: source, Interval: time.Hour}).Watch(ctx)
+	<-polled
+	cancel()
+
+	select {
+	case e, ok := <-events:
+		if ok {
+			t.Errorf("received %v after cancel, want the channel closed", describe(e))
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("channel not closed after cancel")
+	}
+}
+
+func ExampleWatcher() {
+	source := SourceFunc(func(ctx context.Context) ([]semver.SemVer, error) {
+		return []semver.SemVer{{Major: 1, Minor: 4}, {Major: 1, Minor: 5}}, nil
+	})
+	w := &Watcher{Source: source, Known: []semver.SemVer{{Major: 1, Minor: 4}}, LatestOnly: true}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	e := <-w.Watch(ctx)
+	fmt.Println("update available:", e.Version)
+	// Output: update available: 1.5.0
+}
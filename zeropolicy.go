@@ -0,0 +1,66 @@
+package semver
+
+// ZeroCompatible selects which later versions a 0.y.z version is considered compatible with,
+// i.e. what a caret range like "^0.2.3" allows.
+type ZeroCompatible int
+
+const (
+	// ZeroCompatibleLeftmost treats the left-most non-zero component as the major version, like npm and Cargo:
+	// "^0.2.3" is >=0.2.3 <0.3.0 and "^0.0.3" is >=0.0.3 <0.0.4.
+	ZeroCompatibleLeftmost ZeroCompatible = iota
+	// ZeroCompatibleMinor allows patch-level changes only, like "~0.y.z":
+	// "^0.2.3" is >=0.2.3 <0.3.0 and "^0.0.3" is >=0.0.3 <0.1.0.
+	ZeroCompatibleMinor
+	// ZeroCompatibleMajor treats major version zero like any other major version: "^0.2.3" is >=0.2.3 <1.0.0.
+	ZeroCompatibleMajor
+	// ZeroCompatibleNone treats every 0.y.z version as incompatible with any other, as the specification
+	// allows anything to change: "^0.2.3" is =0.2.3.
+	ZeroCompatibleNone
+)
+
+// ZeroPolicy controls how versions during initial development (0.y.z) are bumped and matched,
+// as conventions for them differ between teams and ecosystems. The zero value is the policy used by
+// Bump and ParseConstraint: breaking changes bump to 1.0.0, features bump the minor version and
+// caret ranges use ZeroCompatibleLeftmost.
+type ZeroPolicy struct {
+	// BreakingBumpsMinor makes breaking changes bump 0.y.z to 0.(y+1).0 instead of 1.0.0,
+	// so leaving initial development stays an explicit decision.
+	BreakingBumpsMinor bool
+	// FeatureBumpsPatch makes new features bump 0.y.z to 0.y.(z+1) instead of 0.(y+1).0.
+	// It is usually combined with BreakingBumpsMinor.
+	FeatureBumpsPatch bool
+	// Compatible selects what caret ranges on 0.y.z versions allow.
+	Compatible ZeroCompatible
+}
+
+// Bump returns the next version for a change of the given type like SemVer.Bump,
+// but bumps 0.y.z versions according to the policy.
+func (p ZeroPolicy) Bump(v SemVer, change ChangeType) SemVer {
+	if v.Major == 0 {
+		switch {
+		case change == ChangeMajor && p.BreakingBumpsMinor:
+			change = ChangeMinor
+		case change == ChangeMinor && p.FeatureBumpsPatch:
+			change = ChangePatch
+		}
+	}
+	return v.Bump(change)
+}
+
+// ParseConstraint parses a constraint like the package level ParseConstraint,
+// but expands caret ranges on 0.y.z versions according to the policy.
+func (p ZeroPolicy) ParseConstraint(s string) (Constraint, error) {
+	return parseConstraint(s, p)
+}
+
+// CompatibleRange returns the constraint matching the versions compatible with v under the policy,
+// i.e. the caret range "^v".
+func (p ZeroPolicy) CompatibleRange(v SemVer) Constraint {
+	core := SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch, PreRelease: v.PreRelease}
+	c, err := parseConstraint("^"+core.String(), p)
+	if err != nil {
+		// A valid version always forms a valid caret range
+		panic(err)
+	}
+	return c
+}
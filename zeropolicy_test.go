@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestZeroPolicyBump(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   ZeroPolicy
+		version  string
+		change   ChangeType
+		expected string
+	}{
+		{name: "Default breaking", version: "0.3.1", change: ChangeMajor, expected: "1.0.0"},
+		{name: "Default feature", version: "0.3.1", change: ChangeMinor, expected: "0.4.0"},
+		{name: "Breaking bumps minor", policy: ZeroPolicy{BreakingBumpsMinor: true}, version: "0.3.1", change: ChangeMajor, expected: "0.4.0"},
+		{name: "Breaking bumps minor of pre-release", policy: ZeroPolicy{BreakingBumpsMinor: true}, version: "0.4.0-rc.1", change: ChangeMajor, expected: "0.4.0"},
+		{name: "Feature bumps patch", policy: ZeroPolicy{BreakingBumpsMinor: true, FeatureBumpsPatch: true}, version: "0.3.1", change: ChangeMinor, expected: "0.3.2"},
+		{name: "Fix", policy: ZeroPolicy{BreakingBumpsMinor: true, FeatureBumpsPatch: true}, version: "0.3.1", change: ChangePatch, expected: "0.3.2"},
+		{name: "Stable versions are not affected", policy: ZeroPolicy{BreakingBumpsMinor: true, FeatureBumpsPatch: true}, version: "1.3.1", change: ChangeMajor, expected: "2.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.policy.Bump(mustParse(t, tt.version), tt.change).String(); result != tt.expected {
+				t.Errorf("Bump() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestZeroPolicyParseConstraint(t *testing.T) {
+	tests := []struct {
+		compatible ZeroCompatible
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{ZeroCompatibleLeftmost, "^0.2.3", "0.2.9", true},
+		{ZeroCompatibleLeftmost, "^0.2.3", "0.3.0", false},
+		{ZeroCompatibleLeftmost, "^0.0.3", "0.0.4", false},
+		{ZeroCompatibleMinor, "^0.2.3", "0.2.9", true},
+		{ZeroCompatibleMinor, "^0.2.3", "0.3.0", false},
+		{ZeroCompatibleMinor, "^0.0.3", "0.0.4", true},
+		{ZeroCompatibleMinor, "^0.0.3", "0.1.0", false},
+		{ZeroCompatibleMajor, "^0.2.3", "0.9.0", true},
+		{ZeroCompatibleMajor, "^0.2.3", "1.0.0", false},
+		{ZeroCompatibleNone, "^0.2.3", "0.2.3", true},
+		{ZeroCompatibleNone, "^0.2.3", "0.2.4", false},
+		{ZeroCompatibleNone, "^0.2", "0.2.9", true},
+		{ZeroCompatibleNone, "^1.2.3", "1.9.0", true},
+		{ZeroCompatibleMajor, "^0", "0.9.0", true},
+		{ZeroCompatibleMajor, "^0", "1.0.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" allows "+tt.version, func(t *testing.T) {
+			c, err := ZeroPolicy{Compatible: tt.compatible}.ParseConstraint(tt.constraint)
+			if err != nil {
+				t.Fatalf("Did not expect error but got: %v", err)
+			}
+			if result := c.Allows(mustParse(t, tt.version)); result != tt.expected {
+				t.Errorf("Allows() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestZeroPolicyCompatibleRange(t *testing.T) {
+	c := ZeroPolicy{Compatible: ZeroCompatibleMinor}.CompatibleRange(mustParse(t, "0.0.3+build"))
+	if c.String() != "^0.0.3" {
+		t.Errorf("String() = %v, want %v", c.String(), "^0.0.3")
+	}
+	if !c.Allows(mustParse(t, "0.0.9")) || c.Allows(mustParse(t, "0.1.0")) {
+		t.Errorf("CompatibleRange() = %v, want >=0.0.3 <0.1.0", c)
+	}
+}